@@ -19,26 +19,50 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	User_CreateNonce_FullMethodName    = "/api.user.v1.User/CreateNonce"
-	User_EthAuthorize_FullMethodName   = "/api.user.v1.User/EthAuthorize"
-	User_GetUser_FullMethodName        = "/api.user.v1.User/GetUser"
-	User_UserRecommend_FullMethodName  = "/api.user.v1.User/UserRecommend"
-	User_OrderList_FullMethodName      = "/api.user.v1.User/OrderList"
-	User_OrderListTwo_FullMethodName   = "/api.user.v1.User/OrderListTwo"
-	User_RewardList_FullMethodName     = "/api.user.v1.User/RewardList"
-	User_RecordList_FullMethodName     = "/api.user.v1.User/RecordList"
-	User_CodeList_FullMethodName       = "/api.user.v1.User/CodeList"
-	User_OpenCard_FullMethodName       = "/api.user.v1.User/OpenCard"
-	User_CheckCard_FullMethodName      = "/api.user.v1.User/CheckCard"
-	User_OpenCardTwo_FullMethodName    = "/api.user.v1.User/OpenCardTwo"
-	User_LookCard_FullMethodName       = "/api.user.v1.User/LookCard"
-	User_LookCardNew_FullMethodName    = "/api.user.v1.User/LookCardNew"
-	User_LookCardNewTwo_FullMethodName = "/api.user.v1.User/LookCardNewTwo"
-	User_ChangePin_FullMethodName      = "/api.user.v1.User/ChangePin"
-	User_AmountToCard_FullMethodName   = "/api.user.v1.User/AmountToCard"
-	User_SetVip_FullMethodName         = "/api.user.v1.User/SetVip"
-	User_AmountTo_FullMethodName       = "/api.user.v1.User/AmountTo"
-	User_Withdraw_FullMethodName       = "/api.user.v1.User/Withdraw"
+	User_CreateNonce_FullMethodName           = "/api.user.v1.User/CreateNonce"
+	User_EthAuthorize_FullMethodName          = "/api.user.v1.User/EthAuthorize"
+	User_GetUser_FullMethodName               = "/api.user.v1.User/GetUser"
+	User_UserRecommend_FullMethodName         = "/api.user.v1.User/UserRecommend"
+	User_OrderList_FullMethodName             = "/api.user.v1.User/OrderList"
+	User_OrderListTwo_FullMethodName          = "/api.user.v1.User/OrderListTwo"
+	User_RewardList_FullMethodName            = "/api.user.v1.User/RewardList"
+	User_RecordList_FullMethodName            = "/api.user.v1.User/RecordList"
+	User_CodeList_FullMethodName              = "/api.user.v1.User/CodeList"
+	User_CardList_FullMethodName              = "/api.user.v1.User/CardList"
+	User_OpenCard_FullMethodName              = "/api.user.v1.User/OpenCard"
+	User_CheckCard_FullMethodName             = "/api.user.v1.User/CheckCard"
+	User_OpenCardTwo_FullMethodName           = "/api.user.v1.User/OpenCardTwo"
+	User_LookCard_FullMethodName              = "/api.user.v1.User/LookCard"
+	User_LookCardNew_FullMethodName           = "/api.user.v1.User/LookCardNew"
+	User_LookCardNewTwo_FullMethodName        = "/api.user.v1.User/LookCardNewTwo"
+	User_ChangePin_FullMethodName             = "/api.user.v1.User/ChangePin"
+	User_PinKey_FullMethodName                = "/api.user.v1.User/PinKey"
+	User_AmountToCard_FullMethodName          = "/api.user.v1.User/AmountToCard"
+	User_SetVip_FullMethodName                = "/api.user.v1.User/SetVip"
+	User_AmountTo_FullMethodName              = "/api.user.v1.User/AmountTo"
+	User_Withdraw_FullMethodName              = "/api.user.v1.User/Withdraw"
+	User_WithdrawAddressList_FullMethodName   = "/api.user.v1.User/WithdrawAddressList"
+	User_AddWithdrawAddress_FullMethodName    = "/api.user.v1.User/AddWithdrawAddress"
+	User_DeleteWithdrawAddress_FullMethodName = "/api.user.v1.User/DeleteWithdrawAddress"
+	User_FeeQuote_FullMethodName              = "/api.user.v1.User/FeeQuote"
+	User_Exchange_FullMethodName              = "/api.user.v1.User/Exchange"
+	User_NotifyList_FullMethodName            = "/api.user.v1.User/NotifyList"
+	User_NotifyRead_FullMethodName            = "/api.user.v1.User/NotifyRead"
+	User_NotifySetting_FullMethodName         = "/api.user.v1.User/NotifySetting"
+	User_SetNotifySetting_FullMethodName      = "/api.user.v1.User/SetNotifySetting"
+	User_StreamTicket_FullMethodName          = "/api.user.v1.User/StreamTicket"
+	User_TotpStatus_FullMethodName            = "/api.user.v1.User/TotpStatus"
+	User_TotpSetup_FullMethodName             = "/api.user.v1.User/TotpSetup"
+	User_TotpEnable_FullMethodName            = "/api.user.v1.User/TotpEnable"
+	User_TotpDisable_FullMethodName           = "/api.user.v1.User/TotpDisable"
+	User_DataExport_FullMethodName            = "/api.user.v1.User/DataExport"
+	User_AccountErase_FullMethodName          = "/api.user.v1.User/AccountErase"
+	User_AuditList_FullMethodName             = "/api.user.v1.User/AuditList"
+	User_AuditVerify_FullMethodName           = "/api.user.v1.User/AuditVerify"
+	User_RiskReviewList_FullMethodName        = "/api.user.v1.User/RiskReviewList"
+	User_RiskReview_FullMethodName            = "/api.user.v1.User/RiskReview"
+	User_AdminDataExport_FullMethodName       = "/api.user.v1.User/AdminDataExport"
+	User_AdminAccountErase_FullMethodName     = "/api.user.v1.User/AdminAccountErase"
 )
 
 // UserClient is the client API for User service.
@@ -60,6 +84,8 @@ type UserClient interface {
 	RecordList(ctx context.Context, in *RecordListRequest, opts ...grpc.CallOption) (*RecordListReply, error)
 	// 明细列表
 	CodeList(ctx context.Context, in *CodeListRequest, opts ...grpc.CallOption) (*CodeListReply, error)
+	// 我的卡片
+	CardList(ctx context.Context, in *CardListRequest, opts ...grpc.CallOption) (*CardListReply, error)
 	// 开卡
 	OpenCard(ctx context.Context, in *OpenCardRequest, opts ...grpc.CallOption) (*OpenCardReply, error)
 	CheckCard(ctx context.Context, in *CheckCardRequest, opts ...grpc.CallOption) (*CheckCardReply, error)
@@ -70,7 +96,10 @@ type UserClient interface {
 	LookCardNew(ctx context.Context, in *LookCardRequest, opts ...grpc.CallOption) (*LookCardReply, error)
 	// 补卡
 	LookCardNewTwo(ctx context.Context, in *LookCardRequest, opts ...grpc.CallOption) (*LookCardReply, error)
+	// 修改卡片PIN，PIN 用 PinKey 返回的公钥加密后放在 encryptedPin
 	ChangePin(ctx context.Context, in *ChangePinRequest, opts ...grpc.CallOption) (*ChangePinReply, error)
+	// 加密PIN的RSA公钥，客户端用 RSA-OAEP(SHA-256) 加密
+	PinKey(ctx context.Context, in *PinKeyRequest, opts ...grpc.CallOption) (*PinKeyReply, error)
 	// 划转
 	AmountToCard(ctx context.Context, in *AmountToCardRequest, opts ...grpc.CallOption) (*AmountToCardReply, error)
 	// 设置级别给下级
@@ -79,6 +108,50 @@ type UserClient interface {
 	AmountTo(ctx context.Context, in *AmountToRequest, opts ...grpc.CallOption) (*AmountToReply, error)
 	// 提现
 	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawReply, error)
+	// 提现地址簿
+	WithdrawAddressList(ctx context.Context, in *WithdrawAddressListRequest, opts ...grpc.CallOption) (*WithdrawAddressListReply, error)
+	// 添加提现地址，冷却期结束后才能用于提现
+	AddWithdrawAddress(ctx context.Context, in *AddWithdrawAddressRequest, opts ...grpc.CallOption) (*AddWithdrawAddressReply, error)
+	// 删除提现地址
+	DeleteWithdrawAddress(ctx context.Context, in *DeleteWithdrawAddressRequest, opts ...grpc.CallOption) (*DeleteWithdrawAddressReply, error)
+	// 手续费报价
+	FeeQuote(ctx context.Context, in *FeeQuoteRequest, opts ...grpc.CallOption) (*FeeQuoteReply, error)
+	// 币种兑换
+	Exchange(ctx context.Context, in *ExchangeRequest, opts ...grpc.CallOption) (*ExchangeReply, error)
+	// 站内信列表
+	NotifyList(ctx context.Context, in *NotifyListRequest, opts ...grpc.CallOption) (*NotifyListReply, error)
+	// 站内信标记已读
+	NotifyRead(ctx context.Context, in *NotifyReadRequest, opts ...grpc.CallOption) (*NotifyReadReply, error)
+	// 通知设置
+	NotifySetting(ctx context.Context, in *NotifySettingRequest, opts ...grpc.CallOption) (*NotifySettingReply, error)
+	// 修改通知设置
+	SetNotifySetting(ctx context.Context, in *SetNotifySettingRequest, opts ...grpc.CallOption) (*SetNotifySettingReply, error)
+	// 推送连接票据，一次性、短期有效，浏览器 EventSource 不能设置请求头时放在 ?ticket= 中
+	StreamTicket(ctx context.Context, in *StreamTicketRequest, opts ...grpc.CallOption) (*StreamTicketReply, error)
+	// 两步验证状态
+	TotpStatus(ctx context.Context, in *TotpStatusRequest, opts ...grpc.CallOption) (*TotpStatusReply, error)
+	// 生成两步验证密钥，验证器扫描后调用 TotpEnable 开启
+	TotpSetup(ctx context.Context, in *TotpSetupRequest, opts ...grpc.CallOption) (*TotpSetupReply, error)
+	// 开启两步验证，返回恢复码，只显示一次
+	TotpEnable(ctx context.Context, in *TotpEnableRequest, opts ...grpc.CallOption) (*TotpEnableReply, error)
+	// 关闭两步验证
+	TotpDisable(ctx context.Context, in *TotpDisableRequest, opts ...grpc.CallOption) (*TotpDisableReply, error)
+	// 导出账户数据，data 为 JSON，signature 为对 data 的 ed25519 签名；zip 包下载 /api/app_server/data_export_zip
+	DataExport(ctx context.Context, in *DataExportRequest, opts ...grpc.CallOption) (*DataExportReply, error)
+	// 注销账户，余额为零且卡片都已注销时匿名化个人信息，交易记录按规定保留
+	AccountErase(ctx context.Context, in *AccountEraseRequest, opts ...grpc.CallOption) (*AccountEraseReply, error)
+	// 审计日志查询，需要请求头 X-Admin-Key
+	AuditList(ctx context.Context, in *AuditListRequest, opts ...grpc.CallOption) (*AuditListReply, error)
+	// 审计日志哈希链校验，需要请求头 X-Admin-Key
+	AuditVerify(ctx context.Context, in *AuditVerifyRequest, opts ...grpc.CallOption) (*AuditVerifyReply, error)
+	// 风控记录，status 为 review 时为待人工审核队列，需要请求头 X-Admin-Key
+	RiskReviewList(ctx context.Context, in *RiskReviewListRequest, opts ...grpc.CallOption) (*RiskReviewListReply, error)
+	// 审核风控冻结的提现和转账，通过后执行，拒绝后退回冻结金额，需要请求头 X-Admin-Key
+	RiskReview(ctx context.Context, in *RiskReviewRequest, opts ...grpc.CallOption) (*RiskReviewReply, error)
+	// 代用户导出账户数据，zip 包下载 /api/admin/data_export_zip?userId=，需要请求头 X-Admin-Key
+	AdminDataExport(ctx context.Context, in *AdminDataExportRequest, opts ...grpc.CallOption) (*DataExportReply, error)
+	// 代用户注销账户，需要请求头 X-Admin-Key
+	AdminAccountErase(ctx context.Context, in *AdminAccountEraseRequest, opts ...grpc.CallOption) (*AccountEraseReply, error)
 }
 
 type userClient struct {
@@ -170,6 +243,15 @@ func (c *userClient) CodeList(ctx context.Context, in *CodeListRequest, opts ...
 	return out, nil
 }
 
+func (c *userClient) CardList(ctx context.Context, in *CardListRequest, opts ...grpc.CallOption) (*CardListReply, error) {
+	out := new(CardListReply)
+	err := c.cc.Invoke(ctx, User_CardList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) OpenCard(ctx context.Context, in *OpenCardRequest, opts ...grpc.CallOption) (*OpenCardReply, error) {
 	out := new(OpenCardReply)
 	err := c.cc.Invoke(ctx, User_OpenCard_FullMethodName, in, out, opts...)
@@ -233,6 +315,15 @@ func (c *userClient) ChangePin(ctx context.Context, in *ChangePinRequest, opts .
 	return out, nil
 }
 
+func (c *userClient) PinKey(ctx context.Context, in *PinKeyRequest, opts ...grpc.CallOption) (*PinKeyReply, error) {
+	out := new(PinKeyReply)
+	err := c.cc.Invoke(ctx, User_PinKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) AmountToCard(ctx context.Context, in *AmountToCardRequest, opts ...grpc.CallOption) (*AmountToCardReply, error) {
 	out := new(AmountToCardReply)
 	err := c.cc.Invoke(ctx, User_AmountToCard_FullMethodName, in, out, opts...)
@@ -269,6 +360,204 @@ func (c *userClient) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...
 	return out, nil
 }
 
+func (c *userClient) WithdrawAddressList(ctx context.Context, in *WithdrawAddressListRequest, opts ...grpc.CallOption) (*WithdrawAddressListReply, error) {
+	out := new(WithdrawAddressListReply)
+	err := c.cc.Invoke(ctx, User_WithdrawAddressList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AddWithdrawAddress(ctx context.Context, in *AddWithdrawAddressRequest, opts ...grpc.CallOption) (*AddWithdrawAddressReply, error) {
+	out := new(AddWithdrawAddressReply)
+	err := c.cc.Invoke(ctx, User_AddWithdrawAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) DeleteWithdrawAddress(ctx context.Context, in *DeleteWithdrawAddressRequest, opts ...grpc.CallOption) (*DeleteWithdrawAddressReply, error) {
+	out := new(DeleteWithdrawAddressReply)
+	err := c.cc.Invoke(ctx, User_DeleteWithdrawAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) FeeQuote(ctx context.Context, in *FeeQuoteRequest, opts ...grpc.CallOption) (*FeeQuoteReply, error) {
+	out := new(FeeQuoteReply)
+	err := c.cc.Invoke(ctx, User_FeeQuote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Exchange(ctx context.Context, in *ExchangeRequest, opts ...grpc.CallOption) (*ExchangeReply, error) {
+	out := new(ExchangeReply)
+	err := c.cc.Invoke(ctx, User_Exchange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) NotifyList(ctx context.Context, in *NotifyListRequest, opts ...grpc.CallOption) (*NotifyListReply, error) {
+	out := new(NotifyListReply)
+	err := c.cc.Invoke(ctx, User_NotifyList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) NotifyRead(ctx context.Context, in *NotifyReadRequest, opts ...grpc.CallOption) (*NotifyReadReply, error) {
+	out := new(NotifyReadReply)
+	err := c.cc.Invoke(ctx, User_NotifyRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) NotifySetting(ctx context.Context, in *NotifySettingRequest, opts ...grpc.CallOption) (*NotifySettingReply, error) {
+	out := new(NotifySettingReply)
+	err := c.cc.Invoke(ctx, User_NotifySetting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SetNotifySetting(ctx context.Context, in *SetNotifySettingRequest, opts ...grpc.CallOption) (*SetNotifySettingReply, error) {
+	out := new(SetNotifySettingReply)
+	err := c.cc.Invoke(ctx, User_SetNotifySetting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) StreamTicket(ctx context.Context, in *StreamTicketRequest, opts ...grpc.CallOption) (*StreamTicketReply, error) {
+	out := new(StreamTicketReply)
+	err := c.cc.Invoke(ctx, User_StreamTicket_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) TotpStatus(ctx context.Context, in *TotpStatusRequest, opts ...grpc.CallOption) (*TotpStatusReply, error) {
+	out := new(TotpStatusReply)
+	err := c.cc.Invoke(ctx, User_TotpStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) TotpSetup(ctx context.Context, in *TotpSetupRequest, opts ...grpc.CallOption) (*TotpSetupReply, error) {
+	out := new(TotpSetupReply)
+	err := c.cc.Invoke(ctx, User_TotpSetup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) TotpEnable(ctx context.Context, in *TotpEnableRequest, opts ...grpc.CallOption) (*TotpEnableReply, error) {
+	out := new(TotpEnableReply)
+	err := c.cc.Invoke(ctx, User_TotpEnable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) TotpDisable(ctx context.Context, in *TotpDisableRequest, opts ...grpc.CallOption) (*TotpDisableReply, error) {
+	out := new(TotpDisableReply)
+	err := c.cc.Invoke(ctx, User_TotpDisable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) DataExport(ctx context.Context, in *DataExportRequest, opts ...grpc.CallOption) (*DataExportReply, error) {
+	out := new(DataExportReply)
+	err := c.cc.Invoke(ctx, User_DataExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AccountErase(ctx context.Context, in *AccountEraseRequest, opts ...grpc.CallOption) (*AccountEraseReply, error) {
+	out := new(AccountEraseReply)
+	err := c.cc.Invoke(ctx, User_AccountErase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AuditList(ctx context.Context, in *AuditListRequest, opts ...grpc.CallOption) (*AuditListReply, error) {
+	out := new(AuditListReply)
+	err := c.cc.Invoke(ctx, User_AuditList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AuditVerify(ctx context.Context, in *AuditVerifyRequest, opts ...grpc.CallOption) (*AuditVerifyReply, error) {
+	out := new(AuditVerifyReply)
+	err := c.cc.Invoke(ctx, User_AuditVerify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RiskReviewList(ctx context.Context, in *RiskReviewListRequest, opts ...grpc.CallOption) (*RiskReviewListReply, error) {
+	out := new(RiskReviewListReply)
+	err := c.cc.Invoke(ctx, User_RiskReviewList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RiskReview(ctx context.Context, in *RiskReviewRequest, opts ...grpc.CallOption) (*RiskReviewReply, error) {
+	out := new(RiskReviewReply)
+	err := c.cc.Invoke(ctx, User_RiskReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminDataExport(ctx context.Context, in *AdminDataExportRequest, opts ...grpc.CallOption) (*DataExportReply, error) {
+	out := new(DataExportReply)
+	err := c.cc.Invoke(ctx, User_AdminDataExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminAccountErase(ctx context.Context, in *AdminAccountEraseRequest, opts ...grpc.CallOption) (*AccountEraseReply, error) {
+	out := new(AccountEraseReply)
+	err := c.cc.Invoke(ctx, User_AdminAccountErase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServer is the server API for User service.
 // All implementations must embed UnimplementedUserServer
 // for forward compatibility
@@ -288,6 +577,8 @@ type UserServer interface {
 	RecordList(context.Context, *RecordListRequest) (*RecordListReply, error)
 	// 明细列表
 	CodeList(context.Context, *CodeListRequest) (*CodeListReply, error)
+	// 我的卡片
+	CardList(context.Context, *CardListRequest) (*CardListReply, error)
 	// 开卡
 	OpenCard(context.Context, *OpenCardRequest) (*OpenCardReply, error)
 	CheckCard(context.Context, *CheckCardRequest) (*CheckCardReply, error)
@@ -298,7 +589,10 @@ type UserServer interface {
 	LookCardNew(context.Context, *LookCardRequest) (*LookCardReply, error)
 	// 补卡
 	LookCardNewTwo(context.Context, *LookCardRequest) (*LookCardReply, error)
+	// 修改卡片PIN，PIN 用 PinKey 返回的公钥加密后放在 encryptedPin
 	ChangePin(context.Context, *ChangePinRequest) (*ChangePinReply, error)
+	// 加密PIN的RSA公钥，客户端用 RSA-OAEP(SHA-256) 加密
+	PinKey(context.Context, *PinKeyRequest) (*PinKeyReply, error)
 	// 划转
 	AmountToCard(context.Context, *AmountToCardRequest) (*AmountToCardReply, error)
 	// 设置级别给下级
@@ -307,6 +601,50 @@ type UserServer interface {
 	AmountTo(context.Context, *AmountToRequest) (*AmountToReply, error)
 	// 提现
 	Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error)
+	// 提现地址簿
+	WithdrawAddressList(context.Context, *WithdrawAddressListRequest) (*WithdrawAddressListReply, error)
+	// 添加提现地址，冷却期结束后才能用于提现
+	AddWithdrawAddress(context.Context, *AddWithdrawAddressRequest) (*AddWithdrawAddressReply, error)
+	// 删除提现地址
+	DeleteWithdrawAddress(context.Context, *DeleteWithdrawAddressRequest) (*DeleteWithdrawAddressReply, error)
+	// 手续费报价
+	FeeQuote(context.Context, *FeeQuoteRequest) (*FeeQuoteReply, error)
+	// 币种兑换
+	Exchange(context.Context, *ExchangeRequest) (*ExchangeReply, error)
+	// 站内信列表
+	NotifyList(context.Context, *NotifyListRequest) (*NotifyListReply, error)
+	// 站内信标记已读
+	NotifyRead(context.Context, *NotifyReadRequest) (*NotifyReadReply, error)
+	// 通知设置
+	NotifySetting(context.Context, *NotifySettingRequest) (*NotifySettingReply, error)
+	// 修改通知设置
+	SetNotifySetting(context.Context, *SetNotifySettingRequest) (*SetNotifySettingReply, error)
+	// 推送连接票据，一次性、短期有效，浏览器 EventSource 不能设置请求头时放在 ?ticket= 中
+	StreamTicket(context.Context, *StreamTicketRequest) (*StreamTicketReply, error)
+	// 两步验证状态
+	TotpStatus(context.Context, *TotpStatusRequest) (*TotpStatusReply, error)
+	// 生成两步验证密钥，验证器扫描后调用 TotpEnable 开启
+	TotpSetup(context.Context, *TotpSetupRequest) (*TotpSetupReply, error)
+	// 开启两步验证，返回恢复码，只显示一次
+	TotpEnable(context.Context, *TotpEnableRequest) (*TotpEnableReply, error)
+	// 关闭两步验证
+	TotpDisable(context.Context, *TotpDisableRequest) (*TotpDisableReply, error)
+	// 导出账户数据，data 为 JSON，signature 为对 data 的 ed25519 签名；zip 包下载 /api/app_server/data_export_zip
+	DataExport(context.Context, *DataExportRequest) (*DataExportReply, error)
+	// 注销账户，余额为零且卡片都已注销时匿名化个人信息，交易记录按规定保留
+	AccountErase(context.Context, *AccountEraseRequest) (*AccountEraseReply, error)
+	// 审计日志查询，需要请求头 X-Admin-Key
+	AuditList(context.Context, *AuditListRequest) (*AuditListReply, error)
+	// 审计日志哈希链校验，需要请求头 X-Admin-Key
+	AuditVerify(context.Context, *AuditVerifyRequest) (*AuditVerifyReply, error)
+	// 风控记录，status 为 review 时为待人工审核队列，需要请求头 X-Admin-Key
+	RiskReviewList(context.Context, *RiskReviewListRequest) (*RiskReviewListReply, error)
+	// 审核风控冻结的提现和转账，通过后执行，拒绝后退回冻结金额，需要请求头 X-Admin-Key
+	RiskReview(context.Context, *RiskReviewRequest) (*RiskReviewReply, error)
+	// 代用户导出账户数据，zip 包下载 /api/admin/data_export_zip?userId=，需要请求头 X-Admin-Key
+	AdminDataExport(context.Context, *AdminDataExportRequest) (*DataExportReply, error)
+	// 代用户注销账户，需要请求头 X-Admin-Key
+	AdminAccountErase(context.Context, *AdminAccountEraseRequest) (*AccountEraseReply, error)
 	mustEmbedUnimplementedUserServer()
 }
 
@@ -341,6 +679,9 @@ func (UnimplementedUserServer) RecordList(context.Context, *RecordListRequest) (
 func (UnimplementedUserServer) CodeList(context.Context, *CodeListRequest) (*CodeListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CodeList not implemented")
 }
+func (UnimplementedUserServer) CardList(context.Context, *CardListRequest) (*CardListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CardList not implemented")
+}
 func (UnimplementedUserServer) OpenCard(context.Context, *OpenCardRequest) (*OpenCardReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OpenCard not implemented")
 }
@@ -362,6 +703,9 @@ func (UnimplementedUserServer) LookCardNewTwo(context.Context, *LookCardRequest)
 func (UnimplementedUserServer) ChangePin(context.Context, *ChangePinRequest) (*ChangePinReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangePin not implemented")
 }
+func (UnimplementedUserServer) PinKey(context.Context, *PinKeyRequest) (*PinKeyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinKey not implemented")
+}
 func (UnimplementedUserServer) AmountToCard(context.Context, *AmountToCardRequest) (*AmountToCardReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AmountToCard not implemented")
 }
@@ -374,6 +718,72 @@ func (UnimplementedUserServer) AmountTo(context.Context, *AmountToRequest) (*Amo
 func (UnimplementedUserServer) Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
 }
+func (UnimplementedUserServer) WithdrawAddressList(context.Context, *WithdrawAddressListRequest) (*WithdrawAddressListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAddressList not implemented")
+}
+func (UnimplementedUserServer) AddWithdrawAddress(context.Context, *AddWithdrawAddressRequest) (*AddWithdrawAddressReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWithdrawAddress not implemented")
+}
+func (UnimplementedUserServer) DeleteWithdrawAddress(context.Context, *DeleteWithdrawAddressRequest) (*DeleteWithdrawAddressReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWithdrawAddress not implemented")
+}
+func (UnimplementedUserServer) FeeQuote(context.Context, *FeeQuoteRequest) (*FeeQuoteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeQuote not implemented")
+}
+func (UnimplementedUserServer) Exchange(context.Context, *ExchangeRequest) (*ExchangeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exchange not implemented")
+}
+func (UnimplementedUserServer) NotifyList(context.Context, *NotifyListRequest) (*NotifyListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyList not implemented")
+}
+func (UnimplementedUserServer) NotifyRead(context.Context, *NotifyReadRequest) (*NotifyReadReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyRead not implemented")
+}
+func (UnimplementedUserServer) NotifySetting(context.Context, *NotifySettingRequest) (*NotifySettingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifySetting not implemented")
+}
+func (UnimplementedUserServer) SetNotifySetting(context.Context, *SetNotifySettingRequest) (*SetNotifySettingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNotifySetting not implemented")
+}
+func (UnimplementedUserServer) StreamTicket(context.Context, *StreamTicketRequest) (*StreamTicketReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamTicket not implemented")
+}
+func (UnimplementedUserServer) TotpStatus(context.Context, *TotpStatusRequest) (*TotpStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotpStatus not implemented")
+}
+func (UnimplementedUserServer) TotpSetup(context.Context, *TotpSetupRequest) (*TotpSetupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotpSetup not implemented")
+}
+func (UnimplementedUserServer) TotpEnable(context.Context, *TotpEnableRequest) (*TotpEnableReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotpEnable not implemented")
+}
+func (UnimplementedUserServer) TotpDisable(context.Context, *TotpDisableRequest) (*TotpDisableReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotpDisable not implemented")
+}
+func (UnimplementedUserServer) DataExport(context.Context, *DataExportRequest) (*DataExportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataExport not implemented")
+}
+func (UnimplementedUserServer) AccountErase(context.Context, *AccountEraseRequest) (*AccountEraseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountErase not implemented")
+}
+func (UnimplementedUserServer) AuditList(context.Context, *AuditListRequest) (*AuditListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditList not implemented")
+}
+func (UnimplementedUserServer) AuditVerify(context.Context, *AuditVerifyRequest) (*AuditVerifyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditVerify not implemented")
+}
+func (UnimplementedUserServer) RiskReviewList(context.Context, *RiskReviewListRequest) (*RiskReviewListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RiskReviewList not implemented")
+}
+func (UnimplementedUserServer) RiskReview(context.Context, *RiskReviewRequest) (*RiskReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RiskReview not implemented")
+}
+func (UnimplementedUserServer) AdminDataExport(context.Context, *AdminDataExportRequest) (*DataExportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDataExport not implemented")
+}
+func (UnimplementedUserServer) AdminAccountErase(context.Context, *AdminAccountEraseRequest) (*AccountEraseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminAccountErase not implemented")
+}
 func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
 
 // UnsafeUserServer may be embedded to opt out of forward compatibility for this service.
@@ -549,6 +959,24 @@ func _User_CodeList_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_CardList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CardList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_CardList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CardList(ctx, req.(*CardListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _User_OpenCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OpenCardRequest)
 	if err := dec(in); err != nil {
@@ -675,6 +1103,24 @@ func _User_ChangePin_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_PinKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).PinKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_PinKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).PinKey(ctx, req.(*PinKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _User_AmountToCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AmountToCardRequest)
 	if err := dec(in); err != nil {
@@ -747,68 +1193,468 @@ func _User_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
-// User_ServiceDesc is the grpc.ServiceDesc for User service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var User_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "api.user.v1.User",
-	HandlerType: (*UserServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateNonce",
-			Handler:    _User_CreateNonce_Handler,
-		},
-		{
-			MethodName: "EthAuthorize",
-			Handler:    _User_EthAuthorize_Handler,
-		},
-		{
-			MethodName: "GetUser",
-			Handler:    _User_GetUser_Handler,
-		},
-		{
-			MethodName: "UserRecommend",
-			Handler:    _User_UserRecommend_Handler,
-		},
-		{
-			MethodName: "OrderList",
-			Handler:    _User_OrderList_Handler,
-		},
-		{
-			MethodName: "OrderListTwo",
-			Handler:    _User_OrderListTwo_Handler,
-		},
-		{
-			MethodName: "RewardList",
-			Handler:    _User_RewardList_Handler,
-		},
-		{
-			MethodName: "RecordList",
-			Handler:    _User_RecordList_Handler,
-		},
-		{
-			MethodName: "CodeList",
-			Handler:    _User_CodeList_Handler,
-		},
-		{
-			MethodName: "OpenCard",
-			Handler:    _User_OpenCard_Handler,
-		},
-		{
-			MethodName: "CheckCard",
-			Handler:    _User_CheckCard_Handler,
-		},
-		{
-			MethodName: "OpenCardTwo",
-			Handler:    _User_OpenCardTwo_Handler,
-		},
-		{
-			MethodName: "LookCard",
-			Handler:    _User_LookCard_Handler,
-		},
-		{
-			MethodName: "LookCardNew",
-			Handler:    _User_LookCardNew_Handler,
+func _User_WithdrawAddressList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawAddressListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).WithdrawAddressList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_WithdrawAddressList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).WithdrawAddressList(ctx, req.(*WithdrawAddressListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AddWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWithdrawAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AddWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AddWithdrawAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AddWithdrawAddress(ctx, req.(*AddWithdrawAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_DeleteWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWithdrawAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).DeleteWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_DeleteWithdrawAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).DeleteWithdrawAddress(ctx, req.(*DeleteWithdrawAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_FeeQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).FeeQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_FeeQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).FeeQuote(ctx, req.(*FeeQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Exchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Exchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Exchange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Exchange(ctx, req.(*ExchangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_NotifyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).NotifyList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_NotifyList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).NotifyList(ctx, req.(*NotifyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_NotifyRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).NotifyRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_NotifyRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).NotifyRead(ctx, req.(*NotifyReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_NotifySetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifySettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).NotifySetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_NotifySetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).NotifySetting(ctx, req.(*NotifySettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetNotifySetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNotifySettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetNotifySetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SetNotifySetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetNotifySetting(ctx, req.(*SetNotifySettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_StreamTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).StreamTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_StreamTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).StreamTicket(ctx, req.(*StreamTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_TotpStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotpStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).TotpStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_TotpStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).TotpStatus(ctx, req.(*TotpStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_TotpSetup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotpSetupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).TotpSetup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_TotpSetup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).TotpSetup(ctx, req.(*TotpSetupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_TotpEnable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotpEnableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).TotpEnable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_TotpEnable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).TotpEnable(ctx, req.(*TotpEnableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_TotpDisable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotpDisableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).TotpDisable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_TotpDisable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).TotpDisable(ctx, req.(*TotpDisableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_DataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).DataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_DataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).DataExport(ctx, req.(*DataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AccountErase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountEraseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AccountErase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AccountErase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AccountErase(ctx, req.(*AccountEraseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AuditList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AuditList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AuditList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AuditList(ctx, req.(*AuditListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AuditVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AuditVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AuditVerify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AuditVerify(ctx, req.(*AuditVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RiskReviewList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RiskReviewListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RiskReviewList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RiskReviewList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RiskReviewList(ctx, req.(*RiskReviewListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RiskReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RiskReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RiskReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RiskReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RiskReview(ctx, req.(*RiskReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminDataExport(ctx, req.(*AdminDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminAccountErase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminAccountEraseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminAccountErase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminAccountErase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminAccountErase(ctx, req.(*AdminAccountEraseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// User_ServiceDesc is the grpc.ServiceDesc for User service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var User_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.user.v1.User",
+	HandlerType: (*UserServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateNonce",
+			Handler:    _User_CreateNonce_Handler,
+		},
+		{
+			MethodName: "EthAuthorize",
+			Handler:    _User_EthAuthorize_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _User_GetUser_Handler,
+		},
+		{
+			MethodName: "UserRecommend",
+			Handler:    _User_UserRecommend_Handler,
+		},
+		{
+			MethodName: "OrderList",
+			Handler:    _User_OrderList_Handler,
+		},
+		{
+			MethodName: "OrderListTwo",
+			Handler:    _User_OrderListTwo_Handler,
+		},
+		{
+			MethodName: "RewardList",
+			Handler:    _User_RewardList_Handler,
+		},
+		{
+			MethodName: "RecordList",
+			Handler:    _User_RecordList_Handler,
+		},
+		{
+			MethodName: "CodeList",
+			Handler:    _User_CodeList_Handler,
+		},
+		{
+			MethodName: "CardList",
+			Handler:    _User_CardList_Handler,
+		},
+		{
+			MethodName: "OpenCard",
+			Handler:    _User_OpenCard_Handler,
+		},
+		{
+			MethodName: "CheckCard",
+			Handler:    _User_CheckCard_Handler,
+		},
+		{
+			MethodName: "OpenCardTwo",
+			Handler:    _User_OpenCardTwo_Handler,
+		},
+		{
+			MethodName: "LookCard",
+			Handler:    _User_LookCard_Handler,
+		},
+		{
+			MethodName: "LookCardNew",
+			Handler:    _User_LookCardNew_Handler,
 		},
 		{
 			MethodName: "LookCardNewTwo",
@@ -818,6 +1664,10 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangePin",
 			Handler:    _User_ChangePin_Handler,
 		},
+		{
+			MethodName: "PinKey",
+			Handler:    _User_PinKey_Handler,
+		},
 		{
 			MethodName: "AmountToCard",
 			Handler:    _User_AmountToCard_Handler,
@@ -834,6 +1684,94 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Withdraw",
 			Handler:    _User_Withdraw_Handler,
 		},
+		{
+			MethodName: "WithdrawAddressList",
+			Handler:    _User_WithdrawAddressList_Handler,
+		},
+		{
+			MethodName: "AddWithdrawAddress",
+			Handler:    _User_AddWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "DeleteWithdrawAddress",
+			Handler:    _User_DeleteWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "FeeQuote",
+			Handler:    _User_FeeQuote_Handler,
+		},
+		{
+			MethodName: "Exchange",
+			Handler:    _User_Exchange_Handler,
+		},
+		{
+			MethodName: "NotifyList",
+			Handler:    _User_NotifyList_Handler,
+		},
+		{
+			MethodName: "NotifyRead",
+			Handler:    _User_NotifyRead_Handler,
+		},
+		{
+			MethodName: "NotifySetting",
+			Handler:    _User_NotifySetting_Handler,
+		},
+		{
+			MethodName: "SetNotifySetting",
+			Handler:    _User_SetNotifySetting_Handler,
+		},
+		{
+			MethodName: "StreamTicket",
+			Handler:    _User_StreamTicket_Handler,
+		},
+		{
+			MethodName: "TotpStatus",
+			Handler:    _User_TotpStatus_Handler,
+		},
+		{
+			MethodName: "TotpSetup",
+			Handler:    _User_TotpSetup_Handler,
+		},
+		{
+			MethodName: "TotpEnable",
+			Handler:    _User_TotpEnable_Handler,
+		},
+		{
+			MethodName: "TotpDisable",
+			Handler:    _User_TotpDisable_Handler,
+		},
+		{
+			MethodName: "DataExport",
+			Handler:    _User_DataExport_Handler,
+		},
+		{
+			MethodName: "AccountErase",
+			Handler:    _User_AccountErase_Handler,
+		},
+		{
+			MethodName: "AuditList",
+			Handler:    _User_AuditList_Handler,
+		},
+		{
+			MethodName: "AuditVerify",
+			Handler:    _User_AuditVerify_Handler,
+		},
+		{
+			MethodName: "RiskReviewList",
+			Handler:    _User_RiskReviewList_Handler,
+		},
+		{
+			MethodName: "RiskReview",
+			Handler:    _User_RiskReview_Handler,
+		},
+		{
+			MethodName: "AdminDataExport",
+			Handler:    _User_AdminDataExport_Handler,
+		},
+		{
+			MethodName: "AdminAccountErase",
+			Handler:    _User_AdminAccountErase_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/user/v1/user.proto",
@@ -19,38 +19,85 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationUserAccountErase = "/api.user.v1.User/AccountErase"
+const OperationUserAddWithdrawAddress = "/api.user.v1.User/AddWithdrawAddress"
+const OperationUserAdminAccountErase = "/api.user.v1.User/AdminAccountErase"
+const OperationUserAdminDataExport = "/api.user.v1.User/AdminDataExport"
 const OperationUserAmountTo = "/api.user.v1.User/AmountTo"
 const OperationUserAmountToCard = "/api.user.v1.User/AmountToCard"
+const OperationUserAuditList = "/api.user.v1.User/AuditList"
+const OperationUserAuditVerify = "/api.user.v1.User/AuditVerify"
+const OperationUserCardList = "/api.user.v1.User/CardList"
 const OperationUserChangePin = "/api.user.v1.User/ChangePin"
 const OperationUserCheckCard = "/api.user.v1.User/CheckCard"
 const OperationUserCodeList = "/api.user.v1.User/CodeList"
 const OperationUserCreateNonce = "/api.user.v1.User/CreateNonce"
+const OperationUserDataExport = "/api.user.v1.User/DataExport"
+const OperationUserDeleteWithdrawAddress = "/api.user.v1.User/DeleteWithdrawAddress"
 const OperationUserEthAuthorize = "/api.user.v1.User/EthAuthorize"
+const OperationUserExchange = "/api.user.v1.User/Exchange"
+const OperationUserFeeQuote = "/api.user.v1.User/FeeQuote"
 const OperationUserGetUser = "/api.user.v1.User/GetUser"
 const OperationUserLookCard = "/api.user.v1.User/LookCard"
 const OperationUserLookCardNew = "/api.user.v1.User/LookCardNew"
 const OperationUserLookCardNewTwo = "/api.user.v1.User/LookCardNewTwo"
+const OperationUserNotifyList = "/api.user.v1.User/NotifyList"
+const OperationUserNotifyRead = "/api.user.v1.User/NotifyRead"
+const OperationUserNotifySetting = "/api.user.v1.User/NotifySetting"
 const OperationUserOpenCard = "/api.user.v1.User/OpenCard"
 const OperationUserOpenCardTwo = "/api.user.v1.User/OpenCardTwo"
 const OperationUserOrderList = "/api.user.v1.User/OrderList"
 const OperationUserOrderListTwo = "/api.user.v1.User/OrderListTwo"
+const OperationUserPinKey = "/api.user.v1.User/PinKey"
 const OperationUserRecordList = "/api.user.v1.User/RecordList"
 const OperationUserRewardList = "/api.user.v1.User/RewardList"
+const OperationUserRiskReview = "/api.user.v1.User/RiskReview"
+const OperationUserRiskReviewList = "/api.user.v1.User/RiskReviewList"
+const OperationUserSetNotifySetting = "/api.user.v1.User/SetNotifySetting"
 const OperationUserSetVip = "/api.user.v1.User/SetVip"
+const OperationUserStreamTicket = "/api.user.v1.User/StreamTicket"
+const OperationUserTotpDisable = "/api.user.v1.User/TotpDisable"
+const OperationUserTotpEnable = "/api.user.v1.User/TotpEnable"
+const OperationUserTotpSetup = "/api.user.v1.User/TotpSetup"
+const OperationUserTotpStatus = "/api.user.v1.User/TotpStatus"
 const OperationUserUserRecommend = "/api.user.v1.User/UserRecommend"
 const OperationUserWithdraw = "/api.user.v1.User/Withdraw"
+const OperationUserWithdrawAddressList = "/api.user.v1.User/WithdrawAddressList"
 
 type UserHTTPServer interface {
+	// AccountErase 注销账户，余额为零且卡片都已注销时匿名化个人信息，交易记录按规定保留
+	AccountErase(context.Context, *AccountEraseRequest) (*AccountEraseReply, error)
+	// AddWithdrawAddress 添加提现地址，冷却期结束后才能用于提现
+	AddWithdrawAddress(context.Context, *AddWithdrawAddressRequest) (*AddWithdrawAddressReply, error)
+	// AdminAccountErase 代用户注销账户，需要请求头 X-Admin-Key
+	AdminAccountErase(context.Context, *AdminAccountEraseRequest) (*AccountEraseReply, error)
+	// AdminDataExport 代用户导出账户数据，zip 包下载 /api/admin/data_export_zip?userId=，需要请求头 X-Admin-Key
+	AdminDataExport(context.Context, *AdminDataExportRequest) (*DataExportReply, error)
 	// AmountTo 转账
 	AmountTo(context.Context, *AmountToRequest) (*AmountToReply, error)
 	// AmountToCard 划转
 	AmountToCard(context.Context, *AmountToCardRequest) (*AmountToCardReply, error)
+	// AuditList 审计日志查询，需要请求头 X-Admin-Key
+	AuditList(context.Context, *AuditListRequest) (*AuditListReply, error)
+	// AuditVerify 审计日志哈希链校验，需要请求头 X-Admin-Key
+	AuditVerify(context.Context, *AuditVerifyRequest) (*AuditVerifyReply, error)
+	// CardList 我的卡片
+	CardList(context.Context, *CardListRequest) (*CardListReply, error)
+	// ChangePin 修改卡片PIN，PIN 用 PinKey 返回的公钥加密后放在 encryptedPin
 	ChangePin(context.Context, *ChangePinRequest) (*ChangePinReply, error)
 	CheckCard(context.Context, *CheckCardRequest) (*CheckCardReply, error)
 	// CodeList 明细列表
 	CodeList(context.Context, *CodeListRequest) (*CodeListReply, error)
 	CreateNonce(context.Context, *CreateNonceRequest) (*CreateNonceReply, error)
+	// DataExport 导出账户数据，data 为 JSON，signature 为对 data 的 ed25519 签名；zip 包下载 /api/app_server/data_export_zip
+	DataExport(context.Context, *DataExportRequest) (*DataExportReply, error)
+	// DeleteWithdrawAddress 删除提现地址
+	DeleteWithdrawAddress(context.Context, *DeleteWithdrawAddressRequest) (*DeleteWithdrawAddressReply, error)
 	EthAuthorize(context.Context, *EthAuthorizeRequest) (*EthAuthorizeReply, error)
+	// Exchange 币种兑换
+	Exchange(context.Context, *ExchangeRequest) (*ExchangeReply, error)
+	// FeeQuote 手续费报价
+	FeeQuote(context.Context, *FeeQuoteRequest) (*FeeQuoteReply, error)
 	// GetUser 个人信息
 	GetUser(context.Context, *GetUserRequest) (*GetUserReply, error)
 	// LookCard 开卡
@@ -59,22 +106,48 @@ type UserHTTPServer interface {
 	LookCardNew(context.Context, *LookCardRequest) (*LookCardReply, error)
 	// LookCardNewTwo 补卡
 	LookCardNewTwo(context.Context, *LookCardRequest) (*LookCardReply, error)
+	// NotifyList 站内信列表
+	NotifyList(context.Context, *NotifyListRequest) (*NotifyListReply, error)
+	// NotifyRead 站内信标记已读
+	NotifyRead(context.Context, *NotifyReadRequest) (*NotifyReadReply, error)
+	// NotifySetting 通知设置
+	NotifySetting(context.Context, *NotifySettingRequest) (*NotifySettingReply, error)
 	// OpenCard 开卡
 	OpenCard(context.Context, *OpenCardRequest) (*OpenCardReply, error)
 	OpenCardTwo(context.Context, *OpenCardRequest) (*OpenCardReply, error)
 	// OrderList 账单列表
 	OrderList(context.Context, *OrderListRequest) (*OrderListReply, error)
 	OrderListTwo(context.Context, *OrderListTwoRequest) (*OrderListTwoReply, error)
+	// PinKey 加密PIN的RSA公钥，客户端用 RSA-OAEP(SHA-256) 加密
+	PinKey(context.Context, *PinKeyRequest) (*PinKeyReply, error)
 	// RecordList 明细列表
 	RecordList(context.Context, *RecordListRequest) (*RecordListReply, error)
 	// RewardList 明细列表
 	RewardList(context.Context, *RewardListRequest) (*RewardListReply, error)
+	// RiskReview 审核风控冻结的提现和转账，通过后执行，拒绝后退回冻结金额，需要请求头 X-Admin-Key
+	RiskReview(context.Context, *RiskReviewRequest) (*RiskReviewReply, error)
+	// RiskReviewList 风控记录，status 为 review 时为待人工审核队列，需要请求头 X-Admin-Key
+	RiskReviewList(context.Context, *RiskReviewListRequest) (*RiskReviewListReply, error)
+	// SetNotifySetting 修改通知设置
+	SetNotifySetting(context.Context, *SetNotifySettingRequest) (*SetNotifySettingReply, error)
 	// SetVip 设置级别给下级
 	SetVip(context.Context, *SetVipRequest) (*SetVipReply, error)
+	// StreamTicket 推送连接票据，一次性、短期有效，浏览器 EventSource 不能设置请求头时放在 ?ticket= 中
+	StreamTicket(context.Context, *StreamTicketRequest) (*StreamTicketReply, error)
+	// TotpDisable 关闭两步验证
+	TotpDisable(context.Context, *TotpDisableRequest) (*TotpDisableReply, error)
+	// TotpEnable 开启两步验证，返回恢复码，只显示一次
+	TotpEnable(context.Context, *TotpEnableRequest) (*TotpEnableReply, error)
+	// TotpSetup 生成两步验证密钥，验证器扫描后调用 TotpEnable 开启
+	TotpSetup(context.Context, *TotpSetupRequest) (*TotpSetupReply, error)
+	// TotpStatus 两步验证状态
+	TotpStatus(context.Context, *TotpStatusRequest) (*TotpStatusReply, error)
 	// UserRecommend 团队信息
 	UserRecommend(context.Context, *RecommendListRequest) (*RecommendListReply, error)
 	// Withdraw 提现
 	Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error)
+	// WithdrawAddressList 提现地址簿
+	WithdrawAddressList(context.Context, *WithdrawAddressListRequest) (*WithdrawAddressListReply, error)
 }
 
 func RegisterUserHTTPServer(s *http.Server, srv UserHTTPServer) {
@@ -88,6 +161,7 @@ func RegisterUserHTTPServer(s *http.Server, srv UserHTTPServer) {
 	r.GET("/api/app_server/reward_list", _User_RewardList0_HTTP_Handler(srv))
 	r.GET("/api/app_server/record_list", _User_RecordList0_HTTP_Handler(srv))
 	r.GET("/api/app_server/code_list", _User_CodeList0_HTTP_Handler(srv))
+	r.GET("/api/app_server/card_list", _User_CardList0_HTTP_Handler(srv))
 	r.POST("/api/app_server/open_card", _User_OpenCard0_HTTP_Handler(srv))
 	r.POST("/api/app_server/check_card", _User_CheckCard0_HTTP_Handler(srv))
 	r.POST("/api/app_server/open_card_two", _User_OpenCardTwo0_HTTP_Handler(srv))
@@ -95,10 +169,33 @@ func RegisterUserHTTPServer(s *http.Server, srv UserHTTPServer) {
 	r.POST("/api/app_server/look_card_new", _User_LookCardNew0_HTTP_Handler(srv))
 	r.POST("/api/app_server/change_card", _User_LookCardNewTwo0_HTTP_Handler(srv))
 	r.POST("/api/app_server/change_pin", _User_ChangePin0_HTTP_Handler(srv))
+	r.GET("/api/app_server/pin_key", _User_PinKey0_HTTP_Handler(srv))
 	r.POST("/api/app_server/amount_to_card", _User_AmountToCard0_HTTP_Handler(srv))
 	r.POST("/api/app_server/set_vip", _User_SetVip0_HTTP_Handler(srv))
 	r.POST("/api/app_server/amount_to", _User_AmountTo0_HTTP_Handler(srv))
 	r.POST("/api/app_server/withdraw", _User_Withdraw0_HTTP_Handler(srv))
+	r.GET("/api/app_server/withdraw_address_list", _User_WithdrawAddressList0_HTTP_Handler(srv))
+	r.POST("/api/app_server/withdraw_address_add", _User_AddWithdrawAddress0_HTTP_Handler(srv))
+	r.POST("/api/app_server/withdraw_address_delete", _User_DeleteWithdrawAddress0_HTTP_Handler(srv))
+	r.GET("/api/app_server/fee_quote", _User_FeeQuote0_HTTP_Handler(srv))
+	r.POST("/api/app_server/exchange", _User_Exchange0_HTTP_Handler(srv))
+	r.GET("/api/app_server/notify_list", _User_NotifyList0_HTTP_Handler(srv))
+	r.POST("/api/app_server/notify_read", _User_NotifyRead0_HTTP_Handler(srv))
+	r.GET("/api/app_server/notify_setting", _User_NotifySetting0_HTTP_Handler(srv))
+	r.POST("/api/app_server/set_notify_setting", _User_SetNotifySetting0_HTTP_Handler(srv))
+	r.POST("/api/app_server/stream_ticket", _User_StreamTicket0_HTTP_Handler(srv))
+	r.GET("/api/app_server/totp_status", _User_TotpStatus0_HTTP_Handler(srv))
+	r.POST("/api/app_server/totp_setup", _User_TotpSetup0_HTTP_Handler(srv))
+	r.POST("/api/app_server/totp_enable", _User_TotpEnable0_HTTP_Handler(srv))
+	r.POST("/api/app_server/totp_disable", _User_TotpDisable0_HTTP_Handler(srv))
+	r.GET("/api/app_server/data_export", _User_DataExport0_HTTP_Handler(srv))
+	r.POST("/api/app_server/account_erase", _User_AccountErase0_HTTP_Handler(srv))
+	r.GET("/api/admin/audit_list", _User_AuditList0_HTTP_Handler(srv))
+	r.GET("/api/admin/audit_verify", _User_AuditVerify0_HTTP_Handler(srv))
+	r.GET("/api/admin/risk_review_list", _User_RiskReviewList0_HTTP_Handler(srv))
+	r.POST("/api/admin/risk_review", _User_RiskReview0_HTTP_Handler(srv))
+	r.GET("/api/admin/data_export", _User_AdminDataExport0_HTTP_Handler(srv))
+	r.POST("/api/admin/account_erase", _User_AdminAccountErase0_HTTP_Handler(srv))
 }
 
 func _User_CreateNonce0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
@@ -278,6 +375,25 @@ func _User_CodeList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) err
 	}
 }
 
+func _User_CardList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CardListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCardList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CardList(ctx, req.(*CardListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CardListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _User_OpenCard0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in OpenCardRequest
@@ -390,157 +506,654 @@ func _User_LookCardNew0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context)
 
 func _User_LookCardNewTwo0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in LookCardRequest
-		if err := ctx.Bind(&in.SendBody); err != nil {
-			return err
-		}
+		var in LookCardRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserLookCardNewTwo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LookCardNewTwo(ctx, req.(*LookCardRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LookCardReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ChangePin0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangePinRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserChangePin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ChangePin(ctx, req.(*ChangePinRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ChangePinReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_PinKey0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PinKeyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserPinKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PinKey(ctx, req.(*PinKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PinKeyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AmountToCard0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AmountToCardRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAmountToCard)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AmountToCard(ctx, req.(*AmountToCardRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AmountToCardReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_SetVip0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetVipRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserSetVip)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetVip(ctx, req.(*SetVipRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetVipReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AmountTo0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AmountToRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAmountTo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AmountTo(ctx, req.(*AmountToRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AmountToReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_Withdraw0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in WithdrawRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserWithdraw)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Withdraw(ctx, req.(*WithdrawRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WithdrawReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_WithdrawAddressList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in WithdrawAddressListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserWithdrawAddressList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.WithdrawAddressList(ctx, req.(*WithdrawAddressListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WithdrawAddressListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AddWithdrawAddress0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddWithdrawAddressRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAddWithdrawAddress)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddWithdrawAddress(ctx, req.(*AddWithdrawAddressRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AddWithdrawAddressReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_DeleteWithdrawAddress0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteWithdrawAddressRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserDeleteWithdrawAddress)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteWithdrawAddress(ctx, req.(*DeleteWithdrawAddressRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteWithdrawAddressReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_FeeQuote0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FeeQuoteRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserFeeQuote)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FeeQuote(ctx, req.(*FeeQuoteRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*FeeQuoteReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_Exchange0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExchangeRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserExchange)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Exchange(ctx, req.(*ExchangeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExchangeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_NotifyList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in NotifyListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserNotifyList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.NotifyList(ctx, req.(*NotifyListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*NotifyListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_NotifyRead0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in NotifyReadRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserNotifyRead)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.NotifyRead(ctx, req.(*NotifyReadRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*NotifyReadReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_NotifySetting0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in NotifySettingRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserNotifySetting)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.NotifySetting(ctx, req.(*NotifySettingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*NotifySettingReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_SetNotifySetting0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetNotifySettingRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserSetNotifySetting)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetNotifySetting(ctx, req.(*SetNotifySettingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetNotifySettingReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_StreamTicket0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in StreamTicketRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserStreamTicket)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.StreamTicket(ctx, req.(*StreamTicketRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*StreamTicketReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_TotpStatus0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TotpStatusRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserTotpStatus)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TotpStatus(ctx, req.(*TotpStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TotpStatusReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_TotpSetup0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TotpSetupRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserTotpSetup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TotpSetup(ctx, req.(*TotpSetupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TotpSetupReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_TotpEnable0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TotpEnableRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserTotpEnable)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TotpEnable(ctx, req.(*TotpEnableRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TotpEnableReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_TotpDisable0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TotpDisableRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserTotpDisable)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TotpDisable(ctx, req.(*TotpDisableRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TotpDisableReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_DataExport0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DataExportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserDataExport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DataExport(ctx, req.(*DataExportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DataExportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AccountErase0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AccountEraseRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAccountErase)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AccountErase(ctx, req.(*AccountEraseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AccountEraseReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AuditList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AuditListRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserLookCardNewTwo)
+		http.SetOperation(ctx, OperationUserAuditList)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.LookCardNewTwo(ctx, req.(*LookCardRequest))
+			return srv.AuditList(ctx, req.(*AuditListRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*LookCardReply)
+		reply := out.(*AuditListReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _User_ChangePin0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+func _User_AuditVerify0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in ChangePinRequest
-		if err := ctx.Bind(&in.SendBody); err != nil {
-			return err
-		}
+		var in AuditVerifyRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserChangePin)
+		http.SetOperation(ctx, OperationUserAuditVerify)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.ChangePin(ctx, req.(*ChangePinRequest))
+			return srv.AuditVerify(ctx, req.(*AuditVerifyRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*ChangePinReply)
+		reply := out.(*AuditVerifyReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _User_AmountToCard0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+func _User_RiskReviewList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AmountToCardRequest
-		if err := ctx.Bind(&in.SendBody); err != nil {
-			return err
-		}
+		var in RiskReviewListRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserAmountToCard)
+		http.SetOperation(ctx, OperationUserRiskReviewList)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.AmountToCard(ctx, req.(*AmountToCardRequest))
+			return srv.RiskReviewList(ctx, req.(*RiskReviewListRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*AmountToCardReply)
+		reply := out.(*RiskReviewListReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _User_SetVip0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+func _User_RiskReview0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in SetVipRequest
+		var in RiskReviewRequest
 		if err := ctx.Bind(&in.SendBody); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserSetVip)
+		http.SetOperation(ctx, OperationUserRiskReview)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.SetVip(ctx, req.(*SetVipRequest))
+			return srv.RiskReview(ctx, req.(*RiskReviewRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SetVipReply)
+		reply := out.(*RiskReviewReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _User_AmountTo0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+func _User_AdminDataExport0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AmountToRequest
-		if err := ctx.Bind(&in.SendBody); err != nil {
-			return err
-		}
+		var in AdminDataExportRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserAmountTo)
+		http.SetOperation(ctx, OperationUserAdminDataExport)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.AmountTo(ctx, req.(*AmountToRequest))
+			return srv.AdminDataExport(ctx, req.(*AdminDataExportRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*AmountToReply)
+		reply := out.(*DataExportReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _User_Withdraw0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+func _User_AdminAccountErase0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in WithdrawRequest
+		var in AdminAccountEraseRequest
 		if err := ctx.Bind(&in.SendBody); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserWithdraw)
+		http.SetOperation(ctx, OperationUserAdminAccountErase)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.Withdraw(ctx, req.(*WithdrawRequest))
+			return srv.AdminAccountErase(ctx, req.(*AdminAccountEraseRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*WithdrawReply)
+		reply := out.(*AccountEraseReply)
 		return ctx.Result(200, reply)
 	}
 }
 
 type UserHTTPClient interface {
+	AccountErase(ctx context.Context, req *AccountEraseRequest, opts ...http.CallOption) (rsp *AccountEraseReply, err error)
+	AddWithdrawAddress(ctx context.Context, req *AddWithdrawAddressRequest, opts ...http.CallOption) (rsp *AddWithdrawAddressReply, err error)
+	AdminAccountErase(ctx context.Context, req *AdminAccountEraseRequest, opts ...http.CallOption) (rsp *AccountEraseReply, err error)
+	AdminDataExport(ctx context.Context, req *AdminDataExportRequest, opts ...http.CallOption) (rsp *DataExportReply, err error)
 	AmountTo(ctx context.Context, req *AmountToRequest, opts ...http.CallOption) (rsp *AmountToReply, err error)
 	AmountToCard(ctx context.Context, req *AmountToCardRequest, opts ...http.CallOption) (rsp *AmountToCardReply, err error)
+	AuditList(ctx context.Context, req *AuditListRequest, opts ...http.CallOption) (rsp *AuditListReply, err error)
+	AuditVerify(ctx context.Context, req *AuditVerifyRequest, opts ...http.CallOption) (rsp *AuditVerifyReply, err error)
+	CardList(ctx context.Context, req *CardListRequest, opts ...http.CallOption) (rsp *CardListReply, err error)
 	ChangePin(ctx context.Context, req *ChangePinRequest, opts ...http.CallOption) (rsp *ChangePinReply, err error)
 	CheckCard(ctx context.Context, req *CheckCardRequest, opts ...http.CallOption) (rsp *CheckCardReply, err error)
 	CodeList(ctx context.Context, req *CodeListRequest, opts ...http.CallOption) (rsp *CodeListReply, err error)
 	CreateNonce(ctx context.Context, req *CreateNonceRequest, opts ...http.CallOption) (rsp *CreateNonceReply, err error)
+	DataExport(ctx context.Context, req *DataExportRequest, opts ...http.CallOption) (rsp *DataExportReply, err error)
+	DeleteWithdrawAddress(ctx context.Context, req *DeleteWithdrawAddressRequest, opts ...http.CallOption) (rsp *DeleteWithdrawAddressReply, err error)
 	EthAuthorize(ctx context.Context, req *EthAuthorizeRequest, opts ...http.CallOption) (rsp *EthAuthorizeReply, err error)
+	Exchange(ctx context.Context, req *ExchangeRequest, opts ...http.CallOption) (rsp *ExchangeReply, err error)
+	FeeQuote(ctx context.Context, req *FeeQuoteRequest, opts ...http.CallOption) (rsp *FeeQuoteReply, err error)
 	GetUser(ctx context.Context, req *GetUserRequest, opts ...http.CallOption) (rsp *GetUserReply, err error)
 	LookCard(ctx context.Context, req *LookCardRequest, opts ...http.CallOption) (rsp *LookCardReply, err error)
 	LookCardNew(ctx context.Context, req *LookCardRequest, opts ...http.CallOption) (rsp *LookCardReply, err error)
 	LookCardNewTwo(ctx context.Context, req *LookCardRequest, opts ...http.CallOption) (rsp *LookCardReply, err error)
+	NotifyList(ctx context.Context, req *NotifyListRequest, opts ...http.CallOption) (rsp *NotifyListReply, err error)
+	NotifyRead(ctx context.Context, req *NotifyReadRequest, opts ...http.CallOption) (rsp *NotifyReadReply, err error)
+	NotifySetting(ctx context.Context, req *NotifySettingRequest, opts ...http.CallOption) (rsp *NotifySettingReply, err error)
 	OpenCard(ctx context.Context, req *OpenCardRequest, opts ...http.CallOption) (rsp *OpenCardReply, err error)
 	OpenCardTwo(ctx context.Context, req *OpenCardRequest, opts ...http.CallOption) (rsp *OpenCardReply, err error)
 	OrderList(ctx context.Context, req *OrderListRequest, opts ...http.CallOption) (rsp *OrderListReply, err error)
 	OrderListTwo(ctx context.Context, req *OrderListTwoRequest, opts ...http.CallOption) (rsp *OrderListTwoReply, err error)
+	PinKey(ctx context.Context, req *PinKeyRequest, opts ...http.CallOption) (rsp *PinKeyReply, err error)
 	RecordList(ctx context.Context, req *RecordListRequest, opts ...http.CallOption) (rsp *RecordListReply, err error)
 	RewardList(ctx context.Context, req *RewardListRequest, opts ...http.CallOption) (rsp *RewardListReply, err error)
+	RiskReview(ctx context.Context, req *RiskReviewRequest, opts ...http.CallOption) (rsp *RiskReviewReply, err error)
+	RiskReviewList(ctx context.Context, req *RiskReviewListRequest, opts ...http.CallOption) (rsp *RiskReviewListReply, err error)
+	SetNotifySetting(ctx context.Context, req *SetNotifySettingRequest, opts ...http.CallOption) (rsp *SetNotifySettingReply, err error)
 	SetVip(ctx context.Context, req *SetVipRequest, opts ...http.CallOption) (rsp *SetVipReply, err error)
+	StreamTicket(ctx context.Context, req *StreamTicketRequest, opts ...http.CallOption) (rsp *StreamTicketReply, err error)
+	TotpDisable(ctx context.Context, req *TotpDisableRequest, opts ...http.CallOption) (rsp *TotpDisableReply, err error)
+	TotpEnable(ctx context.Context, req *TotpEnableRequest, opts ...http.CallOption) (rsp *TotpEnableReply, err error)
+	TotpSetup(ctx context.Context, req *TotpSetupRequest, opts ...http.CallOption) (rsp *TotpSetupReply, err error)
+	TotpStatus(ctx context.Context, req *TotpStatusRequest, opts ...http.CallOption) (rsp *TotpStatusReply, err error)
 	UserRecommend(ctx context.Context, req *RecommendListRequest, opts ...http.CallOption) (rsp *RecommendListReply, err error)
 	Withdraw(ctx context.Context, req *WithdrawRequest, opts ...http.CallOption) (rsp *WithdrawReply, err error)
+	WithdrawAddressList(ctx context.Context, req *WithdrawAddressListRequest, opts ...http.CallOption) (rsp *WithdrawAddressListReply, err error)
 }
 
 type UserHTTPClientImpl struct {
@@ -551,6 +1164,58 @@ func NewUserHTTPClient(client *http.Client) UserHTTPClient {
 	return &UserHTTPClientImpl{client}
 }
 
+func (c *UserHTTPClientImpl) AccountErase(ctx context.Context, in *AccountEraseRequest, opts ...http.CallOption) (*AccountEraseReply, error) {
+	var out AccountEraseReply
+	pattern := "/api/app_server/account_erase"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAccountErase))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AddWithdrawAddress(ctx context.Context, in *AddWithdrawAddressRequest, opts ...http.CallOption) (*AddWithdrawAddressReply, error) {
+	var out AddWithdrawAddressReply
+	pattern := "/api/app_server/withdraw_address_add"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAddWithdrawAddress))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminAccountErase(ctx context.Context, in *AdminAccountEraseRequest, opts ...http.CallOption) (*AccountEraseReply, error) {
+	var out AccountEraseReply
+	pattern := "/api/admin/account_erase"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminAccountErase))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminDataExport(ctx context.Context, in *AdminDataExportRequest, opts ...http.CallOption) (*DataExportReply, error) {
+	var out DataExportReply
+	pattern := "/api/admin/data_export"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminDataExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) AmountTo(ctx context.Context, in *AmountToRequest, opts ...http.CallOption) (*AmountToReply, error) {
 	var out AmountToReply
 	pattern := "/api/app_server/amount_to"
@@ -577,6 +1242,45 @@ func (c *UserHTTPClientImpl) AmountToCard(ctx context.Context, in *AmountToCardR
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) AuditList(ctx context.Context, in *AuditListRequest, opts ...http.CallOption) (*AuditListReply, error) {
+	var out AuditListReply
+	pattern := "/api/admin/audit_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAuditList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AuditVerify(ctx context.Context, in *AuditVerifyRequest, opts ...http.CallOption) (*AuditVerifyReply, error) {
+	var out AuditVerifyReply
+	pattern := "/api/admin/audit_verify"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAuditVerify))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) CardList(ctx context.Context, in *CardListRequest, opts ...http.CallOption) (*CardListReply, error) {
+	var out CardListReply
+	pattern := "/api/app_server/card_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCardList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) ChangePin(ctx context.Context, in *ChangePinRequest, opts ...http.CallOption) (*ChangePinReply, error) {
 	var out ChangePinReply
 	pattern := "/api/app_server/change_pin"
@@ -629,6 +1333,32 @@ func (c *UserHTTPClientImpl) CreateNonce(ctx context.Context, in *CreateNonceReq
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) DataExport(ctx context.Context, in *DataExportRequest, opts ...http.CallOption) (*DataExportReply, error) {
+	var out DataExportReply
+	pattern := "/api/app_server/data_export"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserDataExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) DeleteWithdrawAddress(ctx context.Context, in *DeleteWithdrawAddressRequest, opts ...http.CallOption) (*DeleteWithdrawAddressReply, error) {
+	var out DeleteWithdrawAddressReply
+	pattern := "/api/app_server/withdraw_address_delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserDeleteWithdrawAddress))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) EthAuthorize(ctx context.Context, in *EthAuthorizeRequest, opts ...http.CallOption) (*EthAuthorizeReply, error) {
 	var out EthAuthorizeReply
 	pattern := "/api/app_server/eth_authorize"
@@ -642,6 +1372,32 @@ func (c *UserHTTPClientImpl) EthAuthorize(ctx context.Context, in *EthAuthorizeR
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) Exchange(ctx context.Context, in *ExchangeRequest, opts ...http.CallOption) (*ExchangeReply, error) {
+	var out ExchangeReply
+	pattern := "/api/app_server/exchange"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserExchange))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) FeeQuote(ctx context.Context, in *FeeQuoteRequest, opts ...http.CallOption) (*FeeQuoteReply, error) {
+	var out FeeQuoteReply
+	pattern := "/api/app_server/fee_quote"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserFeeQuote))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) GetUser(ctx context.Context, in *GetUserRequest, opts ...http.CallOption) (*GetUserReply, error) {
 	var out GetUserReply
 	pattern := "/api/app_server/user"
@@ -694,6 +1450,45 @@ func (c *UserHTTPClientImpl) LookCardNewTwo(ctx context.Context, in *LookCardReq
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) NotifyList(ctx context.Context, in *NotifyListRequest, opts ...http.CallOption) (*NotifyListReply, error) {
+	var out NotifyListReply
+	pattern := "/api/app_server/notify_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserNotifyList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) NotifyRead(ctx context.Context, in *NotifyReadRequest, opts ...http.CallOption) (*NotifyReadReply, error) {
+	var out NotifyReadReply
+	pattern := "/api/app_server/notify_read"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserNotifyRead))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) NotifySetting(ctx context.Context, in *NotifySettingRequest, opts ...http.CallOption) (*NotifySettingReply, error) {
+	var out NotifySettingReply
+	pattern := "/api/app_server/notify_setting"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserNotifySetting))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) OpenCard(ctx context.Context, in *OpenCardRequest, opts ...http.CallOption) (*OpenCardReply, error) {
 	var out OpenCardReply
 	pattern := "/api/app_server/open_card"
@@ -746,6 +1541,19 @@ func (c *UserHTTPClientImpl) OrderListTwo(ctx context.Context, in *OrderListTwoR
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) PinKey(ctx context.Context, in *PinKeyRequest, opts ...http.CallOption) (*PinKeyReply, error) {
+	var out PinKeyReply
+	pattern := "/api/app_server/pin_key"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserPinKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) RecordList(ctx context.Context, in *RecordListRequest, opts ...http.CallOption) (*RecordListReply, error) {
 	var out RecordListReply
 	pattern := "/api/app_server/record_list"
@@ -772,6 +1580,45 @@ func (c *UserHTTPClientImpl) RewardList(ctx context.Context, in *RewardListReque
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) RiskReview(ctx context.Context, in *RiskReviewRequest, opts ...http.CallOption) (*RiskReviewReply, error) {
+	var out RiskReviewReply
+	pattern := "/api/admin/risk_review"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserRiskReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) RiskReviewList(ctx context.Context, in *RiskReviewListRequest, opts ...http.CallOption) (*RiskReviewListReply, error) {
+	var out RiskReviewListReply
+	pattern := "/api/admin/risk_review_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserRiskReviewList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) SetNotifySetting(ctx context.Context, in *SetNotifySettingRequest, opts ...http.CallOption) (*SetNotifySettingReply, error) {
+	var out SetNotifySettingReply
+	pattern := "/api/app_server/set_notify_setting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserSetNotifySetting))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) SetVip(ctx context.Context, in *SetVipRequest, opts ...http.CallOption) (*SetVipReply, error) {
 	var out SetVipReply
 	pattern := "/api/app_server/set_vip"
@@ -785,6 +1632,71 @@ func (c *UserHTTPClientImpl) SetVip(ctx context.Context, in *SetVipRequest, opts
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) StreamTicket(ctx context.Context, in *StreamTicketRequest, opts ...http.CallOption) (*StreamTicketReply, error) {
+	var out StreamTicketReply
+	pattern := "/api/app_server/stream_ticket"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserStreamTicket))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) TotpDisable(ctx context.Context, in *TotpDisableRequest, opts ...http.CallOption) (*TotpDisableReply, error) {
+	var out TotpDisableReply
+	pattern := "/api/app_server/totp_disable"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserTotpDisable))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) TotpEnable(ctx context.Context, in *TotpEnableRequest, opts ...http.CallOption) (*TotpEnableReply, error) {
+	var out TotpEnableReply
+	pattern := "/api/app_server/totp_enable"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserTotpEnable))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) TotpSetup(ctx context.Context, in *TotpSetupRequest, opts ...http.CallOption) (*TotpSetupReply, error) {
+	var out TotpSetupReply
+	pattern := "/api/app_server/totp_setup"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserTotpSetup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) TotpStatus(ctx context.Context, in *TotpStatusRequest, opts ...http.CallOption) (*TotpStatusReply, error) {
+	var out TotpStatusReply
+	pattern := "/api/app_server/totp_status"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserTotpStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) UserRecommend(ctx context.Context, in *RecommendListRequest, opts ...http.CallOption) (*RecommendListReply, error) {
 	var out RecommendListReply
 	pattern := "/api/app_server/recommend_list"
@@ -810,3 +1722,16 @@ func (c *UserHTTPClientImpl) Withdraw(ctx context.Context, in *WithdrawRequest,
 	}
 	return &out, err
 }
+
+func (c *UserHTTPClientImpl) WithdrawAddressList(ctx context.Context, in *WithdrawAddressListRequest, opts ...http.CallOption) (*WithdrawAddressListReply, error) {
+	var out WithdrawAddressListReply
+	pattern := "/api/app_server/withdraw_address_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserWithdrawAddressList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
@@ -1,10 +1,17 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
 	"os"
+	"time"
 
 	"cardbinance/internal/conf"
+	"cardbinance/internal/pkg/redact"
+	"cardbinance/internal/pkg/telemetry"
+	"cardbinance/internal/server"
+	"cardbinance/internal/service"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -33,7 +40,12 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(c *conf.Server, logger log.Logger, gs *grpc.Server, hs *http.Server, ms *server.MetricsServer, scheduler *server.Scheduler, codePoller *server.CodePoller, eventRelay *server.EventRelay, health *service.HealthService, streams *service.Streams) *kratos.App {
+	drain := 5 * time.Second
+	if nil != c.ShutdownDelay {
+		drain = c.ShutdownDelay.AsDuration()
+	}
+
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -42,21 +54,25 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Logger(logger),
 		kratos.Server(
 			hs,
+			ms,
+			scheduler,
+			codePoller,
+			eventRelay,
 		),
+		// 先摘除就绪状态，等待负载均衡停止转发后再关闭服务
+		kratos.BeforeStop(func(ctx context.Context) error {
+			health.Shutdown()
+			logger.Log(log.LevelInfo, "msg", "readiness off, draining", "delay", drain.String())
+			time.Sleep(drain)
+			// SSE 长连接不会自己结束，先断开，否则 http 服务要等到关闭超时
+			streams.Shutdown()
+			return nil
+		}),
 	)
 }
 
 func main() {
 	flag.Parse()
-	logger := log.With(log.NewStdLogger(os.Stdout),
-		"ts", log.DefaultTimestamp,
-		"caller", log.DefaultCaller,
-		"service.id", id,
-		"service.name", Name,
-		"service.version", Version,
-		"trace.id", tracing.TraceID(),
-		"span.id", tracing.SpanID(),
-	)
 	c := config.New(
 		config.WithSource(
 			file.NewSource(flagconf),
@@ -73,7 +89,38 @@ func main() {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Auth, logger)
+	level := log.LevelInfo
+	if nil != bc.Log && "" != bc.Log.Level {
+		level = log.ParseLevel(bc.Log.Level)
+	}
+
+	// 所有日志输出前脱敏，卡号、身份证、手机号、邮箱和令牌不落盘
+	logger := log.With(log.NewFilter(redact.NewLogger(log.NewStdLogger(os.Stdout)), log.FilterLevel(level)),
+		"ts", log.DefaultTimestamp,
+		"caller", log.DefaultCaller,
+		"service.id", id,
+		"service.name", Name,
+		"service.version", Version,
+		"trace.id", tracing.TraceID(),
+		"span.id", tracing.SpanID(),
+	)
+	log.SetLogger(logger)
+
+	if 0 < flag.NArg() && "migrate" == flag.Arg(0) {
+		if err := runMigrate(bc.Data, logger, flag.Args()[1:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	cleanupTelemetry, err := telemetry.Setup(bc.Trace, Name, Version, id)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanupTelemetry()
+
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Auth, bc.Scheduler, bc.Notify, bc.CardCode, bc.Withdraw, bc.Privacy, bc.Pin, bc.Interlace, logger)
 	if err != nil {
 		panic(err)
 	}
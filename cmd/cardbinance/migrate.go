@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"cardbinance/internal/conf"
+	"cardbinance/internal/data"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const migrateUsage = `usage: cardbinance -conf ../../configs migrate <command>
+
+commands:
+  up [N]          执行未应用的迁移，N 为最多执行的数量，默认全部
+  down [N]        回退最近的 N 个迁移，默认1个
+  status          查看迁移状态
+  force VERSION   只标记版本不执行脚本，接入已有数据库时使用`
+
+// runMigrate migrate 子命令
+func runMigrate(c *conf.Data, logger log.Logger, args []string) error {
+	if 0 == len(args) {
+		return fmt.Errorf(migrateUsage)
+	}
+
+	m, err := data.NewMigrator(data.NewDB(c), logger)
+	if err != nil {
+		return err
+	}
+
+	n := 0
+	if 2 <= len(args) {
+		if n, err = strconv.Atoi(args[1]); err != nil || 0 > n {
+			return fmt.Errorf("invalid number %q\n%s", args[1], migrateUsage)
+		}
+	}
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		return m.Up(ctx, n)
+	case "down":
+		return m.Down(ctx, n)
+	case "force":
+		if 2 > len(args) {
+			return fmt.Errorf(migrateUsage)
+		}
+		return m.Force(ctx, uint64(n))
+	case "status":
+		status, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range status {
+			appliedAt := "pending"
+			if v.Applied {
+				appliedAt = v.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d  %-30s  %s\n", v.Version, v.Name, appliedAt)
+		}
+		return nil
+	default:
+		return fmt.Errorf(migrateUsage)
+	}
+}
@@ -23,20 +23,54 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logger log.Logger) (*kratos.App, func(), error) {
-	grpcServer := server.NewGRPCServer(confServer, logger)
-	db := data.NewDB(confData)
+func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, scheduler *conf.Scheduler, notify *conf.Notify, cardCode *conf.CardCode, withdraw *conf.Withdraw, privacy *conf.Privacy, pin *conf.Pin, confInterlace *conf.Interlace, logger log.Logger) (*kratos.App, func(), error) {
 	client := data.NewRedis(confData)
+	grpcServer := server.NewGRPCServer(confServer, client, logger)
+	db := data.NewDB(confData)
 	dataData, cleanup, err := data.NewData(confData, logger, db, client)
 	if err != nil {
 		return nil, nil, err
 	}
 	userRepo := data.NewUserRepo(dataData, logger)
+	cardRepo := data.NewCardRepo(dataData, logger)
+	balanceRepo := data.NewBalanceRepo(dataData, logger)
+	feeRepo := data.NewFeeRepo(dataData, logger)
+	idempotencyRepo := data.NewIdempotencyRepo(dataData, logger)
+	auditRepo := data.NewAuditRepo(dataData, logger)
+	notifyRepo := data.NewNotifyRepo(dataData, logger)
+	v := data.NewNotifySenders(notify)
+	notifier := biz.NewNotifier(notifyRepo, userRepo, v, logger)
+	eventRepo := data.NewEventRepo(dataData, logger)
+	totpRepo := data.NewTotpRepo(dataData, logger)
+	withdrawAddressRepo := data.NewWithdrawAddressRepo(dataData, logger)
+	riskRepo := data.NewRiskRepo(dataData, logger)
+	screeningRepo := data.NewScreeningRepo(dataData, logger)
+	privacyRepo := data.NewPrivacyRepo(dataData, logger)
+	interlaceClient := data.NewInterlaceClient(confInterlace, logger)
+	locker := data.NewLockRepo(dataData, logger)
 	transaction := data.NewTransaction(dataData)
-	userUseCase := biz.NewUserUseCase(userRepo, transaction, logger)
-	userService := service.NewUserService(userUseCase, logger, auth)
-	httpServer := server.NewHTTPServer(confServer, userService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	userUseCase := biz.NewUserUseCase(userRepo, cardRepo, balanceRepo, feeRepo, idempotencyRepo, auditRepo, notifier, eventRepo, totpRepo, withdrawAddressRepo, riskRepo, screeningRepo, privacyRepo, interlaceClient, locker, transaction, logger)
+	userService := service.NewUserService(userUseCase, logger, auth, cardCode, withdraw, privacy, pin)
+	healthRepo := data.NewHealthRepo(dataData, logger)
+	healthUseCase := biz.NewHealthUseCase(healthRepo, interlaceClient, logger)
+	healthService := service.NewHealthService(healthUseCase, logger)
+	codeHub := biz.NewCodeHub(userRepo, userUseCase, logger)
+	streams := service.NewStreams(userUseCase)
+	codeService := service.NewCodeService(codeHub, cardCode, auth, streams, logger)
+	eventHub := biz.NewEventHub(eventRepo, userUseCase, logger)
+	eventService := service.NewEventService(eventHub, auth, streams, logger)
+	httpServer := server.NewHTTPServer(confServer, userService, healthService, codeService, eventService, client, logger)
+	schedulerRepo := data.NewSchedulerRepo(dataData, logger)
+	schedulerUseCase := biz.NewSchedulerUseCase(schedulerRepo, userUseCase, logger)
+	serverScheduler, err := server.NewScheduler(scheduler, schedulerUseCase, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	codePoller := server.NewCodePoller(codeService, logger)
+	eventRelay := server.NewEventRelay(eventHub, logger)
+	metricsServer := server.NewMetricsServer(confServer)
+	app := newApp(confServer, logger, grpcServer, httpServer, metricsServer, serverScheduler, codePoller, eventRelay, healthService, streams)
 	return app, func() {
 		cleanup()
 	}, nil
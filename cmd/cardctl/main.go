@@ -0,0 +1,607 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"cardbinance/internal/biz"
+	"cardbinance/internal/conf"
+	"cardbinance/internal/pkg/interlace"
+	"cardbinance/internal/pkg/redact"
+	"cardbinance/internal/pkg/watchlist"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const usage = `usage: cardctl [flags] <command> [args]
+
+commands:
+  user ADDRESS             查询用户、卡片和余额
+  card CARD_ID             查询卡片及发卡方余额统计
+  rewards ADDRESS [N]      最近 N 条流水，默认20
+  freeze CARD_ID           冻结卡片
+  redrive ORDER_ID         重新发起失败的划转入账
+  reviews [N]              待人工审核的提现和转账，默认20
+  approve REVIEW_ID        审核通过并执行
+  reject REVIEW_ID REMARK  审核拒绝并退回冻结金额
+  screening-import NAME KIND FILE [VERSION]
+                           导入制裁(sanctions)或PEP(pep)名单，支持 .csv 和 .xml
+  screenings [N]           合规审核中的实体卡申请，默认20
+  screening-clear CARD_ID  排除名单命中，进入发卡
+  screening-reject CARD_ID 确认名单命中，注销卡片
+  reencrypt                用当前主密钥重新加密个人信息，轮换主密钥后执行
+  migrate-cards            把仍在 user 表旧字段上的卡片迁移到 card 表
+
+flags:
+`
+
+var (
+	flagconf     string
+	flagJSON     bool
+	flagYes      bool
+	flagOperator string
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.BoolVar(&flagJSON, "json", false, "output as json")
+	flag.BoolVar(&flagYes, "yes", false, "skip confirmation of mutating commands")
+	flag.StringVar(&flagOperator, "operator", os.Getenv("USER"), "operator name recorded in the audit log")
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flag.PrintDefaults()
+	}
+}
+
+func main() {
+	flag.Parse()
+	if 0 == flag.NArg() {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		fatal(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		fatal(err)
+	}
+
+	// 命令行只输出告警以上的日志，避免混入表格
+	logger := log.NewFilter(redact.NewLogger(log.NewStdLogger(os.Stderr)), log.FilterLevel(log.LevelWarn))
+	log.SetLogger(logger)
+
+	uuc, cleanup, err := wireUseCase(bc.Data, bc.Notify, bc.Interlace, logger)
+	if err != nil {
+		fatal(err)
+	}
+	defer cleanup()
+
+	if err := run(context.Background(), uuc, flag.Arg(0), flag.Args()[1:]); err != nil {
+		cleanup()
+		fatal(err)
+	}
+}
+
+func run(ctx context.Context, uuc *biz.UserUseCase, cmd string, args []string) error {
+	switch cmd {
+	case "user":
+		if 1 != len(args) {
+			return fmt.Errorf("usage: cardctl user ADDRESS")
+		}
+
+		res, err := uuc.SupportLookup(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		return printUser(os.Stdout, res)
+	case "card":
+		if 1 != len(args) {
+			return fmt.Errorf("usage: cardctl card CARD_ID")
+		}
+
+		cardId, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid card id %q", args[0])
+		}
+
+		card, summary, err := uuc.SupportCardSummary(ctx, cardId)
+		if nil == card {
+			return err
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "interlace:", err)
+		}
+		return printCard(os.Stdout, card, summary)
+	case "rewards":
+		if 1 > len(args) || 2 < len(args) {
+			return fmt.Errorf("usage: cardctl rewards ADDRESS [N]")
+		}
+
+		limit := 20
+		if 2 == len(args) {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || 0 >= n {
+				return fmt.Errorf("invalid number %q", args[1])
+			}
+			limit = n
+		}
+
+		rewards, err := uuc.SupportRewards(ctx, args[0], limit)
+		if err != nil {
+			return err
+		}
+		return printRewards(os.Stdout, rewards)
+	case "freeze":
+		if 1 != len(args) {
+			return fmt.Errorf("usage: cardctl freeze CARD_ID")
+		}
+
+		cardId, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid card id %q", args[0])
+		}
+
+		if err := confirm(fmt.Sprintf("freeze card %d", cardId)); err != nil {
+			return err
+		}
+
+		if err := uuc.SupportFreezeCard(ctx, cardId, flagOperator); err != nil {
+			return err
+		}
+		return printResult(os.Stdout, map[string]interface{}{"card_id": cardId, "status": biz.CardStatusFrozen})
+	case "redrive":
+		if 1 != len(args) {
+			return fmt.Errorf("usage: cardctl redrive ORDER_ID")
+		}
+
+		if err := confirm(fmt.Sprintf("re-drive transfer %s", args[0])); err != nil {
+			return err
+		}
+
+		transfer, err := uuc.SupportRedriveTransfer(ctx, args[0], flagOperator)
+		if nil != transfer {
+			if errPrint := printTransfer(os.Stdout, transfer); errPrint != nil {
+				return errPrint
+			}
+		}
+		return err
+	case "reviews":
+		if 1 < len(args) {
+			return fmt.Errorf("usage: cardctl reviews [N]")
+		}
+
+		limit := 20
+		if 1 == len(args) {
+			n, err := strconv.Atoi(args[0])
+			if err != nil || 0 >= n {
+				return fmt.Errorf("invalid number %q", args[0])
+			}
+			limit = n
+		}
+
+		records, err := uuc.SupportRiskReviews(ctx, limit)
+		if err != nil {
+			return err
+		}
+		return printRiskRecords(os.Stdout, records)
+	case "approve", "reject":
+		approve := "approve" == cmd
+		if (approve && 1 != len(args)) || (!approve && 2 != len(args)) {
+			return fmt.Errorf("usage: cardctl approve REVIEW_ID | cardctl reject REVIEW_ID REMARK")
+		}
+
+		id, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid review id %q", args[0])
+		}
+
+		remark := ""
+		if !approve {
+			remark = args[1]
+		}
+
+		if err := confirm(fmt.Sprintf("%s review %d", cmd, id)); err != nil {
+			return err
+		}
+
+		r, err := uuc.SupportReviewRisk(ctx, id, approve, flagOperator, remark)
+		if err != nil {
+			return err
+		}
+		return printResult(os.Stdout, map[string]interface{}{"id": r.ID, "user_id": r.UserId, "operation": r.Operation, "status": r.Status})
+	case "screening-import":
+		if 3 != len(args) && 4 != len(args) {
+			return fmt.Errorf("usage: cardctl screening-import NAME KIND FILE [VERSION]")
+		}
+
+		version, entries, err := readWatchlist(args[2])
+		if err != nil {
+			return err
+		}
+		if 4 == len(args) {
+			version = args[3]
+		}
+		if "" == version {
+			return fmt.Errorf("list version is required for %s", args[2])
+		}
+
+		if err := confirm(fmt.Sprintf("replace %s list %s with %d entries (version %s)", args[1], args[0], len(entries), version)); err != nil {
+			return err
+		}
+
+		w, err := uuc.SupportImportWatchlist(ctx, args[0], args[1], version, entries, flagOperator)
+		if err != nil {
+			return err
+		}
+		return printResult(os.Stdout, map[string]interface{}{"id": w.ID, "name": w.Name, "kind": w.Kind, "version": w.Version, "entries": w.Entries})
+	case "screenings":
+		if 1 < len(args) {
+			return fmt.Errorf("usage: cardctl screenings [N]")
+		}
+
+		limit := 20
+		if 1 == len(args) {
+			n, err := strconv.Atoi(args[0])
+			if err != nil || 0 >= n {
+				return fmt.Errorf("invalid number %q", args[0])
+			}
+			limit = n
+		}
+
+		screenings, err := uuc.SupportScreeningReviews(ctx, limit)
+		if err != nil {
+			return err
+		}
+		return printScreenings(os.Stdout, screenings)
+	case "screening-clear", "screening-reject":
+		if 1 != len(args) {
+			return fmt.Errorf("usage: cardctl %s CARD_ID", cmd)
+		}
+
+		cardId, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid card id %q", args[0])
+		}
+
+		approve := "screening-clear" == cmd
+		if err := confirm(fmt.Sprintf("%s card %d", cmd, cardId)); err != nil {
+			return err
+		}
+
+		card, err := uuc.SupportReviewScreening(ctx, cardId, approve, flagOperator)
+		if err != nil {
+			return err
+		}
+		return printResult(os.Stdout, map[string]interface{}{"card_id": card.ID, "user_id": card.UserId, "status": card.Status})
+	case "reencrypt":
+		if 0 != len(args) {
+			return fmt.Errorf("usage: cardctl reencrypt")
+		}
+
+		if err := confirm("re-encrypt personal data"); err != nil {
+			return err
+		}
+
+		res, err := uuc.SupportReencrypt(ctx, flagOperator)
+		v := make(map[string]interface{}, len(res))
+		for k, n := range res {
+			v[k] = n
+		}
+		if errPrint := printResult(os.Stdout, v); errPrint != nil {
+			return errPrint
+		}
+		return err
+	case "migrate-cards":
+		if 0 != len(args) {
+			return fmt.Errorf("usage: cardctl migrate-cards")
+		}
+
+		n, err := uuc.SupportMigrateCards(ctx, flagOperator)
+		if err != nil {
+			return err
+		}
+		return printResult(os.Stdout, map[string]interface{}{"users": n})
+	default:
+		flag.Usage()
+		return fmt.Errorf("unknown command %q", cmd)
+	}
+}
+
+// confirm 变更类命令需要输入 yes 确认，-yes 跳过
+func confirm(action string) error {
+	if "" == flagOperator {
+		return fmt.Errorf("operator is required, set -operator")
+	}
+
+	if flagYes {
+		return nil
+	}
+
+	fmt.Fprintf(os.Stderr, "%s as %s? type yes to continue: ", action, flagOperator)
+	line, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	if "yes" != strings.TrimSpace(line) {
+		return fmt.Errorf("aborted")
+	}
+
+	return nil
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
+
+type userView struct {
+	ID        uint64            `json:"id"`
+	Address   string            `json:"address"`
+	Vip       uint64            `json:"vip"`
+	Name      string            `json:"name"`
+	Email     string            `json:"email"`
+	IsDelete  uint64            `json:"is_delete"`
+	CreatedAt string            `json:"created_at"`
+	Balances  map[string]string `json:"balances"`
+	Cards     []*cardView       `json:"cards"`
+}
+
+type cardView struct {
+	ID           uint64 `json:"id"`
+	CardType     uint64 `json:"card_type"`
+	ProductId    string `json:"product_id"`
+	IssuerCardId string `json:"issuer_card_id"`
+	Status       string `json:"status"`
+	MaskedPan    string `json:"masked_pan"`
+	CreatedAt    string `json:"created_at"`
+}
+
+func toCardView(card *biz.Card) *cardView {
+	return &cardView{
+		ID:           card.ID,
+		CardType:     card.CardType,
+		ProductId:    card.ProductId,
+		IssuerCardId: card.IssuerCardId,
+		Status:       card.Status,
+		MaskedPan:    card.MaskedPan,
+		CreatedAt:    card.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func printUser(w io.Writer, res *biz.SupportUser) error {
+	// 客服只需确认身份，邮箱按日志规则脱敏
+	v := &userView{
+		ID:        res.User.ID,
+		Address:   res.User.Address,
+		Vip:       res.User.Vip,
+		Name:      strings.TrimSpace(res.User.FirstName + " " + res.User.LastName),
+		Email:     redact.String(res.User.Email),
+		IsDelete:  res.User.IsDelete,
+		CreatedAt: res.User.CreatedAt.Format("2006-01-02 15:04:05"),
+		Balances:  make(map[string]string, len(res.Balances)),
+		Cards:     make([]*cardView, 0, len(res.Cards)),
+	}
+	for _, b := range res.Balances {
+		v.Balances[b.Currency] = strconv.FormatFloat(b.Amount, 'f', -1, 64)
+	}
+	for _, card := range res.Cards {
+		v.Cards = append(v.Cards, toCardView(card))
+	}
+
+	if flagJSON {
+		return printJSON(w, v)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "ID\t%d\n", v.ID)
+	fmt.Fprintf(tw, "ADDRESS\t%s\n", v.Address)
+	fmt.Fprintf(tw, "VIP\t%d\n", v.Vip)
+	fmt.Fprintf(tw, "NAME\t%s\n", v.Name)
+	fmt.Fprintf(tw, "EMAIL\t%s\n", v.Email)
+	fmt.Fprintf(tw, "DELETED\t%d\n", v.IsDelete)
+	fmt.Fprintf(tw, "CREATED\t%s\n", v.CreatedAt)
+	for _, b := range res.Balances {
+		fmt.Fprintf(tw, "BALANCE %s\t%s\n", b.Currency, v.Balances[b.Currency])
+	}
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "CARD_ID\tTYPE\tSTATUS\tPRODUCT\tISSUER_CARD_ID\tPAN\tCREATED")
+	for _, card := range v.Cards {
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%s\t%s\t%s\n", card.ID, card.CardType, card.Status, card.ProductId, card.IssuerCardId, card.MaskedPan, card.CreatedAt)
+	}
+	return tw.Flush()
+}
+
+func printCard(w io.Writer, card *biz.Card, summary *interlace.CardSummary) error {
+	if flagJSON {
+		v := map[string]interface{}{"card": toCardView(card)}
+		if nil != summary {
+			v["summary"] = summary
+		}
+		return printJSON(w, v)
+	}
+
+	cv := toCardView(card)
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "CARD_ID\t%d\n", cv.ID)
+	fmt.Fprintf(tw, "USER_ID\t%d\n", card.UserId)
+	fmt.Fprintf(tw, "TYPE\t%d\n", cv.CardType)
+	fmt.Fprintf(tw, "STATUS\t%s\n", cv.Status)
+	fmt.Fprintf(tw, "ISSUER_CARD_ID\t%s\n", cv.IssuerCardId)
+	fmt.Fprintf(tw, "PAN\t%s\n", cv.MaskedPan)
+	if nil != summary {
+		d := summary
+		fmt.Fprintf(tw, "AVAILABLE\t%s %s\n", d.Balance.Available, d.Balance.Currency)
+		fmt.Fprintf(tw, "CONSUMPTION\t%s\n", d.Statistics.Consumption)
+		fmt.Fprintf(tw, "REFUND\t%s\n", d.Statistics.Refund)
+		fmt.Fprintf(tw, "REVERSAL\t%s\n", d.Statistics.Reversal)
+		fmt.Fprintf(tw, "NET_CONSUMPTION\t%s %s\n", d.Statistics.NetConsumption, d.Statistics.Currency)
+		fmt.Fprintf(tw, "VELOCITY\t%s %s\n", d.VelocityControl.Type, d.VelocityControl.Limit)
+	}
+	return tw.Flush()
+}
+
+func printRewards(w io.Writer, rewards []*biz.Reward) error {
+	if flagJSON {
+		v := make([]map[string]interface{}, 0, len(rewards))
+		for _, r := range rewards {
+			v = append(v, map[string]interface{}{
+				"id":         r.ID,
+				"reason":     r.Reason,
+				"amount":     r.Amount,
+				"currency":   r.Currency,
+				"one":        r.One,
+				"address":    r.Address,
+				"created_at": r.CreatedAt.Format("2006-01-02 15:04:05"),
+			})
+		}
+		return printJSON(w, v)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tREASON\tAMOUNT\tCURRENCY\tONE\tADDRESS\tCREATED")
+	for _, r := range rewards {
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%d\t%s\t%s\n", r.ID, r.Reason, strconv.FormatFloat(r.Amount, 'f', -1, 64), r.Currency, r.One, r.Address, r.CreatedAt.Format("2006-01-02 15:04:05"))
+	}
+	return tw.Flush()
+}
+
+func printTransfer(w io.Writer, t *biz.CardTransfer) error {
+	v := map[string]interface{}{
+		"id":         t.ID,
+		"order_id":   t.OrderId,
+		"user_id":    t.UserId,
+		"card_id":    t.CardId,
+		"amount":     t.Amount,
+		"status":     t.Status,
+		"error":      t.Error,
+		"attempts":   t.Attempts,
+		"updated_at": t.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if flagJSON {
+		return printJSON(w, v)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, k := range []string{"order_id", "user_id", "card_id", "amount", "status", "error", "attempts", "updated_at"} {
+		fmt.Fprintf(tw, "%s\t%v\n", strings.ToUpper(k), v[k])
+	}
+	return tw.Flush()
+}
+
+func printRiskRecords(w io.Writer, records []*biz.RiskRecord) error {
+	if flagJSON {
+		v := make([]map[string]interface{}, 0, len(records))
+		for _, r := range records {
+			v = append(v, map[string]interface{}{
+				"id":         r.ID,
+				"user_id":    r.UserId,
+				"operation":  r.Operation,
+				"amount":     r.Amount,
+				"currency":   r.Currency,
+				"address":    r.Address,
+				"chain":      r.Chain,
+				"to_user_id": r.ToUserId,
+				"reasons":    r.Reasons,
+				"created_at": r.CreatedAt.Format("2006-01-02 15:04:05"),
+			})
+		}
+		return printJSON(w, v)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tUSER_ID\tOPERATION\tAMOUNT\tCURRENCY\tADDRESS\tCHAIN\tREASONS\tCREATED")
+	for _, r := range records {
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", r.ID, r.UserId, r.Operation, strconv.FormatFloat(r.Amount, 'f', -1, 64), r.Currency, r.Address, r.Chain, r.Reasons, r.CreatedAt.Format("2006-01-02 15:04:05"))
+	}
+	return tw.Flush()
+}
+
+// readWatchlist 按扩展名解析名单文件，xml 中的版本号作为默认版本
+func readWatchlist(path string) (string, []*watchlist.Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", nil, err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		entries, err := watchlist.ParseCSV(f)
+		return "", entries, err
+	case ".xml":
+		return watchlist.ParseXML(f)
+	}
+
+	return "", nil, fmt.Errorf("unsupported list file %q, expected .csv or .xml", path)
+}
+
+func printScreenings(w io.Writer, screenings []*biz.Screening) error {
+	if flagJSON {
+		v := make([]map[string]interface{}, 0, len(screenings))
+		for _, s := range screenings {
+			v = append(v, map[string]interface{}{
+				"card_id":          s.CardId,
+				"user_id":          s.UserId,
+				"name":             s.Name,
+				"country":          s.Country,
+				"score":            s.Score,
+				"matched_list":     s.MatchedList,
+				"matched_entry_id": s.MatchedEntryId,
+				"matched_name":     s.MatchedName,
+				"list_version":     s.ListVersion,
+				"created_at":       s.CreatedAt.Format("2006-01-02 15:04:05"),
+			})
+		}
+		return printJSON(w, v)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "CARD_ID\tUSER_ID\tNAME\tCOUNTRY\tSCORE\tLIST\tENTRY_ID\tMATCHED_NAME\tCREATED")
+	for _, s := range screenings {
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%.4f\t%s\t%s\t%s\t%s\n", s.CardId, s.UserId, s.Name, s.Country, s.Score, s.MatchedList, s.MatchedEntryId, s.MatchedName, s.CreatedAt.Format("2006-01-02 15:04:05"))
+	}
+	return tw.Flush()
+}
+
+func printResult(w io.Writer, v map[string]interface{}) error {
+	if flagJSON {
+		return printJSON(w, v)
+	}
+
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, k := range keys {
+		fmt.Fprintf(tw, "%s\t%v\n", strings.ToUpper(k), v[k])
+	}
+	return tw.Flush()
+}
+
+func printJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
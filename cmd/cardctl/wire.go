@@ -0,0 +1,20 @@
+//go:build wireinject
+// +build wireinject
+
+// The build tag makes sure the stub is not built in the final build.
+
+package main
+
+import (
+	"cardbinance/internal/biz"
+	"cardbinance/internal/conf"
+	"cardbinance/internal/data"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+// wireUseCase init the data and biz layers without servers.
+func wireUseCase(*conf.Data, *conf.Notify, *conf.Interlace, log.Logger) (*biz.UserUseCase, func(), error) {
+	panic(wire.Build(data.ProviderSet, biz.NewNotifier, biz.NewUserUseCase))
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io"
+	"log"
+	"mime"
+	"net"
+	"net/http"
+	"net/textproto"
+	"strings"
+)
+
+// notifysink 本地调试通知用，接收 SMTP 邮件和 Telegram sendMessage 请求并打印
+var (
+	flagSMTP     string
+	flagTelegram string
+)
+
+func init() {
+	flag.StringVar(&flagSMTP, "smtp", "127.0.0.1:2525", "smtp listen addr")
+	flag.StringVar(&flagTelegram, "telegram", "127.0.0.1:8081", "telegram api listen addr")
+}
+
+func main() {
+	flag.Parse()
+
+	go func() {
+		if err := serveTelegram(flagTelegram); nil != err {
+			log.Fatal(err)
+		}
+	}()
+
+	if err := serveSMTP(flagSMTP); nil != err {
+		log.Fatal(err)
+	}
+}
+
+func serveTelegram(addr string) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/sendMessage") {
+			http.NotFound(w, r)
+			return
+		}
+
+		var msg struct {
+			ChatId interface{} `json:"chat_id"`
+			Text   string      `json:"text"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&msg); nil != err {
+			http.Error(w, `{"ok":false,"description":"bad request"}`, http.StatusBadRequest)
+			return
+		}
+
+		log.Printf("[telegram] to=%v\n%s\n", msg.ChatId, msg.Text)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	})
+
+	log.Printf("telegram sink on http://%s", addr)
+	return http.ListenAndServe(addr, mux)
+}
+
+func serveSMTP(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if nil != err {
+		return err
+	}
+	log.Printf("smtp sink on %s", addr)
+
+	for {
+		conn, err := l.Accept()
+		if nil != err {
+			return err
+		}
+
+		go handleSMTP(conn)
+	}
+}
+
+// handleSMTP 只实现发信需要的最少命令，不支持 STARTTLS 和 AUTH
+func handleSMTP(conn net.Conn) {
+	defer conn.Close()
+
+	tp := textproto.NewConn(conn)
+	reply := func(format string, args ...interface{}) bool {
+		return nil == tp.PrintfLine(format, args...)
+	}
+
+	if !reply("220 notifysink ready") {
+		return
+	}
+
+	var from string
+	var to []string
+	for {
+		line, err := tp.ReadLine()
+		if nil != err {
+			return
+		}
+
+		cmd := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply("250 notifysink")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			from, to = strings.TrimSpace(line[len("MAIL FROM:"):]), nil
+			reply("250 ok")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			to = append(to, strings.TrimSpace(line[len("RCPT TO:"):]))
+			reply("250 ok")
+		case "DATA" == cmd:
+			reply("354 end with .")
+			data, err := tp.ReadDotBytes()
+			if nil != err {
+				return
+			}
+			printMail(from, to, data)
+			reply("250 ok")
+		case "RSET" == cmd, "NOOP" == cmd:
+			reply("250 ok")
+		case "QUIT" == cmd:
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func printMail(from string, to []string, data []byte) {
+	r := bufio.NewReader(bytes.NewReader(data))
+	header, _ := textproto.NewReader(r).ReadMIMEHeader()
+	body, _ := io.ReadAll(r)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(header.Get("Subject"))
+	if nil != err {
+		subject = header.Get("Subject")
+	}
+
+	log.Printf("[smtp] from=%s to=%s subject=%s\n%s\n", from, strings.Join(to, ","), subject, body)
+}
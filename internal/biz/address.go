@@ -0,0 +1,208 @@
+package biz
+
+import (
+	pb "cardbinance/api/user/v1"
+	"context"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 提现地址所在链
+const (
+	ChainBSC  = "BSC"
+	ChainETH  = "ETH"
+	ChainTRON = "TRON"
+)
+
+const (
+	withdrawAddressMax         = 20
+	withdrawAddressLabelLength = 30
+	defaultAddressCooldown     = 24 * time.Hour
+)
+
+var (
+	evmAddressRe  = regexp.MustCompile("^0x[0-9a-fA-F]{40}$")
+	tronAddressRe = regexp.MustCompile("^T[1-9A-HJ-NP-Za-km-z]{33}$")
+)
+
+// WithdrawAddress 提现地址簿，AvailableAt 之前不能用于提现
+type WithdrawAddress struct {
+	ID          uint64
+	UserId      uint64
+	Chain       string
+	Address     string
+	Label       string
+	AvailableAt time.Time
+	CreatedAt   time.Time
+}
+
+type WithdrawAddressRepo interface {
+	GetWithdrawAddresses(ctx context.Context, userId uint64) ([]*WithdrawAddress, error)
+	GetWithdrawAddressById(ctx context.Context, userId, id uint64) (*WithdrawAddress, error)
+	// CreateWithdrawAddress 同一用户同一链上地址重复时返回 false
+	CreateWithdrawAddress(ctx context.Context, wa *WithdrawAddress) (*WithdrawAddress, bool, error)
+	DeleteWithdrawAddress(ctx context.Context, userId, id uint64) error
+}
+
+// normalizeWithdrawAddress 校验地址格式，EVM 地址统一为小写
+func normalizeWithdrawAddress(chain, address string) (string, string, bool) {
+	chain = strings.ToUpper(strings.TrimSpace(chain))
+	address = strings.TrimSpace(address)
+
+	switch chain {
+	case ChainBSC, ChainETH:
+		if !evmAddressRe.MatchString(address) {
+			return "", "", false
+		}
+		return chain, strings.ToLower(address), true
+	case ChainTRON:
+		if !tronAddressRe.MatchString(address) {
+			return "", "", false
+		}
+		return chain, address, true
+	}
+
+	return "", "", false
+}
+
+// WithdrawAddressList .
+func (uuc *UserUseCase) WithdrawAddressList(ctx context.Context, req *pb.WithdrawAddressListRequest, userId uint64) (*pb.WithdrawAddressListReply, error) {
+	res := make([]*pb.WithdrawAddressListReply_List, 0)
+
+	addresses, err := uuc.addressRepo.GetWithdrawAddresses(ctx, userId)
+	if nil != err {
+		return &pb.WithdrawAddressListReply{Status: "查询错误"}, nil
+	}
+
+	now := time.Now()
+	for _, v := range addresses {
+		res = append(res, &pb.WithdrawAddressListReply_List{
+			Id:          v.ID,
+			Chain:       v.Chain,
+			Address:     v.Address,
+			Label:       v.Label,
+			CreatedAt:   v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			AvailableAt: v.AvailableAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			Available:   !now.Before(v.AvailableAt),
+		})
+	}
+
+	return &pb.WithdrawAddressListReply{Status: "ok", List: res}, nil
+}
+
+// AddWithdrawAddress cooldown 为新地址可用前的等待时间，0 使用默认值
+func (uuc *UserUseCase) AddWithdrawAddress(ctx context.Context, req *pb.AddWithdrawAddressRequest, userId uint64, cooldown time.Duration) (*pb.AddWithdrawAddressReply, error) {
+	chain, address, ok := normalizeWithdrawAddress(req.SendBody.Chain, req.SendBody.Address)
+	if !ok {
+		return &pb.AddWithdrawAddressReply{Status: "地址格式错误"}, nil
+	}
+
+	label := strings.TrimSpace(req.SendBody.Label)
+	if withdrawAddressLabelLength < utf8.RuneCountInString(label) {
+		return &pb.AddWithdrawAddressReply{Status: "备注过长"}, nil
+	}
+
+	addresses, err := uuc.addressRepo.GetWithdrawAddresses(ctx, userId)
+	if nil != err {
+		return &pb.AddWithdrawAddressReply{Status: "查询错误"}, nil
+	}
+
+	if withdrawAddressMax <= len(addresses) {
+		return &pb.AddWithdrawAddressReply{Status: "地址数量已达上限"}, nil
+	}
+
+	if 0 >= cooldown {
+		cooldown = defaultAddressCooldown
+	}
+
+	var (
+		wa      *WithdrawAddress
+		created bool
+		now     = time.Now()
+	)
+
+	// 地址变更的通知是强制的，通知写入失败时不添加
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		wa, created, err = uuc.addressRepo.CreateWithdrawAddress(ctx, &WithdrawAddress{
+			UserId:      userId,
+			Chain:       chain,
+			Address:     address,
+			Label:       label,
+			AvailableAt: now.Add(cooldown),
+			CreatedAt:   now,
+		})
+		if nil != err || !created {
+			return err
+		}
+
+		return uuc.notifier.notify(ctx, userId, NotifyEventWithdrawAddressAdd, map[string]interface{}{
+			"Chain":       wa.Chain,
+			"Address":     wa.Address,
+			"Label":       wa.Label,
+			"AvailableAt": wa.AvailableAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}); nil != err {
+		uuc.log.Errorw("msg", "添加提现地址错误", "err", err, "user_id", userId)
+		return &pb.AddWithdrawAddressReply{Status: "添加错误"}, nil
+	}
+
+	if !created {
+		return &pb.AddWithdrawAddressReply{Status: "地址已存在"}, nil
+	}
+
+	availableAt := wa.AvailableAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05")
+	return &pb.AddWithdrawAddressReply{Status: "ok", Id: wa.ID, AvailableAt: availableAt}, nil
+}
+
+// DeleteWithdrawAddress .
+func (uuc *UserUseCase) DeleteWithdrawAddress(ctx context.Context, req *pb.DeleteWithdrawAddressRequest, userId uint64) (*pb.DeleteWithdrawAddressReply, error) {
+	wa, err := uuc.addressRepo.GetWithdrawAddressById(ctx, userId, req.SendBody.Id)
+	if nil != err {
+		return &pb.DeleteWithdrawAddressReply{Status: "查询错误"}, nil
+	}
+
+	if nil == wa {
+		return &pb.DeleteWithdrawAddressReply{Status: "地址不存在"}, nil
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		if err := uuc.addressRepo.DeleteWithdrawAddress(ctx, userId, wa.ID); nil != err {
+			return err
+		}
+
+		return uuc.notifier.notify(ctx, userId, NotifyEventWithdrawAddressDelete, map[string]interface{}{
+			"Chain":   wa.Chain,
+			"Address": wa.Address,
+			"Label":   wa.Label,
+		})
+	}); nil != err {
+		uuc.log.Errorw("msg", "删除提现地址错误", "err", err, "user_id", userId, "id", wa.ID)
+		return &pb.DeleteWithdrawAddressReply{Status: "删除错误"}, nil
+	}
+
+	return &pb.DeleteWithdrawAddressReply{Status: "ok"}, nil
+}
+
+// withdrawTarget 提现目标地址，addressId 为 0 时为登录钱包地址；返回的 status 非空时拒绝提现
+func (uuc *UserUseCase) withdrawTarget(ctx context.Context, user *User, addressId uint64) (string, string, string) {
+	if 0 == addressId {
+		return user.Address, "", ""
+	}
+
+	wa, err := uuc.addressRepo.GetWithdrawAddressById(ctx, user.ID, addressId)
+	if nil != err {
+		return "", "", "查询地址错误"
+	}
+
+	if nil == wa {
+		return "", "", "提现地址不存在"
+	}
+
+	if time.Now().Before(wa.AvailableAt) {
+		return "", "", "新地址" + wa.AvailableAt.Add(8*time.Hour).Format("2006-01-02 15:04:05") + "后可用"
+	}
+
+	return wa.Address, wa.Chain, ""
+}
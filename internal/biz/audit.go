@@ -0,0 +1,364 @@
+package biz
+
+import (
+	pb "cardbinance/api/user/v1"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	auditRetries  = 5
+	auditBackoff  = 100 * time.Millisecond
+	auditRedacted = "redacted" // 加密失败时不保存明文
+)
+
+// 审计日志里不保存的请求字段：签名、PIN 等凭证和个人信息，审计日志不能删除，个人信息写入后无法擦除
+var auditRedactFields = []string{
+	"sign", "pin", "encryptedPin", "totpCode", "code",
+	"idCard", "email", "firstName", "lastName", "phone", "street", "postalCode", "city", "state",
+}
+
+// AuditLog 只追加，Hash = sha256(PrevHash + 内容)，任何一条被修改或删除都会使后续校验失败。
+// Ip、UserAgent、Request 用操作用户的审计密钥加密后再计算哈希，注销时删除密钥，哈希链不受影响
+type AuditLog struct {
+	ID        uint64
+	ActorId   uint64
+	TargetId  uint64
+	Actor     string // 后台操作人，用户操作为空
+	Operation string
+	Ip        string
+	UserAgent string
+	Request   string
+	Before    string
+	After     string
+	Result    string
+	PrevHash  string
+	Hash      string
+	CreatedAt time.Time
+}
+
+type AuditFilter struct {
+	ActorId   uint64
+	TargetId  uint64
+	Operation string
+	StartTime *time.Time
+	EndTime   *time.Time
+}
+
+type AuditRepo interface {
+	// LockAuditChain 锁住链头并返回最后一条的哈希，需要在事务中调用
+	LockAuditChain(ctx context.Context) (string, error)
+	// CreateAuditLog 写入并更新链头，需要在 LockAuditChain 的事务中调用
+	CreateAuditLog(ctx context.Context, al *AuditLog) error
+	GetAuditLogsPage(ctx context.Context, b *Pagination, filter *AuditFilter) ([]*AuditLog, error, int64)
+	// GetAuditLogsAfterId 按 id 正序
+	GetAuditLogsAfterId(ctx context.Context, id uint64, limit int) ([]*AuditLog, error)
+	// SealAuditFields 用用户的审计密钥加密，用户已注销时替换为 erased
+	SealAuditFields(ctx context.Context, userId uint64, fields []*string) error
+	// OpenAuditFields 解密，密钥已删除时替换为 erased
+	OpenAuditFields(ctx context.Context, userId uint64, fields []*string) error
+	// ReencryptAuditKeys 同 UserRepo.ReencryptUsers
+	ReencryptAuditKeys(ctx context.Context, afterId uint64, limit int) (uint64, int, error)
+}
+
+// auditState 审计快照中单个用户的状态
+type auditState struct {
+	UserId   uint64             `json:"userId"`
+	Vip      uint64             `json:"vip"`
+	Balances map[string]float64 `json:"balances"`
+	Cards    map[string]string  `json:"cards"` // 卡片id => 状态
+}
+
+// auditHash Actor 为空时不参与计算，与之前写入的记录保持一致
+func auditHash(al *AuditLog) string {
+	fields := []string{
+		al.PrevHash,
+		strconv.FormatUint(al.ActorId, 10),
+		strconv.FormatUint(al.TargetId, 10),
+		al.Operation,
+		al.Ip,
+		al.UserAgent,
+		al.Request,
+		al.Before,
+		al.After,
+		al.Result,
+		strconv.FormatInt(al.CreatedAt.Unix(), 10),
+	}
+	if "" != al.Actor {
+		fields = append(fields, al.Actor)
+	}
+	content := strings.Join(fields, "\n")
+
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// clearSendBody 复制请求并清空 send_body 中的指定字段
+func clearSendBody(req proto.Message, names ...string) proto.Message {
+	tmp := proto.Clone(req)
+	m := tmp.ProtoReflect()
+	if fd := m.Descriptor().Fields().ByName("send_body"); nil != fd && m.Has(fd) {
+		body := m.Mutable(fd).Message()
+		for _, name := range names {
+			if f := body.Descriptor().Fields().ByName(protoreflect.Name(name)); nil != f {
+				body.Clear(f)
+			}
+		}
+	}
+
+	return tmp
+}
+
+// replyStatus 回复中的 status 字段，非接口回复用 StringValue 记录结果
+func replyStatus(reply proto.Message) string {
+	if v, ok := reply.(*wrapperspb.StringValue); ok {
+		return v.GetValue()
+	}
+
+	m := reply.ProtoReflect()
+	if !m.IsValid() {
+		return ""
+	}
+
+	if fd := m.Descriptor().Fields().ByName("status"); nil != fd && protoreflect.StringKind == fd.Kind() {
+		return m.Get(fd).String()
+	}
+
+	return ""
+}
+
+// auditSnapshot 用户的 vip、各币种余额和卡片状态
+func (uuc *UserUseCase) auditSnapshot(ctx context.Context, userIds ...uint64) string {
+	states := make([]*auditState, 0, len(userIds))
+	for k, userId := range userIds {
+		if 0 == userId || (0 < k && userId == userIds[k-1]) {
+			continue
+		}
+
+		state := &auditState{
+			UserId:   userId,
+			Balances: make(map[string]float64, 0),
+			Cards:    make(map[string]string, 0),
+		}
+
+		if user, err := uuc.repo.GetUserById(userId); nil == err && nil != user {
+			state.Vip = user.Vip
+		}
+
+		if balances, err := uuc.balanceRepo.GetBalancesByUserId(ctx, userId); nil == err {
+			for _, v := range balances {
+				state.Balances[v.Currency] = v.Amount
+			}
+		}
+
+		if cards, err := uuc.cardRepo.GetCardsByUserId(ctx, userId); nil == err {
+			for _, v := range cards {
+				state.Cards[strconv.FormatUint(v.ID, 10)] = v.Status
+			}
+		}
+
+		states = append(states, state)
+	}
+
+	b, _ := json.Marshal(states)
+	return string(b)
+}
+
+// Audited 执行 fn 并记录审计日志，包括操作前后操作人和目标用户的状态。
+// entry 由调用方填写操作人、操作、IP 和 User-Agent，targetAddress 为空时目标即操作人
+func Audited[T proto.Message](ctx context.Context, uuc *UserUseCase, entry *AuditLog, targetAddress string, req proto.Message, fn func() (T, error)) (T, error) {
+	entry.TargetId = entry.ActorId
+	if "" != targetAddress {
+		if target, err := uuc.repo.GetUserByAddress(targetAddress); nil == err && nil != target {
+			entry.TargetId = target.ID
+		} else {
+			entry.TargetId = 0
+		}
+	}
+
+	if b, err := protojson.Marshal(clearSendBody(req, auditRedactFields...)); nil == err {
+		entry.Request = string(b)
+	}
+	entry.Before = uuc.auditSnapshot(ctx, entry.ActorId, entry.TargetId)
+
+	reply, err := fn()
+	if nil != err {
+		entry.Result = err.Error()
+	} else {
+		entry.Result = replyStatus(reply)
+	}
+	entry.After = uuc.auditSnapshot(ctx, entry.ActorId, entry.TargetId)
+
+	// 审计写入失败不影响已完成的业务
+	if errAudit := uuc.appendAuditLog(ctx, entry); nil != errAudit {
+		uuc.log.Errorw("msg", "审计日志写入失败", "err", errAudit, "user_id", entry.ActorId, "operation", entry.Operation)
+	}
+
+	return reply, err
+}
+
+// appendAuditLog 在事务内锁住链头串行追加，保证哈希链连续；锁冲突或数据库错误时重试，
+// 业务已经完成，不随请求取消
+func (uuc *UserUseCase) appendAuditLog(ctx context.Context, entry *AuditLog) error {
+	ctx = context.WithoutCancel(ctx)
+
+	if 255 < len(entry.Result) {
+		entry.Result = entry.Result[:255]
+	}
+	if 255 < len(entry.UserAgent) {
+		entry.UserAgent = entry.UserAgent[:255]
+	}
+
+	// 入库只保留到秒，与哈希内容一致
+	entry.CreatedAt = time.Now().Truncate(time.Second)
+
+	// 后台操作不记录个人信息，不需要加密
+	if 0 != entry.ActorId {
+		fields := []*string{&entry.Ip, &entry.UserAgent, &entry.Request}
+		if err := uuc.auditRepo.SealAuditFields(ctx, entry.ActorId, fields); nil != err {
+			// 仍然记录操作，只丢弃个人信息
+			uuc.log.Errorw("msg", "审计日志加密失败", "err", err, "user_id", entry.ActorId, "operation", entry.Operation)
+			for _, v := range fields {
+				*v = auditRedacted
+			}
+		}
+	}
+
+	var err error
+	for i := 0; i < auditRetries; i++ {
+		if 0 < i {
+			time.Sleep(time.Duration(i) * auditBackoff)
+		}
+
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			prevHash, errTwo := uuc.auditRepo.LockAuditChain(ctx)
+			if nil != errTwo {
+				return errTwo
+			}
+
+			entry.PrevHash = prevHash
+			entry.Hash = auditHash(entry)
+			return uuc.auditRepo.CreateAuditLog(ctx, entry)
+		}); nil == err {
+			return nil
+		}
+	}
+
+	return err
+}
+
+// AuditList .
+func (uuc *UserUseCase) AuditList(ctx context.Context, req *pb.AuditListRequest) (*pb.AuditListReply, error) {
+	var (
+		logs  []*AuditLog
+		count int64
+		err   error
+	)
+
+	filter := &AuditFilter{
+		ActorId:   req.ActorId,
+		TargetId:  req.TargetId,
+		Operation: req.Operation,
+	}
+
+	if "" != req.StartTime {
+		tmp, errParse := time.ParseInLocation("2006-01-02 15:04:05", req.StartTime, time.UTC)
+		if nil != errParse {
+			return &pb.AuditListReply{Status: "开始时间格式错误"}, nil
+		}
+		filter.StartTime = &tmp
+	}
+
+	if "" != req.EndTime {
+		tmp, errParse := time.ParseInLocation("2006-01-02 15:04:05", req.EndTime, time.UTC)
+		if nil != errParse {
+			return &pb.AuditListReply{Status: "结束时间格式错误"}, nil
+		}
+		filter.EndTime = &tmp
+	}
+
+	if 1 > req.Page {
+		req.Page = 1
+	}
+
+	logs, err, count = uuc.auditRepo.GetAuditLogsPage(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 20,
+	}, filter)
+	if nil != err {
+		return &pb.AuditListReply{Status: "查询错误"}, nil
+	}
+
+	res := make([]*pb.AuditListReply_List, 0, len(logs))
+	for _, v := range logs {
+		if 0 != v.ActorId {
+			if err = uuc.auditRepo.OpenAuditFields(ctx, v.ActorId, []*string{&v.Ip, &v.UserAgent, &v.Request}); nil != err {
+				uuc.log.Errorw("msg", "审计日志解密失败", "err", err, "id", v.ID)
+			}
+		}
+
+		res = append(res, &pb.AuditListReply_List{
+			Id:        v.ID,
+			ActorId:   v.ActorId,
+			TargetId:  v.TargetId,
+			Actor:     v.Actor,
+			Operation: v.Operation,
+			Ip:        v.Ip,
+			UserAgent: v.UserAgent,
+			Request:   v.Request,
+			Before:    v.Before,
+			After:     v.After,
+			Result:    v.Result,
+			PrevHash:  v.PrevHash,
+			Hash:      v.Hash,
+			CreatedAt: v.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &pb.AuditListReply{
+		Status: "ok",
+		Count:  uint64(count),
+		List:   res,
+	}, nil
+}
+
+// AuditVerify 从第一条开始重新计算哈希链
+func (uuc *UserUseCase) AuditVerify(ctx context.Context, req *pb.AuditVerifyRequest) (*pb.AuditVerifyReply, error) {
+	var (
+		lastId   uint64
+		prevHash string
+		count    uint64
+	)
+
+	for {
+		logs, err := uuc.auditRepo.GetAuditLogsAfterId(ctx, lastId, 500)
+		if nil != err {
+			return &pb.AuditVerifyReply{Status: "查询错误", Count: count}, nil
+		}
+
+		for _, v := range logs {
+			if prevHash != v.PrevHash || auditHash(v) != v.Hash {
+				return &pb.AuditVerifyReply{Status: "校验失败", Count: count, BrokenId: v.ID}, nil
+			}
+
+			prevHash = v.Hash
+			lastId = v.ID
+			count++
+		}
+
+		if 500 > len(logs) {
+			break
+		}
+	}
+
+	return &pb.AuditVerifyReply{Status: "ok", Count: count}, nil
+}
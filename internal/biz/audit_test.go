@@ -0,0 +1,273 @@
+package biz
+
+import (
+	pb "cardbinance/api/user/v1"
+	"context"
+	"errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// stubTx 直接执行，failures 次之前返回错误
+type stubTx struct {
+	failures int
+	calls    int
+}
+
+func (s *stubTx) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	s.calls++
+	if s.calls <= s.failures {
+		return errors.New("deadlock")
+	}
+
+	return fn(ctx)
+}
+
+// stubAuditRepo 密文为 ak1:<序号>，明文按用户保存，删除用户的明文即删除密钥
+type stubAuditRepo struct {
+	AuditRepo
+	logs  []*AuditLog
+	plain map[uint64]map[string]string
+}
+
+func newStubAuditRepo() *stubAuditRepo {
+	return &stubAuditRepo{plain: make(map[uint64]map[string]string, 0)}
+}
+
+func (s *stubAuditRepo) LockAuditChain(ctx context.Context) (string, error) {
+	if 0 == len(s.logs) {
+		return "", nil
+	}
+
+	return s.logs[len(s.logs)-1].Hash, nil
+}
+
+func (s *stubAuditRepo) CreateAuditLog(ctx context.Context, al *AuditLog) error {
+	tmp := *al
+	tmp.ID = uint64(len(s.logs) + 1)
+	s.logs = append(s.logs, &tmp)
+	return nil
+}
+
+func (s *stubAuditRepo) GetAuditLogsPage(ctx context.Context, b *Pagination, filter *AuditFilter) ([]*AuditLog, error, int64) {
+	res := make([]*AuditLog, 0, len(s.logs))
+	for _, v := range s.logs {
+		tmp := *v
+		res = append(res, &tmp)
+	}
+
+	return res, nil, int64(len(res))
+}
+
+func (s *stubAuditRepo) GetAuditLogsAfterId(ctx context.Context, id uint64, limit int) ([]*AuditLog, error) {
+	res := make([]*AuditLog, 0, len(s.logs))
+	for _, v := range s.logs {
+		if id < v.ID {
+			tmp := *v
+			res = append(res, &tmp)
+		}
+	}
+
+	return res, nil
+}
+
+func (s *stubAuditRepo) SealAuditFields(ctx context.Context, userId uint64, fields []*string) error {
+	if nil == s.plain {
+		return errors.New("audit key unavailable")
+	}
+
+	if _, ok := s.plain[userId]; !ok {
+		s.plain[userId] = make(map[string]string, 0)
+	}
+
+	for _, v := range fields {
+		sealed := "ak1:" + strconv.Itoa(len(s.plain[userId]))
+		s.plain[userId][sealed] = *v
+		*v = sealed
+	}
+
+	return nil
+}
+
+func (s *stubAuditRepo) OpenAuditFields(ctx context.Context, userId uint64, fields []*string) error {
+	for _, v := range fields {
+		if plain, ok := s.plain[userId][*v]; ok {
+			*v = plain
+		} else if strings.HasPrefix(*v, "ak1:") {
+			*v = "erased"
+		}
+	}
+
+	return nil
+}
+
+func TestAuditErasure(t *testing.T) {
+	repo := newStubAuditRepo()
+	uuc := &UserUseCase{auditRepo: repo, tx: &stubTx{}, log: log.NewHelper(log.DefaultLogger)}
+	ctx := context.Background()
+
+	entries := []*AuditLog{
+		{ActorId: 7, TargetId: 7, Operation: "Withdraw", Ip: "10.0.0.7", UserAgent: "ua-7", Request: "{\"amount\":10}"},
+		{ActorId: 8, TargetId: 8, Operation: "Withdraw", Ip: "10.0.0.8", UserAgent: "ua-8", Request: "{\"amount\":20}"},
+		{TargetId: 7, Actor: "alice", Operation: "AdminAccountErase", Ip: "10.0.0.1", UserAgent: "ua-admin", Request: "{}"},
+	}
+	for _, v := range entries {
+		if err := uuc.appendAuditLog(ctx, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 销毁用户 7 的密钥
+	delete(repo.plain, 7)
+
+	verify, err := uuc.AuditVerify(ctx, &pb.AuditVerifyRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if "ok" != verify.Status || 3 != verify.Count {
+		t.Errorf("AuditVerify = %s/%d, want ok/3", verify.Status, verify.Count)
+	}
+
+	list, err := uuc.AuditList(ctx, &pb.AuditListRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		sealed    bool
+		ip        string
+		userAgent string
+		request   string
+		actor     string
+	}{
+		{name: "erased user", sealed: true, ip: "erased", userAgent: "erased", request: "erased"},
+		{name: "active user", sealed: true, ip: "10.0.0.8", userAgent: "ua-8", request: "{\"amount\":20}"},
+		{name: "admin entry not sealed", ip: "10.0.0.1", userAgent: "ua-admin", request: "{}", actor: "alice"},
+	}
+
+	for k, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if sealed := strings.HasPrefix(repo.logs[k].Ip, "ak1:"); sealed != tt.sealed {
+				t.Errorf("stored ip %q sealed = %v, want %v", repo.logs[k].Ip, sealed, tt.sealed)
+			}
+
+			got := list.List[k]
+			if tt.ip != got.Ip || tt.userAgent != got.UserAgent || tt.request != got.Request || tt.actor != got.Actor {
+				t.Errorf("AuditList = %q %q %q %q, want %q %q %q %q", got.Ip, got.UserAgent, got.Request, got.Actor, tt.ip, tt.userAgent, tt.request, tt.actor)
+			}
+		})
+	}
+}
+
+func TestAuditHashActor(t *testing.T) {
+	al := &AuditLog{ActorId: 1, Operation: "Withdraw", CreatedAt: time.Unix(1700000000, 0)}
+	legacy := auditHash(al)
+
+	al.Actor = "alice"
+	if legacy == auditHash(al) {
+		t.Errorf("auditHash ignores Actor")
+	}
+
+	al.Actor = ""
+	if legacy != auditHash(al) {
+		t.Errorf("auditHash with empty Actor changed")
+	}
+}
+
+func TestClearSendBody(t *testing.T) {
+	req := &pb.OpenCardRequest{SendBody: &pb.OpenCardRequest_SendBody{
+		Sign:        "0xsign",
+		Email:       "ivan@example.com",
+		FirstName:   "Ivan",
+		LastName:    "Petrov",
+		Phone:       "79990001122",
+		Street:      "Tverskaya 1",
+		PostalCode:  "125009",
+		City:        "Moscow",
+		State:       "MOW",
+		IdCard:      "4500123456",
+		Country:     "RU",
+		CountryCode: "RU",
+		Gender:      "male",
+	}}
+
+	b, err := protojson.Marshal(clearSendBody(req, auditRedactFields...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(b)
+
+	tests := []struct {
+		name  string
+		value string
+		kept  bool
+	}{
+		{name: "sign", value: "0xsign"},
+		{name: "email", value: "ivan@example.com"},
+		{name: "first name", value: "Ivan"},
+		{name: "last name", value: "Petrov"},
+		{name: "phone", value: "79990001122"},
+		{name: "street", value: "Tverskaya 1"},
+		{name: "postal code", value: "125009"},
+		{name: "city", value: "Moscow"},
+		{name: "state", value: "MOW"},
+		{name: "id card", value: "4500123456"},
+		{name: "country kept", value: "\"RU\"", kept: true},
+		{name: "gender kept", value: "male", kept: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if strings.Contains(got, tt.value) != tt.kept {
+				t.Errorf("request %s contains %q = %v, want %v", got, tt.value, !tt.kept, tt.kept)
+			}
+		})
+	}
+
+	if "Ivan" != req.SendBody.FirstName {
+		t.Errorf("clearSendBody modified the original request")
+	}
+}
+
+func TestAppendAuditLogRetry(t *testing.T) {
+	tests := []struct {
+		name     string
+		failures int
+		sealErr  bool
+		wantErr  bool
+		wantLogs int
+		wantIp   string
+	}{
+		{name: "first attempt", wantLogs: 1, wantIp: "ak1:0"},
+		{name: "retried after conflicts", failures: auditRetries - 1, wantLogs: 1, wantIp: "ak1:0"},
+		{name: "gives up after retries", failures: auditRetries, wantErr: true},
+		{name: "seal failure keeps entry", sealErr: true, wantLogs: 1, wantIp: auditRedacted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubAuditRepo()
+			if tt.sealErr {
+				repo.plain = nil
+			}
+			uuc := &UserUseCase{auditRepo: repo, tx: &stubTx{failures: tt.failures}, log: log.NewHelper(log.DefaultLogger)}
+
+			err := uuc.appendAuditLog(context.Background(), &AuditLog{ActorId: 7, Operation: "Withdraw", Ip: "10.0.0.7"})
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("appendAuditLog() err = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantLogs != len(repo.logs) {
+				t.Fatalf("stored %d logs, want %d", len(repo.logs), tt.wantLogs)
+			}
+			if 0 < len(repo.logs) && tt.wantIp != repo.logs[0].Ip {
+				t.Errorf("stored ip %q, want %q", repo.logs[0].Ip, tt.wantIp)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package biz
+
+import (
+	pb "cardbinance/api/user/v1"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	CurrencyUSDT = "USDT" // 链上USDT，存在 user.amount
+	CurrencyUSDC = "USDC" // 链上USDC
+	CurrencyUSD  = "USD"  // 卡片法币备付金
+)
+
+// 钱包支持的币种，GetUser 按此顺序返回
+var walletCurrencies = []string{CurrencyUSDT, CurrencyUSDC, CurrencyUSD}
+
+type Balance struct {
+	UserId   uint64
+	Currency string
+	Amount   float64
+}
+
+type BalanceRepo interface {
+	GetBalancesByUserId(ctx context.Context, userId uint64) ([]*Balance, error)
+	GetBalance(ctx context.Context, userId uint64, currency string) (float64, error)
+	Exchange(ctx context.Context, userId uint64, from, to string, amount, amountTo float64) error
+}
+
+// parseCurrency 空值为USDT，不支持的币种返回false
+func parseCurrency(currency string) (string, bool) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if "" == currency {
+		return CurrencyUSDT, true
+	}
+
+	for _, v := range walletCurrencies {
+		if v == currency {
+			return currency, true
+		}
+	}
+
+	return "", false
+}
+
+// getCurrencyRates 各币种折合USDT的价格，config 表 currency_rate_usdc、currency_rate_usd，未配置按1
+func (uuc *UserUseCase) getCurrencyRates() map[string]float64 {
+	rates := map[string]float64{
+		CurrencyUSDT: 1,
+		CurrencyUSDC: 1,
+		CurrencyUSD:  1,
+	}
+
+	configs, _ := uuc.repo.GetConfigByKeys("currency_rate_usdc", "currency_rate_usd")
+	if nil != configs {
+		for _, vConfig := range configs {
+			tmp, err := strconv.ParseFloat(vConfig.Value, 10)
+			if nil != err || 0 >= tmp {
+				continue
+			}
+
+			if "currency_rate_usdc" == vConfig.KeyName {
+				rates[CurrencyUSDC] = tmp
+			}
+			if "currency_rate_usd" == vConfig.KeyName {
+				rates[CurrencyUSD] = tmp
+			}
+		}
+	}
+
+	return rates
+}
+
+// convertCurrency 按配置价格把 amount 个 from 换算成 to
+func convertCurrency(rates map[string]float64, from, to string, amount float64) float64 {
+	if from == to {
+		return amount
+	}
+
+	return amount * rates[from] / rates[to]
+}
+
+func (uuc *UserUseCase) balancesReply(ctx context.Context, userId uint64) []*pb.Balance {
+	res := make([]*pb.Balance, 0, len(walletCurrencies))
+
+	balances, err := uuc.balanceRepo.GetBalancesByUserId(ctx, userId)
+	if nil != err {
+		return res
+	}
+
+	amounts := make(map[string]float64, len(balances))
+	for _, v := range balances {
+		amounts[v.Currency] = v.Amount
+	}
+
+	for _, currency := range walletCurrencies {
+		res = append(res, &pb.Balance{
+			Currency: currency,
+			Amount:   fmt.Sprintf("%.2f", amounts[currency]),
+		})
+	}
+
+	return res
+}
+
+// Exchange 币种兑换，手续费按到账币种计算并从到账数量中扣除
+func (uuc *UserUseCase) Exchange(ctx context.Context, req *pb.ExchangeRequest, userId uint64) (*pb.ExchangeReply, error) {
+	var (
+		user    *User
+		err     error
+		from    string
+		to      string
+		ok      bool
+		balance float64
+	)
+
+	from, ok = parseCurrency(req.SendBody.From)
+	if !ok {
+		return &pb.ExchangeReply{Status: "币种错误"}, nil
+	}
+
+	to, ok = parseCurrency(req.SendBody.To)
+	if !ok {
+		return &pb.ExchangeReply{Status: "币种错误"}, nil
+	}
+
+	if from == to {
+		return &pb.ExchangeReply{Status: "币种相同"}, nil
+	}
+
+	if 0 >= req.SendBody.Amount {
+		return &pb.ExchangeReply{Status: "数量错误"}, nil
+	}
+
+	user, err = uuc.repo.GetUserById(userId)
+	if nil == user || nil != err {
+		return &pb.ExchangeReply{Status: "用户不存在"}, nil
+	}
+
+	balance, err = uuc.balanceRepo.GetBalance(ctx, userId, from)
+	if nil != err {
+		return &pb.ExchangeReply{Status: "查询余额错误"}, nil
+	}
+
+	if float64(req.SendBody.Amount) > balance {
+		return &pb.ExchangeReply{Status: "账号余额不足"}, nil
+	}
+
+	// 兑换规则按到账币种配置，最低金额和手续费都用换算后的金额比较
+	fee := uuc.getFeeSchedule(ctx, FeeOpExchange, "0", user.Vip)
+	amountTo := convertCurrency(uuc.getCurrencyRates(), from, to, float64(req.SendBody.Amount))
+	if fee.MinAmount > amountTo {
+		return &pb.ExchangeReply{Status: fmt.Sprintf("兑换最少%.2f%s", fee.MinAmount, to)}, nil
+	}
+
+	amountTo -= fee.Calc(amountTo)
+	if 0 >= amountTo {
+		return &pb.ExchangeReply{Status: "手续费错误"}, nil
+	}
+
+	if err = uuc.lockedTx(ctx, []string{balanceLockKey(userId)}, func(ctx context.Context) error { // 事务
+		err = uuc.balanceRepo.Exchange(ctx, userId, from, to, float64(req.SendBody.Amount), amountTo)
+		if nil != err {
+			return err
+		}
+
+		return nil
+	}); nil != err {
+		uuc.log.Errorw("msg", "兑换写入mysql错误", "err", err, "user_id", userId)
+		return &pb.ExchangeReply{
+			Status: "兑换错误，联系管理员",
+		}, nil
+	}
+	uuc.publishBalance(ctx, userId)
+
+	return &pb.ExchangeReply{
+		Status: "ok",
+		Amount: fmt.Sprintf("%.2f", amountTo),
+	}, nil
+}
@@ -0,0 +1,282 @@
+package biz
+
+import (
+	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/pkg/interlace"
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"time"
+)
+
+// errOpenCardRejected 开卡事务内检查不通过时回滚，原因由调用方另行返回给客户端
+var errOpenCardRejected = errors.New(409, "OPEN_CARD_REJECTED", "开卡条件不满足")
+
+const (
+	CardTypeVirtual  uint64 = 1 // 虚拟卡
+	CardTypePhysical uint64 = 2 // 实体卡
+)
+
+const (
+	CardStatusPending   = "pending"   // 已提交，等待发卡
+	CardStatusReview    = "review"    // 实体卡申请疑似命中名单，等待合规审核
+	CardStatusActive    = "active"    // 已激活
+	CardStatusFrozen    = "frozen"    // 已冻结
+	CardStatusReplacing = "replacing" // 补卡中
+	CardStatusClosed    = "closed"    // 已注销
+)
+
+const (
+	CardTransferPending = "pending" // 已扣款，等待发卡方入账
+	CardTransferSuccess = "success"
+	CardTransferFailed  = "failed" // 发卡方入账失败，可重新发起
+)
+
+// 每个用户最多持有的虚拟卡数量，config 表 card_virtual_max 可覆盖
+const defaultVirtualCardMax = 5
+
+type Card struct {
+	ID           uint64
+	UserId       uint64
+	CardType     uint64
+	ProductId    string
+	IssuerCardId string
+	Status       string
+	MaskedPan    string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Usable 已发卡且未冻结/注销，可以请求发卡方
+func (c *Card) Usable() bool {
+	return CardStatusActive == c.Status && 10 <= len(c.IssuerCardId)
+}
+
+// CardTransfer 划转到卡的发卡方入账记录，OrderId 即发卡方的 clientTransactionId
+type CardTransfer struct {
+	ID        uint64
+	UserId    uint64
+	CardId    uint64
+	RewardId  uint64
+	OrderId   string
+	Amount    float64 // 卡片入账金额，USD
+	Status    string
+	Error     string
+	Attempts  uint64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CardTransaction 从发卡方同步的卡片交易流水，TxnId 为发卡方交易id
+type CardTransaction struct {
+	ID                  uint64
+	TxnId               string
+	UserId              uint64
+	CardId              uint64
+	IssuerCardId        string
+	ClientTransactionId string
+	Type                int32
+	Status              string
+	Currency            string
+	Amount              float64
+	Fee                 float64
+	TransactionCurrency string
+	TransactionAmount   float64
+	MerchantName        string
+	Mcc                 string
+	TransactionTime     time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// CardBalance 发卡方最近一次返回的卡余额
+type CardBalance struct {
+	Available string    `json:"available"`
+	Currency  string    `json:"currency"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type CardRepo interface {
+	GetCardsByUserId(ctx context.Context, userId uint64) ([]*Card, error)
+	GetCardByUserIdAndId(ctx context.Context, userId, cardId uint64) (*Card, error)
+	GetCardById(ctx context.Context, cardId uint64) (*Card, error)
+	GetCardByIssuerCardId(ctx context.Context, issuerCardId string) (*Card, error)
+	GetCardsByStatus(ctx context.Context, status string) ([]*Card, error)
+	CreateCard(ctx context.Context, card *Card) (*Card, error)
+	UpdateCardStatus(ctx context.Context, cardId uint64, status string) error
+	UpdateCardMaskedPan(ctx context.Context, cardId uint64, maskedPan string) error
+	IssueCard(ctx context.Context, cardId uint64, issuerCardId string) error
+	// CountOpenCards 未注销的卡片数量，加锁读取，需要在事务中调用
+	CountOpenCards(ctx context.Context, userId, cardType uint64) (int64, error)
+	// QueueLegacyIssue 写入外部发卡流程读取的 user 表字段，该类型已有待发卡申请时返回 false
+	QueueLegacyIssue(ctx context.Context, userId, cardType uint64) (bool, error)
+	MigrateLegacyCards(ctx context.Context, userId uint64) ([]*Card, error)
+	GetUserIdsWithLegacyCards(ctx context.Context) ([]uint64, error)
+	CreateCardTransfer(ctx context.Context, ct *CardTransfer) (*CardTransfer, error)
+	GetCardTransferByOrderId(ctx context.Context, orderId string) (*CardTransfer, error)
+	// UpdateCardTransfer 只更新状态为 fromStatus 的记录，并累加尝试次数
+	UpdateCardTransfer(ctx context.Context, id uint64, fromStatus, status, errMsg string) error
+	// ExpireCardTransfers before 之前仍为 pending 的记录置为 failed
+	ExpireCardTransfers(ctx context.Context, before time.Time) (int64, error)
+	// SaveCardTransaction 按 TxnId 新增或更新状态和金额，changed 为新增或有字段变化
+	SaveCardTransaction(ctx context.Context, ct *CardTransaction) (created bool, changed bool, err error)
+	GetLastCardTransactionTime(ctx context.Context) (time.Time, error)
+	// GetNonFinalCardTransactions id 大于 afterId 且状态不是 CLOSED/FAIL 的交易，按 id 升序
+	GetNonFinalCardTransactions(ctx context.Context, afterId uint64, limit int) ([]*CardTransaction, error)
+	CreateCardRecord(ctx context.Context, r *CardRecord) error
+	// IncrPinAttempts 返回窗口内的修改次数，窗口从第一次修改开始计算
+	IncrPinAttempts(ctx context.Context, cardId uint64, window time.Duration) (int64, error)
+	GetPinAttempts(ctx context.Context, cardId uint64) (int64, error)
+	// SaveCardBalance 缓存发卡方返回的余额，发卡方不可用时展示
+	SaveCardBalance(ctx context.Context, cardId uint64, balance *CardBalance) error
+	// GetCardBalance 没有缓存时返回 nil
+	GetCardBalance(ctx context.Context, cardId uint64) (*CardBalance, error)
+}
+
+// getUserCards 读取用户全部卡片，旧字段上的卡片已由 0002 迁移写入 card 表，读取时不再迁移
+func (uuc *UserUseCase) getUserCards(ctx context.Context, userId uint64) ([]*Card, error) {
+	return uuc.cardRepo.GetCardsByUserId(ctx, userId)
+}
+
+// getUserCard 按 cardId 查卡片；cardId 为 0 时兼容旧客户端，按卡片类型取第一张
+func (uuc *UserUseCase) getUserCard(ctx context.Context, userId, cardId, cardType uint64) (*Card, error) {
+	if 0 < cardId {
+		if _, err := uuc.getUserCards(ctx, userId); nil != err {
+			return nil, err
+		}
+
+		return uuc.cardRepo.GetCardByUserIdAndId(ctx, userId, cardId)
+	}
+
+	cards, err := uuc.getUserCards(ctx, userId)
+	if nil != err {
+		return nil, err
+	}
+
+	for _, v := range cards {
+		if cardType == v.CardType && CardStatusClosed != v.Status {
+			return v, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// MigrateLegacyCards 批量迁移旧字段上的卡片
+func (uuc *UserUseCase) MigrateLegacyCards(ctx context.Context) (int, error) {
+	userIds, err := uuc.cardRepo.GetUserIdsWithLegacyCards(ctx)
+	if nil != err {
+		return 0, err
+	}
+
+	for _, userId := range userIds {
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			_, errTwo := uuc.cardRepo.MigrateLegacyCards(ctx, userId)
+			return errTwo
+		}); nil != err {
+			return 0, err
+		}
+	}
+
+	return len(userIds), nil
+}
+
+func (uuc *UserUseCase) getVirtualCardMax() uint64 {
+	max := uint64(defaultVirtualCardMax)
+	configs, _ := uuc.repo.GetConfigByKeys("card_virtual_max")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "card_virtual_max" == vConfig.KeyName {
+				if tmp, err := strconv.ParseUint(vConfig.Value, 10, 64); nil == err && 0 < tmp {
+					max = tmp
+				}
+			}
+		}
+	}
+
+	return max
+}
+
+// cardBalance 查询发卡方余额并缓存，发卡方不可用时返回缓存的余额，stale 为 true
+func (uuc *UserUseCase) cardBalance(ctx context.Context, card *Card) (*CardBalance, bool, error) {
+	summary, err := uuc.interlace.CardSummary(ctx, card.IssuerCardId)
+	if nil == err {
+		balance := &CardBalance{
+			Available: summary.Balance.Available,
+			Currency:  summary.Balance.Currency,
+			UpdatedAt: time.Now(),
+		}
+		if errTwo := uuc.cardRepo.SaveCardBalance(ctx, card.ID, balance); nil != errTwo {
+			uuc.log.Errorw("msg", "缓存卡片余额失败", "err", errTwo, "card_id", card.ID)
+		}
+
+		return balance, false, nil
+	}
+
+	if !interlace.IsUnavailable(err) {
+		return nil, false, err
+	}
+
+	cached, errTwo := uuc.cardRepo.GetCardBalance(ctx, card.ID)
+	if nil != errTwo || nil == cached {
+		return nil, false, err
+	}
+
+	return cached, true, nil
+}
+
+func (uuc *UserUseCase) cardsReply(ctx context.Context, cards []*Card) []*pb.Card {
+	res := make([]*pb.Card, 0, len(cards))
+	for _, v := range cards {
+		var (
+			amount string
+			stale  bool
+		)
+		if v.Usable() {
+			balance, isStale, err := uuc.cardBalance(ctx, v)
+			if nil != err {
+				uuc.log.Warnw("msg", "查询卡片余额失败", "err", err, "user_id", v.UserId, "card_id", v.ID)
+			} else {
+				amount, stale = balance.Available, isStale
+			}
+		}
+
+		res = append(res, &pb.Card{
+			Id:          v.ID,
+			CardType:    v.CardType,
+			ProductId:   v.ProductId,
+			Status:      v.Status,
+			MaskedPan:   v.MaskedPan,
+			Amount:      amount,
+			AmountStale: stale,
+		})
+	}
+
+	return res
+}
+
+func (uuc *UserUseCase) CardList(ctx context.Context, req *pb.CardListRequest, userId uint64) (*pb.CardListReply, error) {
+	cards, err := uuc.getUserCards(ctx, userId)
+	if nil != err {
+		return &pb.CardListReply{Status: "查询错误", List: make([]*pb.Card, 0)}, nil
+	}
+
+	return &pb.CardListReply{
+		Status: "ok",
+		List:   uuc.cardsReply(ctx, cards),
+	}, nil
+}
+
+// notifyCard 卡片相关通知，模板中 Pan 为脱敏卡号
+func (uuc *UserUseCase) notifyCard(ctx context.Context, event string, card *Card, data map[string]interface{}) {
+	if nil == data {
+		data = make(map[string]interface{}, 1)
+	}
+
+	data["Pan"] = card.MaskedPan
+	if "" == card.MaskedPan {
+		data["Pan"] = "#" + strconv.FormatUint(card.ID, 10)
+	}
+
+	uuc.notifier.Notify(ctx, card.UserId, event, data)
+}
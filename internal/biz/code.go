@@ -0,0 +1,187 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultCodeTTL = 5 * time.Minute
+	codePollLimit  = 100
+	codeBacklog    = 5 // 连接时补发的最近验证码数量，每张卡
+)
+
+// CardCode 推送给客户端的验证码
+type CardCode struct {
+	ID        uint64
+	CardId    uint64
+	Code      string
+	Time      time.Time
+	ExpiresAt time.Time
+}
+
+// CodeSubscription 一个推送连接订阅的卡片
+type CodeSubscription struct {
+	C     chan *CardCode
+	cards map[string]uint64 // 脱敏卡号 -> 卡片id
+	hub   *CodeHub
+}
+
+// Close 取消订阅
+func (s *CodeSubscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	delete(s.hub.subs, s)
+}
+
+// CodeHub 验证码由外部写入 card_code 表，有推送连接时按 id 增量读取并分发给本实例的订阅者
+type CodeHub struct {
+	repo   UserRepo
+	uuc    *UserUseCase
+	mu     sync.Mutex
+	subs   map[*CodeSubscription]struct{}
+	lastId uint64
+	log    *log.Helper
+}
+
+func NewCodeHub(repo UserRepo, uuc *UserUseCase, logger log.Logger) *CodeHub {
+	return &CodeHub{
+		repo: repo,
+		uuc:  uuc,
+		subs: make(map[*CodeSubscription]struct{}),
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Subscribe 订阅用户所有可用卡片的验证码，返回的 backlog 为连接前已收到且未过期的验证码
+func (h *CodeHub) Subscribe(ctx context.Context, userId uint64, ttl time.Duration) (*CodeSubscription, []*CardCode, error) {
+	cards, err := h.uuc.getUserCards(ctx, userId)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	sub := &CodeSubscription{
+		C:     make(chan *CardCode, 16),
+		cards: make(map[string]uint64, len(cards)),
+		hub:   h,
+	}
+	for _, v := range cards {
+		if 5 < len(v.MaskedPan) && CardStatusClosed != v.Status {
+			sub.cards[v.MaskedPan] = v.ID
+		}
+	}
+
+	h.mu.Lock()
+	cold := 0 == len(h.subs)
+	h.subs[sub] = struct{}{}
+	h.mu.Unlock()
+
+	// 没有订阅者时不轮询，重新开始时从当前最大 id 之后读取
+	if cold {
+		lastId, errTwo := h.repo.GetLastCardCodeId(ctx)
+		if nil != errTwo {
+			sub.Close()
+			return nil, nil, errTwo
+		}
+
+		h.mu.Lock()
+		if lastId > h.lastId {
+			h.lastId = lastId
+		}
+		h.mu.Unlock()
+	}
+
+	backlog := make([]*CardCode, 0)
+	for pan, cardId := range sub.cards {
+		codes, err, _ := h.repo.GetUserCodePage(ctx, &Pagination{PageNum: 1, PageSize: codeBacklog}, pan)
+		if nil != err {
+			sub.Close()
+			return nil, nil, err
+		}
+
+		for i := len(codes) - 1; i >= 0; i-- {
+			if c := newCardCode(codes[i], cardId, ttl); nil != c {
+				backlog = append(backlog, c)
+			}
+		}
+	}
+
+	return sub, backlog, nil
+}
+
+// HasSubscribers .
+func (h *CodeHub) HasSubscribers() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return 0 < len(h.subs)
+}
+
+// Poll 读取新验证码并分发，过期的不推送；订阅者处理不过来时丢弃，客户端可通过 CodeList 补查
+func (h *CodeHub) Poll(ctx context.Context, ttl time.Duration) error {
+	h.mu.Lock()
+	lastId := h.lastId
+	h.mu.Unlock()
+
+	codes, err := h.repo.GetCardCodesAfterId(ctx, lastId, codePollLimit)
+	if nil != err {
+		return err
+	}
+
+	if 0 == len(codes) {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, v := range codes {
+		for sub := range h.subs {
+			cardId, ok := sub.cards[v.Card]
+			if !ok {
+				continue
+			}
+
+			c := newCardCode(v, cardId, ttl)
+			if nil == c {
+				continue
+			}
+
+			select {
+			case sub.C <- c:
+			default:
+				h.log.Warnw("msg", "验证码推送丢弃", "card_id", cardId, "code_id", v.ID)
+			}
+		}
+	}
+	h.lastId = codes[len(codes)-1].ID
+
+	return nil
+}
+
+func newCardCode(v *CardOrder, cardId uint64, ttl time.Duration) *CardCode {
+	ttl = codeTTL(ttl)
+	if nil == v.Time || time.Since(*v.Time) > ttl {
+		return nil
+	}
+
+	return &CardCode{
+		ID:        v.ID,
+		CardId:    cardId,
+		Code:      v.Code,
+		Time:      *v.Time,
+		ExpiresAt: v.Time.Add(ttl),
+	}
+}
+
+func codeTTL(ttl time.Duration) time.Duration {
+	if 0 >= ttl {
+		return defaultCodeTTL
+	}
+
+	return ttl
+}
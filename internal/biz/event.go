@@ -0,0 +1,281 @@
+package biz
+
+import (
+	pb "cardbinance/api/user/v1"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 账户事件类型
+const (
+	AccountEventBalance         = "balance"          // 钱包各币种余额
+	AccountEventCardBalance     = "card_balance"     // 卡片在发卡方的可用余额
+	AccountEventCardTransaction = "card_transaction" // 新的卡片交易
+	AccountEventCardStatus      = "card_status"      // 卡片状态变化
+)
+
+// AccountEvent 通过 Redis 发布，各实例转发给该用户的推送连接
+type AccountEvent struct {
+	Type   string          `json:"type"`
+	UserId uint64          `json:"userId"`
+	Data   json.RawMessage `json:"data"`
+	At     int64           `json:"at"` // 毫秒
+}
+
+type EventRepo interface {
+	PublishAccountEvent(ctx context.Context, e *AccountEvent) error
+	// SubscribeAccountEvents 订阅所有用户的事件，ctx 结束时关闭返回的 channel
+	SubscribeAccountEvents(ctx context.Context) (<-chan *AccountEvent, error)
+	CreateStreamTicket(ctx context.Context, ticket string, st *StreamTicket, ttl time.Duration) error
+	// TakeStreamTicket 读取并删除票据，不存在或已使用时返回 nil
+	TakeStreamTicket(ctx context.Context, ticket string) (*StreamTicket, error)
+}
+
+const streamTicketTTL = 30 * time.Second
+
+// StreamTicket 推送连接票据，代替放在 URL 中的 JWT，连接有效期不超过签发时的 JWT
+type StreamTicket struct {
+	UserId    uint64 `json:"userId"`
+	ExpiresAt int64  `json:"expiresAt"` // JWT 过期时间，秒
+}
+
+type balanceEvent struct {
+	Balances []*balanceItem `json:"balances"`
+}
+
+type balanceItem struct {
+	Currency string `json:"currency"`
+	Amount   string `json:"amount"`
+}
+
+type cardBalanceEvent struct {
+	CardId    uint64 `json:"cardId"`
+	Available string `json:"available"`
+	Currency  string `json:"currency"`
+}
+
+type cardTransactionEvent struct {
+	CardId   uint64 `json:"cardId"`
+	TxnId    string `json:"txnId"`
+	Type     int32  `json:"type"`
+	Status   string `json:"status"`
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+	Merchant string `json:"merchant"`
+	Time     int64  `json:"time"` // 毫秒
+}
+
+type cardStatusEvent struct {
+	CardId uint64 `json:"cardId"`
+	Status string `json:"status"`
+}
+
+// EventSubscription 一个推送连接
+type EventSubscription struct {
+	C      chan *AccountEvent
+	userId uint64
+	hub    *EventHub
+}
+
+// Close 取消订阅
+func (s *EventSubscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	delete(s.hub.subs[s.userId], s)
+	if 0 == len(s.hub.subs[s.userId]) {
+		delete(s.hub.subs, s.userId)
+	}
+}
+
+// EventHub 每个实例订阅一次 Redis，按用户分发给本实例的推送连接
+type EventHub struct {
+	repo EventRepo
+	uuc  *UserUseCase
+	mu   sync.Mutex
+	subs map[uint64]map[*EventSubscription]struct{}
+	log  *log.Helper
+}
+
+func NewEventHub(repo EventRepo, uuc *UserUseCase, logger log.Logger) *EventHub {
+	return &EventHub{
+		repo: repo,
+		uuc:  uuc,
+		subs: make(map[uint64]map[*EventSubscription]struct{}),
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Subscribe 订阅用户事件，返回当前钱包余额作为第一条事件
+func (h *EventHub) Subscribe(ctx context.Context, userId uint64) (*EventSubscription, *AccountEvent, error) {
+	sub := &EventSubscription{
+		C:      make(chan *AccountEvent, 32),
+		userId: userId,
+		hub:    h,
+	}
+
+	h.mu.Lock()
+	if nil == h.subs[userId] {
+		h.subs[userId] = make(map[*EventSubscription]struct{})
+	}
+	h.subs[userId][sub] = struct{}{}
+	h.mu.Unlock()
+
+	snapshot, err := newAccountEvent(userId, AccountEventBalance, h.uuc.balanceEvent(ctx, userId))
+	if nil != err {
+		sub.Close()
+		return nil, nil, err
+	}
+
+	return sub, snapshot, nil
+}
+
+// Run 接收 Redis 中的事件直到 ctx 结束
+func (h *EventHub) Run(ctx context.Context) error {
+	events, err := h.repo.SubscribeAccountEvents(ctx)
+	if nil != err {
+		return err
+	}
+
+	for e := range events {
+		h.dispatch(e)
+	}
+
+	return nil
+}
+
+// dispatch 连接处理不过来时丢弃，余额类事件客户端以最新一条为准
+func (h *EventHub) dispatch(e *AccountEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subs[e.UserId] {
+		select {
+		case sub.C <- e:
+		default:
+			h.log.Warnw("msg", "账户事件推送丢弃", "user_id", e.UserId, "type", e.Type)
+		}
+	}
+}
+
+func newAccountEvent(userId uint64, typ string, data interface{}) (*AccountEvent, error) {
+	b, err := json.Marshal(data)
+	if nil != err {
+		return nil, err
+	}
+
+	return &AccountEvent{
+		Type:   typ,
+		UserId: userId,
+		Data:   b,
+		At:     time.Now().UnixMilli(),
+	}, nil
+}
+
+// publishEvent 发布失败只记录日志，不影响业务结果
+func (uuc *UserUseCase) publishEvent(ctx context.Context, userId uint64, typ string, data interface{}) {
+	e, err := newAccountEvent(userId, typ, data)
+	if nil == err {
+		err = uuc.eventRepo.PublishAccountEvent(ctx, e)
+	}
+
+	if nil != err {
+		uuc.log.Errorw("msg", "账户事件发布失败", "err", err, "user_id", userId, "type", typ)
+	}
+}
+
+func (uuc *UserUseCase) balanceEvent(ctx context.Context, userId uint64) *balanceEvent {
+	res := &balanceEvent{Balances: make([]*balanceItem, 0, len(walletCurrencies))}
+	for _, v := range uuc.balancesReply(ctx, userId) {
+		res.Balances = append(res.Balances, &balanceItem{Currency: v.Currency, Amount: v.Amount})
+	}
+
+	return res
+}
+
+// publishBalance 钱包余额变化后发布最新余额
+func (uuc *UserUseCase) publishBalance(ctx context.Context, userId uint64) {
+	uuc.publishEvent(ctx, userId, AccountEventBalance, uuc.balanceEvent(ctx, userId))
+}
+
+// publishCardBalance 查询发卡方余额后发布
+func (uuc *UserUseCase) publishCardBalance(ctx context.Context, card *Card) {
+	if "" == card.IssuerCardId {
+		return
+	}
+
+	balance, stale, err := uuc.cardBalance(ctx, card)
+	if nil != err {
+		uuc.log.Errorw("msg", "查询卡片余额失败", "err", err, "user_id", card.UserId, "card_id", card.ID)
+		return
+	}
+
+	// 缓存的余额客户端已经有了
+	if stale {
+		return
+	}
+
+	uuc.publishEvent(ctx, card.UserId, AccountEventCardBalance, &cardBalanceEvent{
+		CardId:    card.ID,
+		Available: balance.Available,
+		Currency:  balance.Currency,
+	})
+}
+
+func (uuc *UserUseCase) publishCardStatus(ctx context.Context, card *Card, status string) {
+	uuc.publishEvent(ctx, card.UserId, AccountEventCardStatus, &cardStatusEvent{CardId: card.ID, Status: status})
+}
+
+func (uuc *UserUseCase) publishCardTransaction(ctx context.Context, card *Card, ct *CardTransaction) {
+	uuc.publishEvent(ctx, card.UserId, AccountEventCardTransaction, &cardTransactionEvent{
+		CardId:   card.ID,
+		TxnId:    ct.TxnId,
+		Type:     ct.Type,
+		Status:   ct.Status,
+		Amount:   fmt.Sprintf("%.2f", ct.Amount),
+		Currency: ct.Currency,
+		Merchant: ct.MerchantName,
+		Time:     ct.TransactionTime.UnixMilli(),
+	})
+}
+
+// StreamTicket 签发推送连接票据
+func (uuc *UserUseCase) StreamTicket(ctx context.Context, req *pb.StreamTicketRequest, userId uint64, expiresAt time.Time) (*pb.StreamTicketReply, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); nil != err {
+		return &pb.StreamTicketReply{Status: "生成错误"}, nil
+	}
+
+	ticket := hex.EncodeToString(b)
+	if err := uuc.eventRepo.CreateStreamTicket(ctx, ticket, &StreamTicket{UserId: userId, ExpiresAt: expiresAt.Unix()}, streamTicketTTL); nil != err {
+		uuc.log.Errorw("msg", "推送票据保存失败", "err", err, "user_id", userId)
+		return &pb.StreamTicketReply{Status: "生成错误"}, nil
+	}
+
+	return &pb.StreamTicketReply{Status: "ok", Ticket: ticket, ExpiresIn: uint64(streamTicketTTL.Seconds())}, nil
+}
+
+// TakeStreamTicket 使用票据，只能使用一次
+func (uuc *UserUseCase) TakeStreamTicket(ctx context.Context, ticket string) (*StreamTicket, error) {
+	if "" == ticket {
+		return nil, nil
+	}
+
+	st, err := uuc.eventRepo.TakeStreamTicket(ctx, ticket)
+	if nil != err {
+		return nil, err
+	}
+
+	if nil == st || 0 >= st.UserId || time.Now().Unix() >= st.ExpiresAt {
+		return nil, nil
+	}
+
+	return st, nil
+}
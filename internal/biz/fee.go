@@ -0,0 +1,197 @@
+package biz
+
+import (
+	pb "cardbinance/api/user/v1"
+	"context"
+	"fmt"
+	"strconv"
+)
+
+const (
+	FeeOpWithdraw     = "withdraw"       // 提现
+	FeeOpAmountTo     = "amount_to"      // 转账
+	FeeOpAmountToCard = "amount_to_card" // 划转到卡
+	FeeOpOpenCard     = "open_card"      // 开虚拟卡
+	FeeOpOpenCardTwo  = "open_card_two"  // 开实体卡
+	FeeOpExchange     = "exchange"       // 币种兑换
+)
+
+// FeeSchedule 手续费规则：fixed + amount*rate，再按 min/max 截断；max 为0不封顶
+// ProductId 为 "0" 匹配全部产品，Vip 为适用的最低级别
+type FeeSchedule struct {
+	ID        uint64
+	Operation string
+	ProductId string
+	Vip       uint64
+	Fixed     float64
+	Rate      float64
+	Min       float64
+	Max       float64
+	MinAmount float64 // 单笔最低金额，按到账币种计：兑换为目标币种，划转到卡为 USD
+}
+
+type FeeRepo interface {
+	GetFeeSchedulesByOperation(ctx context.Context, operation string) ([]*FeeSchedule, error)
+}
+
+// 未配置 fee_schedule 时的默认规则，与原先写死的金额一致
+var defaultFeeSchedules = map[string]*FeeSchedule{
+	FeeOpWithdraw:     {Operation: FeeOpWithdraw},
+	FeeOpAmountTo:     {Operation: FeeOpAmountTo},
+	FeeOpAmountToCard: {Operation: FeeOpAmountToCard, MinAmount: 20},
+	FeeOpOpenCard:     {Operation: FeeOpOpenCard, Fixed: 15},
+	FeeOpOpenCardTwo:  {Operation: FeeOpOpenCardTwo, Fixed: 150},
+	FeeOpExchange:     {Operation: FeeOpExchange},
+}
+
+// legacyFeeKey 旧的 config 配置项，Fixed 为 true 时配置的是固定金额，否则是比例
+type legacyFeeKey struct {
+	Key   string
+	Fixed bool
+}
+
+// 旧的手续费配置，仅在 fee_schedule 没有对应规则时作为默认值
+var legacyFeeKeys = map[string]legacyFeeKey{
+	FeeOpWithdraw:     {Key: "withdraw_rate"},
+	FeeOpAmountToCard: {Key: "amount_to_rate"},
+	FeeOpExchange:     {Key: "exchange_rate"},
+	FeeOpOpenCardTwo:  {Key: "card_two", Fixed: true},
+}
+
+// Calc 计算手续费
+func (f *FeeSchedule) Calc(amount float64) float64 {
+	fee := f.Fixed + amount*f.Rate
+	if 0 < f.Min && fee < f.Min {
+		fee = f.Min
+	}
+	if 0 < f.Max && fee > f.Max {
+		fee = f.Max
+	}
+
+	return fee
+}
+
+// getFeeSchedule 选取最匹配的规则：指定产品优先于通用规则，其次取不超过用户级别的最高级别
+func (uuc *UserUseCase) getFeeSchedule(ctx context.Context, operation, productId string, vip uint64) *FeeSchedule {
+	var res *FeeSchedule
+
+	schedules, err := uuc.feeRepo.GetFeeSchedulesByOperation(ctx, operation)
+	if nil == err {
+		for _, v := range schedules {
+			if v.Vip > vip {
+				continue
+			}
+
+			if "0" != v.ProductId && "" != v.ProductId && productId != v.ProductId {
+				continue
+			}
+
+			if nil == res {
+				res = v
+				continue
+			}
+
+			vSpecific := "0" != v.ProductId && "" != v.ProductId
+			resSpecific := "0" != res.ProductId && "" != res.ProductId
+			if vSpecific && !resSpecific {
+				res = v
+			} else if vSpecific == resSpecific && v.Vip > res.Vip {
+				res = v
+			}
+		}
+	}
+
+	if nil != res {
+		return res
+	}
+
+	tmp := FeeSchedule{Operation: operation}
+	if d, ok := defaultFeeSchedules[operation]; ok {
+		tmp = *d
+	}
+
+	if legacy, ok := legacyFeeKeys[operation]; ok {
+		configs, _ := uuc.repo.GetConfigByKeys(legacy.Key)
+		if nil != configs {
+			for _, vConfig := range configs {
+				if legacy.Key != vConfig.KeyName {
+					continue
+				}
+
+				value, errParse := strconv.ParseFloat(vConfig.Value, 10)
+				if nil != errParse {
+					continue
+				}
+
+				if legacy.Fixed {
+					tmp.Fixed = value
+				} else {
+					tmp.Rate = value
+				}
+			}
+		}
+	}
+
+	return &tmp
+}
+
+// FeeQuote 操作前查询手续费
+func (uuc *UserUseCase) FeeQuote(ctx context.Context, req *pb.FeeQuoteRequest, userId uint64) (*pb.FeeQuoteReply, error) {
+	var (
+		user      *User
+		err       error
+		productId = "0"
+	)
+
+	user, err = uuc.repo.GetUserById(userId)
+	if nil == user || nil != err {
+		return &pb.FeeQuoteReply{Status: "用户不存在"}, nil
+	}
+
+	if _, ok := defaultFeeSchedules[req.Operation]; !ok {
+		return &pb.FeeQuoteReply{Status: "操作类型错误"}, nil
+	}
+
+	if FeeOpOpenCard == req.Operation {
+		productId = user.ProductId
+	}
+
+	if 0 < req.CardId {
+		var card *Card
+		card, err = uuc.getUserCard(ctx, userId, req.CardId, 0)
+		if nil == card || nil != err {
+			return &pb.FeeQuoteReply{Status: "卡片不存在"}, nil
+		}
+
+		productId = card.ProductId
+	}
+
+	amount := float64(req.Amount)
+	if FeeOpExchange == req.Operation {
+		from, okFrom := parseCurrency(req.Currency)
+		to, okTo := parseCurrency(req.ToCurrency)
+		if !okFrom || !okTo || from == to {
+			return &pb.FeeQuoteReply{Status: "币种错误"}, nil
+		}
+
+		// 兑换手续费按到账币种计算
+		amount = convertCurrency(uuc.getCurrencyRates(), from, to, amount)
+	}
+
+	schedule := uuc.getFeeSchedule(ctx, req.Operation, productId, user.Vip)
+	fee := schedule.Calc(amount)
+
+	// 开卡费直接从余额扣除，其余操作从金额中扣除
+	receive := amount - fee
+	if FeeOpOpenCard == req.Operation || FeeOpOpenCardTwo == req.Operation {
+		receive = 0
+	}
+
+	// 兑换的最低金额与手续费一样按到账币种计算
+	return &pb.FeeQuoteReply{
+		Status:    "ok",
+		Fee:       fmt.Sprintf("%.2f", fee),
+		Receive:   fmt.Sprintf("%.2f", receive),
+		MinAmount: fmt.Sprintf("%.2f", schedule.MinAmount),
+	}, nil
+}
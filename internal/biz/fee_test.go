@@ -0,0 +1,116 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"testing"
+)
+
+type stubFeeRepo struct {
+	schedules []*FeeSchedule
+	err       error
+}
+
+func (s *stubFeeRepo) GetFeeSchedulesByOperation(ctx context.Context, operation string) ([]*FeeSchedule, error) {
+	res := make([]*FeeSchedule, 0)
+	for _, v := range s.schedules {
+		if operation == v.Operation {
+			res = append(res, v)
+		}
+	}
+
+	return res, s.err
+}
+
+// stubConfigRepo 只实现 GetConfigByKeys，其余方法调用时 panic
+type stubConfigRepo struct {
+	UserRepo
+	configs map[string]string
+}
+
+func (s *stubConfigRepo) GetConfigByKeys(keys ...string) ([]*Config, error) {
+	res := make([]*Config, 0)
+	for _, k := range keys {
+		if v, ok := s.configs[k]; ok {
+			res = append(res, &Config{KeyName: k, Value: v})
+		}
+	}
+
+	return res, nil
+}
+
+func TestFeeScheduleCalc(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule FeeSchedule
+		amount   float64
+		want     float64
+	}{
+		{name: "zero", schedule: FeeSchedule{}, amount: 100, want: 0},
+		{name: "fixed", schedule: FeeSchedule{Fixed: 15}, amount: 100, want: 15},
+		{name: "rate", schedule: FeeSchedule{Rate: 0.01}, amount: 200, want: 2},
+		{name: "fixed and rate", schedule: FeeSchedule{Fixed: 1, Rate: 0.01}, amount: 200, want: 3},
+		{name: "min", schedule: FeeSchedule{Rate: 0.01, Min: 5}, amount: 100, want: 5},
+		{name: "max", schedule: FeeSchedule{Rate: 0.01, Max: 5}, amount: 1000, want: 5},
+		{name: "max zero uncapped", schedule: FeeSchedule{Rate: 0.01}, amount: 100000, want: 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.schedule.Calc(tt.amount); got != tt.want {
+				t.Errorf("Calc(%v) = %v, want %v", tt.amount, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetFeeSchedule(t *testing.T) {
+	schedules := []*FeeSchedule{
+		{ID: 1, Operation: FeeOpOpenCard, ProductId: "0", Vip: 0, Fixed: 15},
+		{ID: 2, Operation: FeeOpOpenCard, ProductId: "0", Vip: 2, Fixed: 10},
+		{ID: 3, Operation: FeeOpOpenCard, ProductId: "p1", Vip: 0, Fixed: 12},
+		{ID: 4, Operation: FeeOpOpenCard, ProductId: "p1", Vip: 3, Fixed: 8},
+	}
+
+	tests := []struct {
+		name      string
+		schedules []*FeeSchedule
+		repoErr   error
+		configs   map[string]string
+		operation string
+		productId string
+		vip       uint64
+		wantId    uint64
+		wantFixed float64
+		wantRate  float64
+		wantMin   float64
+	}{
+		{name: "generic base", schedules: schedules, operation: FeeOpOpenCard, productId: "p2", vip: 0, wantId: 1, wantFixed: 15},
+		{name: "generic highest vip not above user", schedules: schedules, operation: FeeOpOpenCard, productId: "p2", vip: 5, wantId: 2, wantFixed: 10},
+		{name: "product beats generic vip", schedules: schedules, operation: FeeOpOpenCard, productId: "p1", vip: 2, wantId: 3, wantFixed: 12},
+		{name: "product highest vip", schedules: schedules, operation: FeeOpOpenCard, productId: "p1", vip: 3, wantId: 4, wantFixed: 8},
+		{name: "default", operation: FeeOpOpenCard, wantFixed: 15},
+		{name: "default on repo error", schedules: schedules, repoErr: errors.New("db"), operation: FeeOpOpenCard, wantFixed: 15},
+		{name: "default min amount", operation: FeeOpAmountToCard, wantMin: 20},
+		{name: "legacy rate", configs: map[string]string{"withdraw_rate": "0.02"}, operation: FeeOpWithdraw, wantRate: 0.02},
+		{name: "legacy card_two is fixed", configs: map[string]string{"card_two": "199"}, operation: FeeOpOpenCardTwo, wantFixed: 199},
+		{name: "legacy card_two invalid keeps default", configs: map[string]string{"card_two": "abc"}, operation: FeeOpOpenCardTwo, wantFixed: 150},
+		{name: "schedule beats legacy", schedules: []*FeeSchedule{{ID: 9, Operation: FeeOpOpenCardTwo, ProductId: "0", Fixed: 120}}, configs: map[string]string{"card_two": "199"}, operation: FeeOpOpenCardTwo, wantId: 9, wantFixed: 120},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uuc := &UserUseCase{
+				repo:    &stubConfigRepo{configs: tt.configs},
+				feeRepo: &stubFeeRepo{schedules: tt.schedules, err: tt.repoErr},
+				log:     log.NewHelper(log.DefaultLogger),
+			}
+
+			got := uuc.getFeeSchedule(context.Background(), tt.operation, tt.productId, tt.vip)
+			if got.ID != tt.wantId || got.Fixed != tt.wantFixed || got.Rate != tt.wantRate || got.MinAmount != tt.wantMin {
+				t.Errorf("getFeeSchedule() = %+v, want id=%d fixed=%v rate=%v min=%v", got, tt.wantId, tt.wantFixed, tt.wantRate, tt.wantMin)
+			}
+		})
+	}
+}
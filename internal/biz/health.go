@@ -0,0 +1,130 @@
+package biz
+
+import (
+	"cardbinance/internal/pkg/interlace"
+	"cardbinance/internal/pkg/redact"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	HealthStatusUp       = "up"
+	HealthStatusDown     = "down"
+	HealthStatusDegraded = "degraded"
+)
+
+const healthCheckTimeout = 2 * time.Second
+
+type HealthRepo interface {
+	PingDB(ctx context.Context) error
+	PingRedis(ctx context.Context) error
+}
+
+// HealthCheck 单个依赖的检查结果
+type HealthCheck struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Critical bool   `json:"critical"` // 失败时是否摘除流量
+	Latency  int64  `json:"latencyMs"`
+	Error    string `json:"error,omitempty"`
+	Detail   string `json:"detail,omitempty"` // 例如发卡方熔断状态
+}
+
+type healthChecker struct {
+	name     string
+	critical bool
+	check    func(ctx context.Context) error
+	detail   func() string
+}
+
+type HealthUseCase struct {
+	repo     HealthRepo
+	checkers []healthChecker
+	stopping atomic.Bool
+	log      *log.Helper
+}
+
+func NewHealthUseCase(repo HealthRepo, ic *interlace.Client, logger log.Logger) *HealthUseCase {
+	h := &HealthUseCase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+
+	h.checkers = []healthChecker{
+		{name: "mysql", critical: true, check: repo.PingDB},
+		{name: "redis", critical: true, check: repo.PingRedis},
+		// 发卡方不可用时只影响开卡划转等接口，不摘除整个实例
+		{name: "interlace", critical: false, check: ic.Check, detail: func() string {
+			return "breaker=" + string(ic.BreakerState())
+		}},
+	}
+
+	return h
+}
+
+// Shutdown 进程退出前标记为未就绪，负载均衡摘除流量
+func (h *HealthUseCase) Shutdown() {
+	h.stopping.Store(true)
+}
+
+// Ready 并发检查全部依赖，关键依赖失败或正在退出时未就绪
+func (h *HealthUseCase) Ready(ctx context.Context) (string, bool, []*HealthCheck) {
+	checks := make([]*HealthCheck, len(h.checkers))
+
+	var wg sync.WaitGroup
+	for k, v := range h.checkers {
+		wg.Add(1)
+		go func(k int, v healthChecker) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+			defer cancel()
+
+			start := time.Now()
+			err := v.check(ctx)
+			checks[k] = &HealthCheck{
+				Name:     v.name,
+				Status:   HealthStatusUp,
+				Critical: v.critical,
+				Latency:  time.Since(start).Milliseconds(),
+			}
+			if nil != err {
+				checks[k].Status = HealthStatusDown
+				checks[k].Error = redact.String(err.Error())
+			}
+			if nil != v.detail {
+				checks[k].Detail = v.detail()
+			}
+		}(k, v)
+	}
+	wg.Wait()
+
+	status := HealthStatusUp
+	ready := true
+	for _, v := range checks {
+		if HealthStatusUp == v.Status {
+			continue
+		}
+
+		if v.Critical {
+			status = HealthStatusDown
+			ready = false
+		} else if HealthStatusUp == status {
+			status = HealthStatusDegraded
+		}
+	}
+
+	if h.stopping.Load() {
+		status = HealthStatusDown
+		ready = false
+	}
+
+	if !ready {
+		h.log.Warnw("msg", "readiness check failed", "stopping", h.stopping.Load())
+	}
+
+	return status, ready, checks
+}
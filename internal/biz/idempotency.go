@@ -0,0 +1,161 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"time"
+)
+
+const (
+	IdempotencyStatusProcessing = "processing"
+	IdempotencyStatusDone       = "done"
+)
+
+type IdempotencyKey struct {
+	ID          uint64
+	UserId      uint64
+	IdemKey     string
+	Operation   string
+	Fingerprint string
+	Status      string
+	Reply       string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type IdempotencyRepo interface {
+	// CreateIdempotencyKey 键已存在时返回已有记录和false
+	CreateIdempotencyKey(ctx context.Context, ik *IdempotencyKey) (*IdempotencyKey, bool, error)
+	// GetIdempotencyKey 不存在时返回 nil
+	GetIdempotencyKey(ctx context.Context, userId uint64, key string) (*IdempotencyKey, error)
+	FinishIdempotencyKey(ctx context.Context, id uint64, reply string) error
+	DeleteIdempotencyKey(ctx context.Context, id uint64) error
+	// DeleteStaleIdempotencyKeys 删除 before 之前仍在处理中的键，进程中断后客户端可以重试
+	DeleteStaleIdempotencyKeys(ctx context.Context, before time.Time) (int64, error)
+}
+
+// requestFingerprint 请求内容摘要，签名和两步验证码每次不同，不参与计算
+func requestFingerprint(req proto.Message) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(clearSendBody(req, "sign", "totpCode"))
+	if nil != err {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Idempotent 按 Idempotency-Key 执行一次 fn 并保存结果，相同键重放时返回第一次的结果，
+// 相同键但请求内容不同时拒绝。key 为空时直接执行
+func Idempotent[T proto.Message](ctx context.Context, uuc *UserUseCase, userId uint64, key, operation string, req proto.Message, fn func() (T, error), failed func(status string) T) (T, error) {
+	if "" == key {
+		return fn()
+	}
+
+	if 100 < len(key) {
+		return failed("Idempotency-Key过长"), nil
+	}
+
+	fingerprint, err := requestFingerprint(req)
+	if nil != err {
+		return failed("请求参数错误"), nil
+	}
+
+	ik, created, err := uuc.idempotencyRepo.CreateIdempotencyKey(ctx, &IdempotencyKey{
+		UserId:      userId,
+		IdemKey:     key,
+		Operation:   operation,
+		Fingerprint: fingerprint,
+		Status:      IdempotencyStatusProcessing,
+	})
+	if nil != err {
+		return failed("错误"), nil
+	}
+
+	if !created {
+		return replayIdempotencyKey(ik, operation, fingerprint, failed), nil
+	}
+
+	reply, err := fn()
+	if nil != err {
+		// 未得到最终结果，允许用同一个键重试
+		_ = uuc.idempotencyRepo.DeleteIdempotencyKey(ctx, ik.ID)
+		return reply, err
+	}
+
+	b, err := protojson.Marshal(reply)
+	if nil == err {
+		err = uuc.idempotencyRepo.FinishIdempotencyKey(ctx, ik.ID, string(b))
+	}
+	if nil != err {
+		uuc.log.Errorw("msg", "幂等结果保存失败", "err", err, "user_id", userId, "idempotency_key", key)
+	}
+
+	return reply, nil
+}
+
+// IdempotentReplay 在消耗签名 nonce 和两步验证码之前查询，相同键已有记录时返回第一次的结果
+// 或拒绝原因，ok 为 false 时调用方继续校验并执行 Idempotent
+func IdempotentReplay[T proto.Message](ctx context.Context, uuc *UserUseCase, userId uint64, key, operation string, req proto.Message, failed func(status string) T) (T, bool) {
+	var zero T
+	if "" == key || 100 < len(key) {
+		return zero, false
+	}
+
+	ik, err := uuc.idempotencyRepo.GetIdempotencyKey(ctx, userId, key)
+	if nil != err {
+		return failed("错误"), true
+	}
+
+	if nil == ik {
+		return zero, false
+	}
+
+	fingerprint, err := requestFingerprint(req)
+	if nil != err {
+		return failed("请求参数错误"), true
+	}
+
+	return replayIdempotencyKey(ik, operation, fingerprint, failed), true
+}
+
+// IdempotentDone 相同键、相同请求已有保存的结果，重放时会原样返回
+func (uuc *UserUseCase) IdempotentDone(ctx context.Context, userId uint64, key, operation string, req proto.Message) bool {
+	if "" == key || 100 < len(key) {
+		return false
+	}
+
+	ik, err := uuc.idempotencyRepo.GetIdempotencyKey(ctx, userId, key)
+	if nil != err || nil == ik || IdempotencyStatusDone != ik.Status || operation != ik.Operation {
+		return false
+	}
+
+	fingerprint, err := requestFingerprint(req)
+	if nil != err {
+		return false
+	}
+
+	return fingerprint == ik.Fingerprint
+}
+
+// replayIdempotencyKey 已有记录时的结果：内容不同拒绝，处理中提示稍后，完成则返回保存的结果
+func replayIdempotencyKey[T proto.Message](ik *IdempotencyKey, operation, fingerprint string, failed func(status string) T) T {
+	if operation != ik.Operation || fingerprint != ik.Fingerprint {
+		return failed("Idempotency-Key已用于其他请求")
+	}
+
+	if IdempotencyStatusDone != ik.Status {
+		return failed("请求处理中")
+	}
+
+	var zero T
+	reply := zero.ProtoReflect().Type().New().Interface().(T)
+	if err := protojson.Unmarshal([]byte(ik.Reply), reply); nil != err {
+		return failed("错误")
+	}
+
+	return reply
+}
@@ -0,0 +1,216 @@
+package biz
+
+import (
+	pb "cardbinance/api/user/v1"
+	"context"
+	"errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"testing"
+	"time"
+)
+
+type stubIdempotencyRepo struct {
+	keys   map[string]*IdempotencyKey
+	nextId uint64
+	getErr error
+}
+
+func newStubIdempotencyRepo() *stubIdempotencyRepo {
+	return &stubIdempotencyRepo{keys: make(map[string]*IdempotencyKey, 0)}
+}
+
+func (s *stubIdempotencyRepo) CreateIdempotencyKey(ctx context.Context, ik *IdempotencyKey) (*IdempotencyKey, bool, error) {
+	if exist, ok := s.keys[ik.IdemKey]; ok {
+		return exist, false, nil
+	}
+
+	s.nextId++
+	tmp := *ik
+	tmp.ID = s.nextId
+	s.keys[ik.IdemKey] = &tmp
+	return &tmp, true, nil
+}
+
+func (s *stubIdempotencyRepo) GetIdempotencyKey(ctx context.Context, userId uint64, key string) (*IdempotencyKey, error) {
+	if nil != s.getErr {
+		return nil, s.getErr
+	}
+
+	return s.keys[key], nil
+}
+
+func (s *stubIdempotencyRepo) FinishIdempotencyKey(ctx context.Context, id uint64, reply string) error {
+	for _, v := range s.keys {
+		if id == v.ID {
+			v.Status = IdempotencyStatusDone
+			v.Reply = reply
+		}
+	}
+
+	return nil
+}
+
+func (s *stubIdempotencyRepo) DeleteIdempotencyKey(ctx context.Context, id uint64) error {
+	for k, v := range s.keys {
+		if id == v.ID {
+			delete(s.keys, k)
+		}
+	}
+
+	return nil
+}
+
+func (s *stubIdempotencyRepo) DeleteStaleIdempotencyKeys(ctx context.Context, before time.Time) (int64, error) {
+	return 0, nil
+}
+
+func withdrawRequest(amount uint64, sign, totpCode string) *pb.WithdrawRequest {
+	return &pb.WithdrawRequest{SendBody: &pb.WithdrawRequest_SendBody{Amount: amount, Sign: sign, TotpCode: totpCode}}
+}
+
+func withdrawFailed(status string) *pb.WithdrawReply {
+	return &pb.WithdrawReply{Status: status}
+}
+
+func TestIdempotent(t *testing.T) {
+	done := func(req *pb.WithdrawRequest, status string) *IdempotencyKey {
+		fingerprint, _ := requestFingerprint(req)
+		b, _ := protojson.Marshal(&pb.WithdrawReply{Status: status})
+		return &IdempotencyKey{ID: 100, IdemKey: "k1", Operation: "Withdraw", Fingerprint: fingerprint, Status: IdempotencyStatusDone, Reply: string(b)}
+	}
+	processing := func(req *pb.WithdrawRequest) *IdempotencyKey {
+		ik := done(req, "")
+		ik.Status = IdempotencyStatusProcessing
+		ik.Reply = ""
+		return ik
+	}
+
+	tests := []struct {
+		name       string
+		key        string
+		operation  string
+		req        *pb.WithdrawRequest
+		exist      *IdempotencyKey
+		fnErr      error
+		wantStatus string
+		wantErr    bool
+		wantCalls  int
+		wantStored bool
+	}{
+		{name: "empty key runs fn", key: "", operation: "Withdraw", req: withdrawRequest(10, "s1", ""), wantStatus: "ok", wantCalls: 1},
+		{name: "key too long", key: string(make([]byte, 101)), operation: "Withdraw", req: withdrawRequest(10, "s1", ""), wantStatus: "Idempotency-Key过长"},
+		{name: "new key runs fn and stores reply", key: "k1", operation: "Withdraw", req: withdrawRequest(10, "s1", ""), wantStatus: "ok", wantCalls: 1, wantStored: true},
+		{name: "done key replays stored reply", key: "k1", operation: "Withdraw", req: withdrawRequest(10, "s1", ""), exist: done(withdrawRequest(10, "s0", ""), "first"), wantStatus: "first", wantStored: true},
+		{name: "sign and totp ignored in fingerprint", key: "k1", operation: "Withdraw", req: withdrawRequest(10, "s2", "123456"), exist: done(withdrawRequest(10, "s1", "654321"), "first"), wantStatus: "first", wantStored: true},
+		{name: "different body rejected", key: "k1", operation: "Withdraw", req: withdrawRequest(20, "s1", ""), exist: done(withdrawRequest(10, "s1", ""), "first"), wantStatus: "Idempotency-Key已用于其他请求", wantStored: true},
+		{name: "different operation rejected", key: "k1", operation: "AmountTo", req: withdrawRequest(10, "s1", ""), exist: done(withdrawRequest(10, "s1", ""), "first"), wantStatus: "Idempotency-Key已用于其他请求", wantStored: true},
+		{name: "processing key rejected", key: "k1", operation: "Withdraw", req: withdrawRequest(10, "s1", ""), exist: processing(withdrawRequest(10, "s1", "")), wantStatus: "请求处理中", wantStored: true},
+		{name: "fn error releases key", key: "k1", operation: "Withdraw", req: withdrawRequest(10, "s1", ""), fnErr: errors.New("db"), wantErr: true, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubIdempotencyRepo()
+			if nil != tt.exist {
+				repo.keys[tt.exist.IdemKey] = tt.exist
+			}
+			uuc := &UserUseCase{idempotencyRepo: repo, log: log.NewHelper(log.DefaultLogger)}
+
+			calls := 0
+			reply, err := Idempotent(context.Background(), uuc, 1, tt.key, tt.operation, tt.req, func() (*pb.WithdrawReply, error) {
+				calls++
+				return &pb.WithdrawReply{Status: "ok"}, tt.fnErr
+			}, withdrawFailed)
+
+			if tt.wantErr != (nil != err) {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && tt.wantStatus != reply.Status {
+				t.Errorf("status = %q, want %q", reply.Status, tt.wantStatus)
+			}
+			if tt.wantCalls != calls {
+				t.Errorf("fn calls = %d, want %d", calls, tt.wantCalls)
+			}
+			if _, ok := repo.keys[tt.key]; tt.wantStored != ok {
+				t.Errorf("key stored = %v, want %v", ok, tt.wantStored)
+			}
+		})
+	}
+}
+
+func TestIdempotentReplay(t *testing.T) {
+	fingerprint, _ := requestFingerprint(withdrawRequest(10, "s1", ""))
+	b, _ := protojson.Marshal(&pb.WithdrawReply{Status: "first"})
+
+	tests := []struct {
+		name       string
+		key        string
+		req        *pb.WithdrawRequest
+		exist      *IdempotencyKey
+		getErr     error
+		wantOk     bool
+		wantStatus string
+	}{
+		{name: "empty key continues", key: "", req: withdrawRequest(10, "s1", "")},
+		{name: "unknown key continues", key: "k1", req: withdrawRequest(10, "s1", "")},
+		{name: "done key replays before nonce", key: "k1", req: withdrawRequest(10, "s2", ""), exist: &IdempotencyKey{IdemKey: "k1", Operation: "Withdraw", Fingerprint: fingerprint, Status: IdempotencyStatusDone, Reply: string(b)}, wantOk: true, wantStatus: "first"},
+		{name: "processing key stops", key: "k1", req: withdrawRequest(10, "s1", ""), exist: &IdempotencyKey{IdemKey: "k1", Operation: "Withdraw", Fingerprint: fingerprint, Status: IdempotencyStatusProcessing}, wantOk: true, wantStatus: "请求处理中"},
+		{name: "different body stops", key: "k1", req: withdrawRequest(30, "s1", ""), exist: &IdempotencyKey{IdemKey: "k1", Operation: "Withdraw", Fingerprint: fingerprint, Status: IdempotencyStatusDone, Reply: string(b)}, wantOk: true, wantStatus: "Idempotency-Key已用于其他请求"},
+		{name: "repo error stops", key: "k1", req: withdrawRequest(10, "s1", ""), getErr: errors.New("db"), wantOk: true, wantStatus: "错误"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubIdempotencyRepo()
+			repo.getErr = tt.getErr
+			if nil != tt.exist {
+				repo.keys[tt.exist.IdemKey] = tt.exist
+			}
+			uuc := &UserUseCase{idempotencyRepo: repo, log: log.NewHelper(log.DefaultLogger)}
+
+			reply, ok := IdempotentReplay(context.Background(), uuc, 1, tt.key, "Withdraw", tt.req, withdrawFailed)
+			if tt.wantOk != ok {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOk)
+			}
+			if ok && tt.wantStatus != reply.Status {
+				t.Errorf("status = %q, want %q", reply.Status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestIdempotentDone(t *testing.T) {
+	req := withdrawRequest(10, "s1", "")
+	fingerprint, _ := requestFingerprint(req)
+
+	tests := []struct {
+		name      string
+		key       string
+		operation string
+		req       *pb.WithdrawRequest
+		exist     *IdempotencyKey
+		want      bool
+	}{
+		{name: "empty key", key: "", operation: "Withdraw", req: req},
+		{name: "unknown key", key: "k1", operation: "Withdraw", req: req},
+		{name: "done key", key: "k1", operation: "Withdraw", req: withdrawRequest(10, "s2", "123456"), exist: &IdempotencyKey{IdemKey: "k1", Operation: "Withdraw", Fingerprint: fingerprint, Status: IdempotencyStatusDone}, want: true},
+		{name: "processing key", key: "k1", operation: "Withdraw", req: req, exist: &IdempotencyKey{IdemKey: "k1", Operation: "Withdraw", Fingerprint: fingerprint, Status: IdempotencyStatusProcessing}},
+		{name: "different body", key: "k1", operation: "Withdraw", req: withdrawRequest(20, "s1", ""), exist: &IdempotencyKey{IdemKey: "k1", Operation: "Withdraw", Fingerprint: fingerprint, Status: IdempotencyStatusDone}},
+		{name: "different operation", key: "k1", operation: "AmountTo", req: req, exist: &IdempotencyKey{IdemKey: "k1", Operation: "Withdraw", Fingerprint: fingerprint, Status: IdempotencyStatusDone}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubIdempotencyRepo()
+			if nil != tt.exist {
+				repo.keys[tt.exist.IdemKey] = tt.exist
+			}
+			uuc := &UserUseCase{idempotencyRepo: repo, log: log.NewHelper(log.DefaultLogger)}
+
+			if got := uuc.IdempotentDone(context.Background(), 1, tt.key, tt.operation, tt.req); tt.want != got {
+				t.Errorf("IdempotentDone() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,450 @@
+package biz
+
+import (
+	"cardbinance/internal/pkg/interlace"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	idempotencyStaleAfter = 10 * time.Minute // 处理中的幂等键超过这个时间视为进程中断
+	txnSyncLookback       = 7 * 24 * time.Hour
+	txnSyncOverlap        = time.Hour // 发卡方交易状态会延迟变化，每次从最后一条往前重叠同步
+	txnSyncPageSize       = 100
+	reencryptBatchSize    = 200
+)
+
+// ProcessOpenCardUsers 旧流程提交开卡（card_order_id=do）的用户迁移到 card 表，并激活外部发卡流程已回写卡号的卡片
+func (uuc *UserUseCase) ProcessOpenCardUsers(ctx context.Context) error {
+	users, err := uuc.repo.GetUsersOpenCard()
+	if nil != err {
+		return err
+	}
+
+	failed := 0
+	for _, user := range users {
+		if _, err = uuc.getUserCards(ctx, user.ID); nil != err {
+			uuc.log.Errorw("msg", "开卡用户迁移失败", "err", err, "user_id", user.ID)
+			failed++
+		}
+	}
+
+	issued, pending, err := uuc.IssuePendingCards(ctx)
+	if nil != err {
+		return err
+	}
+	uuc.log.Infow("msg", "待发卡", "users", len(users), "pending_cards", pending, "issued", issued)
+
+	if 0 < failed {
+		return fmt.Errorf("%d users failed to migrate", failed)
+	}
+
+	return nil
+}
+
+// legacyIssueState 外部发卡流程在 user 表上的状态：ordered 为已提交待发卡，issuerCardId 为已回写的卡号
+func legacyIssueState(user *User, cardType uint64) (ordered bool, issuerCardId, maskedPan string) {
+	if CardTypePhysical == cardType {
+		if 2 == user.CardTwo && 10 <= len(user.CardTwoNumber) {
+			issuerCardId = user.CardTwoNumber
+		}
+		if 5 < len(user.CardNumberRelTwo) {
+			maskedPan = MaskCard8_6_4(user.CardNumberRelTwo)
+		}
+
+		return 1 == user.CardTwo, issuerCardId, maskedPan
+	}
+
+	if "success" == user.CardOrderId && 10 <= len(user.CardNumber) {
+		issuerCardId = user.CardNumber
+	}
+	if 5 < len(user.CardNumberRel) {
+		maskedPan = MaskCard8_6_4(user.CardNumberRel)
+	}
+
+	return "do" == user.CardOrderId, issuerCardId, maskedPan
+}
+
+// IssuePendingCards 待发卡的卡片：外部发卡流程已回写卡号的激活，尚未提交的（审核通过、上一张刚发完）重新提交
+func (uuc *UserUseCase) IssuePendingCards(ctx context.Context) (int, int, error) {
+	cards, err := uuc.cardRepo.GetCardsByStatus(ctx, CardStatusPending)
+	if nil != err {
+		return 0, 0, err
+	}
+
+	issued := 0
+	for _, card := range cards {
+		if err = ctx.Err(); nil != err {
+			return issued, len(cards), err
+		}
+
+		user, errTwo := uuc.repo.GetUserById(card.UserId)
+		if nil != errTwo || nil == user {
+			uuc.log.Errorw("msg", "发卡查询用户错误", "err", errTwo, "user_id", card.UserId, "card_id", card.ID)
+			continue
+		}
+
+		ordered, issuerCardId, maskedPan := legacyIssueState(user, card.CardType)
+		if ordered {
+			continue
+		}
+
+		// 回写的卡号已属于之前的卡片，说明这张还没有提交
+		if "" != issuerCardId {
+			existing, errThree := uuc.cardRepo.GetCardByIssuerCardId(ctx, issuerCardId)
+			if nil != errThree {
+				uuc.log.Errorw("msg", "发卡查询卡号错误", "err", errThree, "card_id", card.ID)
+				continue
+			}
+
+			if nil != existing {
+				issuerCardId = ""
+			}
+		}
+
+		if "" == issuerCardId {
+			if _, errThree := uuc.cardRepo.QueueLegacyIssue(ctx, card.UserId, card.CardType); nil != errThree {
+				uuc.log.Errorw("msg", "提交发卡错误", "err", errThree, "user_id", card.UserId, "card_id", card.ID)
+			}
+			continue
+		}
+
+		if errThree := uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			if err := uuc.cardRepo.IssueCard(ctx, card.ID, issuerCardId); nil != err {
+				return err
+			}
+
+			if "" != maskedPan {
+				return uuc.cardRepo.UpdateCardMaskedPan(ctx, card.ID, maskedPan)
+			}
+
+			return nil
+		}); nil != errThree {
+			uuc.log.Errorw("msg", "发卡写入错误", "err", errThree, "user_id", card.UserId, "card_id", card.ID)
+			continue
+		}
+
+		issued++
+		uuc.log.Infow("msg", "卡片已发卡", "user_id", card.UserId, "card_id", card.ID)
+		uuc.publishCardStatus(ctx, card, CardStatusActive)
+	}
+
+	return issued, len(cards), nil
+}
+
+// SyncCardTransactions 拉取发卡方交易流水入库，并用划转入账的结果更新 card_transfer；
+// 按页拉取直到最后一页，早于重叠窗口且状态未终结的交易再按 id 逐条查询
+func (uuc *UserUseCase) SyncCardTransactions(ctx context.Context) error {
+	last, err := uuc.cardRepo.GetLastCardTransactionTime(ctx)
+	if nil != err {
+		return err
+	}
+
+	start := time.Now().Add(-txnSyncLookback)
+	if !last.IsZero() {
+		start = last.Add(-txnSyncOverlap)
+	}
+
+	ts := &txnSync{
+		cards:        make(map[string]*Card, 0),
+		changedCards: make(map[uint64]*Card, 0),
+	}
+
+	// 有新交易或状态变化的卡片推送一次最新余额，中途失败时已同步的部分也推送
+	defer func() {
+		for _, card := range ts.changedCards {
+			uuc.publishCardBalance(ctx, card)
+		}
+	}()
+
+	prevFirst := ""
+	for page := 1; ; page++ {
+		if !stillLeader(ctx) {
+			return fmt.Errorf("leadership lost before page %d", page)
+		}
+
+		txns, _, err := uuc.interlace.ListTransactions(ctx, &interlace.TransactionListRequest{
+			StartTime: strconv.FormatInt(start.UnixMilli(), 10),
+			Limit:     txnSyncPageSize,
+			Page:      page,
+		})
+		if nil != err {
+			return err
+		}
+
+		// 发卡方忽略分页参数时会重复返回第一页，避免死循环
+		if 0 < len(txns) && 1 < page && prevFirst == txns[0].ID {
+			return fmt.Errorf("issuer returned page %d twice", page-1)
+		}
+		if 0 < len(txns) {
+			prevFirst = txns[0].ID
+		}
+
+		for _, t := range txns {
+			if err = uuc.saveSyncedTransaction(ctx, ts, t); nil != err {
+				return err
+			}
+		}
+
+		if txnSyncPageSize > len(txns) {
+			break
+		}
+	}
+
+	resynced, err := uuc.resyncNonFinalTransactions(ctx, ts, start)
+	if nil != err {
+		return err
+	}
+
+	uuc.log.Infow("msg", "交易同步", "synced", ts.synced, "resynced", resynced, "changed_cards", len(ts.changedCards), "start", start.Format(time.RFC3339))
+	return nil
+}
+
+// txnSync 一次同步中已查询的卡片和有变化的卡片
+type txnSync struct {
+	cards        map[string]*Card
+	changedCards map[uint64]*Card
+	synced       int
+}
+
+// saveSyncedTransaction 保存一条发卡方交易，推送变化、通知新交易并对账划转
+func (uuc *UserUseCase) saveSyncedTransaction(ctx context.Context, ts *txnSync, t *interlace.Transaction) error {
+	card, ok := ts.cards[t.CardId]
+	if !ok {
+		var err error
+		if card, err = uuc.cardRepo.GetCardByIssuerCardId(ctx, t.CardId); nil != err {
+			return err
+		}
+		ts.cards[t.CardId] = card
+	}
+
+	ct := cardTransactionFromInterlace(t)
+	if nil != card {
+		ct.UserId = card.UserId
+		ct.CardId = card.ID
+	}
+
+	created, changed, err := uuc.cardRepo.SaveCardTransaction(ctx, ct)
+	if nil != err {
+		return err
+	}
+	ts.synced++
+
+	if nil != card && changed {
+		uuc.publishCardTransaction(ctx, card, ct)
+		ts.changedCards[card.ID] = card
+	}
+
+	if nil != card && created {
+		uuc.notifyTransaction(ctx, card, t)
+	}
+
+	uuc.reconcileCardTransfer(ctx, t)
+	return nil
+}
+
+// resyncNonFinalTransactions 交易时间早于 before 且仍为 PENDING 等非终态的交易不在按时间拉取的范围内，按 id 查询最新状态
+func (uuc *UserUseCase) resyncNonFinalTransactions(ctx context.Context, ts *txnSync, before time.Time) (int, error) {
+	resynced := 0
+	afterId := uint64(0)
+	for {
+		if !stillLeader(ctx) {
+			return resynced, fmt.Errorf("leadership lost after resyncing %d transactions", resynced)
+		}
+
+		cts, err := uuc.cardRepo.GetNonFinalCardTransactions(ctx, afterId, txnSyncPageSize)
+		if nil != err {
+			return resynced, err
+		}
+
+		for _, ct := range cts {
+			afterId = ct.ID
+			if !ct.TransactionTime.Before(before) {
+				continue
+			}
+
+			txns, _, err := uuc.interlace.ListTransactions(ctx, &interlace.TransactionListRequest{ID: ct.TxnId})
+			if nil != err {
+				return resynced, err
+			}
+
+			for _, t := range txns {
+				if ct.TxnId != t.ID {
+					continue
+				}
+
+				if err = uuc.saveSyncedTransaction(ctx, ts, t); nil != err {
+					return resynced, err
+				}
+				resynced++
+			}
+		}
+
+		if txnSyncPageSize > len(cts) {
+			return resynced, nil
+		}
+	}
+}
+
+// reconcileCardTransfer 划转入账的 clientTransactionId 即 card_transfer.order_id
+func (uuc *UserUseCase) reconcileCardTransfer(ctx context.Context, t *interlace.Transaction) {
+	if !strings.HasPrefix(t.ClientTransactionId, "in-") {
+		return
+	}
+
+	status, errMsg := "", ""
+	switch t.Status {
+	case "CLOSED":
+		status = CardTransferSuccess
+	case "FAIL":
+		status, errMsg = CardTransferFailed, "issuer: FAIL"
+	default:
+		return
+	}
+
+	transfer, err := uuc.cardRepo.GetCardTransferByOrderId(ctx, t.ClientTransactionId)
+	if nil != err || nil == transfer || status == transfer.Status || CardTransferSuccess == transfer.Status {
+		return
+	}
+
+	if err = uuc.cardRepo.UpdateCardTransfer(ctx, transfer.ID, transfer.Status, status, errMsg); nil != err {
+		uuc.log.Errorw("msg", "划转对账更新失败", "err", err, "user_id", transfer.UserId, "order_id", transfer.OrderId, "status", status)
+		return
+	}
+	uuc.log.Infow("msg", "划转对账", "user_id", transfer.UserId, "order_id", transfer.OrderId, "from", transfer.Status, "to", status)
+
+	if CardTransferSuccess == status {
+		if card, _ := uuc.cardRepo.GetCardById(ctx, transfer.CardId); nil != card {
+			uuc.notifyCard(ctx, NotifyEventCardTopUp, card, map[string]interface{}{
+				"Amount": fmt.Sprintf("%.2f", transfer.Amount),
+			})
+			uuc.publishCardBalance(ctx, card)
+		}
+	}
+}
+
+// notifyTransaction 新同步到的交易通知持卡人，划转入账在对账时单独通知
+func (uuc *UserUseCase) notifyTransaction(ctx context.Context, card *Card, t *interlace.Transaction) {
+	if strings.HasPrefix(t.ClientTransactionId, "in-") {
+		return
+	}
+
+	event := NotifyEventCardTransaction
+	if "FAIL" == t.Status {
+		event = NotifyEventCardDecline
+	}
+
+	uuc.notifyCard(ctx, event, card, map[string]interface{}{
+		"Amount":   t.Amount,
+		"Currency": t.Currency,
+		"Merchant": t.MerchantName,
+		"Status":   t.Status,
+	})
+}
+
+func cardTransactionFromInterlace(t *interlace.Transaction) *CardTransaction {
+	amount, _ := strconv.ParseFloat(t.Amount, 64)
+	fee, _ := strconv.ParseFloat(t.Fee, 64)
+	transactionAmount, _ := strconv.ParseFloat(t.TransactionAmount, 64)
+
+	txnTime := parseInterlaceTime(t.TransactionTime)
+	if txnTime.IsZero() {
+		txnTime = parseInterlaceTime(t.CreateTime)
+	}
+
+	return &CardTransaction{
+		TxnId:               t.ID,
+		IssuerCardId:        t.CardId,
+		ClientTransactionId: t.ClientTransactionId,
+		Type:                t.Type,
+		Status:              t.Status,
+		Currency:            t.Currency,
+		Amount:              amount,
+		Fee:                 fee,
+		TransactionCurrency: t.TransactionCurrency,
+		TransactionAmount:   transactionAmount,
+		MerchantName:        t.MerchantName,
+		Mcc:                 t.Mcc,
+		TransactionTime:     txnTime,
+	}
+}
+
+// parseInterlaceTime 发卡方时间为毫秒时间戳字符串，兼容秒和 RFC3339
+func parseInterlaceTime(s string) time.Time {
+	if "" == s {
+		return time.Time{}
+	}
+
+	if v, err := strconv.ParseInt(s, 10, 64); nil == err {
+		if 1e12 <= v {
+			return time.UnixMilli(v)
+		}
+
+		return time.Unix(v, 0)
+	}
+
+	if v, err := time.Parse(time.RFC3339, s); nil == err {
+		return v
+	}
+
+	return time.Time{}
+}
+
+// ExpireStale 清理进程中断留下的处理中状态
+func (uuc *UserUseCase) ExpireStale(ctx context.Context) error {
+	keys, err := uuc.idempotencyRepo.DeleteStaleIdempotencyKeys(ctx, time.Now().Add(-idempotencyStaleAfter))
+	if nil != err {
+		return err
+	}
+
+	// 超时的划转置为 failed 后可由 cardctl redrive 重新发起，发卡方实际已入账的会在交易同步时改为 success
+	transfers, err := uuc.cardRepo.ExpireCardTransfers(ctx, time.Now().Add(-cardTransferStuckAfter))
+	if nil != err {
+		return err
+	}
+
+	if 0 < keys || 0 < transfers {
+		uuc.log.Warnw("msg", "清理中断的请求", "idempotency_keys", keys, "card_transfers", transfers)
+	}
+
+	return nil
+}
+
+// ReencryptPersonalData 把明文或旧主密钥加密的个人信息、两步验证密钥和审计密钥用当前主密钥重新加密，主密钥轮换后由定时任务在线完成
+func (uuc *UserUseCase) ReencryptPersonalData(ctx context.Context) error {
+	_, err := uuc.reencryptPersonalData(ctx)
+	return err
+}
+
+func (uuc *UserUseCase) reencryptPersonalData(ctx context.Context) (map[string]int, error) {
+	res := make(map[string]int, 4)
+	for name, fn := range map[string]func(context.Context, uint64, int) (uint64, int, error){
+		"user":      uuc.repo.ReencryptUsers,
+		"card_two":  uuc.repo.ReencryptCardTwos,
+		"user_totp": uuc.totpRepo.ReencryptTotpSecrets,
+		"audit_key": uuc.auditRepo.ReencryptAuditKeys,
+	} {
+		var afterId uint64
+		for {
+			lastId, updated, err := fn(ctx, afterId, reencryptBatchSize)
+			res[name] += updated
+			if nil != err {
+				return res, err
+			}
+
+			if 0 == lastId {
+				break
+			}
+			afterId = lastId
+		}
+
+		if 0 < res[name] {
+			uuc.log.Infow("msg", "个人信息重新加密", "table", name, "rows", res[name])
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,102 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	lockTTL  = 10 * time.Second // 锁过期时间，持有者异常退出时自动释放
+	lockWait = 3 * time.Second  // 获取锁最长等待时间
+)
+
+var ErrLockNotAcquired = errors.New(429, "LOCK_NOT_ACQUIRED", "操作繁忙，请稍后再试")
+
+// Lock 分布式锁，Token 为单调递增的 fencing token，每次成功加锁都会变大
+type Lock struct {
+	Key   string
+	Owner string
+	Token int64
+}
+
+type Locker interface {
+	// Lock 在 wait 时间内获取锁，超时返回 ErrLockNotAcquired
+	Lock(ctx context.Context, key string, ttl, wait time.Duration) (*Lock, error)
+	Unlock(ctx context.Context, lock *Lock) error
+	// Validate 锁仍由自己持有，且没有更大的 fencing token 被签发
+	Validate(ctx context.Context, lock *Lock) error
+	// Fence 在数据库事务内登记 fencing token 并锁住对应行，已登记更大的 token 时返回错误；
+	// 锁过期后新的持有者登记更大的 token，旧持有者的事务无法再写入
+	Fence(ctx context.Context, lock *Lock) error
+}
+
+func balanceLockKey(userId uint64) string {
+	return "balance:" + strconv.FormatUint(userId, 10)
+}
+
+func nonceLockKey(address string) string {
+	return "nonce:" + address
+}
+
+// withLock 持有 key 对应的锁执行 fn
+func (uuc *UserUseCase) withLock(ctx context.Context, key string, fn func() error) error {
+	lock, err := uuc.locker.Lock(ctx, key, lockTTL, lockWait)
+	if nil != err {
+		return err
+	}
+	defer func() {
+		_ = uuc.locker.Unlock(context.Background(), lock)
+	}()
+
+	return fn()
+}
+
+// lockedTx 持有全部 keys 的锁执行事务，事务内登记 fencing token，提交前校验锁未丢失，用于所有余额变动
+func (uuc *UserUseCase) lockedTx(ctx context.Context, keys []string, fn func(ctx context.Context) error) error {
+	// 固定加锁顺序，避免互相等待
+	sorted := append([]string{}, keys...)
+	sort.Strings(sorted)
+
+	locks := make([]*Lock, 0, len(sorted))
+	defer func() {
+		for _, v := range locks {
+			_ = uuc.locker.Unlock(context.Background(), v)
+		}
+	}()
+
+	for k, key := range sorted {
+		if 0 < k && key == sorted[k-1] {
+			continue
+		}
+
+		lock, err := uuc.locker.Lock(ctx, key, lockTTL, lockWait)
+		if nil != err {
+			return err
+		}
+		locks = append(locks, lock)
+	}
+
+	return uuc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		// 先登记 fencing token，行锁持有到提交，锁过期后的旧持有者在这里失败或等待新持有者提交后失败
+		for _, v := range locks {
+			if err := uuc.locker.Fence(ctx, v); nil != err {
+				return err
+			}
+		}
+
+		if err := fn(ctx); nil != err {
+			return err
+		}
+
+		for _, v := range locks {
+			if err := uuc.locker.Validate(ctx, v); nil != err {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
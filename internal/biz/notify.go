@@ -0,0 +1,505 @@
+package biz
+
+import (
+	"bytes"
+	pb "cardbinance/api/user/v1"
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"net/mail"
+	"strconv"
+	"text/template"
+	"time"
+)
+
+const (
+	NotifyChannelEmail    = "email"
+	NotifyChannelTelegram = "telegram"
+	NotifyChannelInbox    = "inbox" // 站内信，写入即送达
+)
+
+const (
+	NotifyEventCardTransaction       = "card_transaction" // 卡片消费
+	NotifyEventCardDecline           = "card_decline"     // 卡片交易失败
+	NotifyEventCardTopUp             = "card_topup"       // 划转到卡入账
+	NotifyEventCardFrozen            = "card_frozen"
+	NotifyEventWithdraw              = "withdraw"
+	NotifyEventWithdrawAddressAdd    = "withdraw_address_add"
+	NotifyEventWithdrawAddressDelete = "withdraw_address_delete"
+	NotifyEventRiskHold              = "risk_hold"   // 提现或转账转人工审核
+	NotifyEventRiskReject            = "risk_reject" // 人工审核拒绝
+	NotifyEventReferralReward        = "referral_reward"
+	NotifyEventVipChange             = "vip_change"
+)
+
+const (
+	NotificationPending = "pending"
+	NotificationSent    = "sent"
+	NotificationFailed  = "failed"
+)
+
+const (
+	notifyMaxAttempts           = 5
+	notifyDispatchBatch         = 100
+	notifyRewardCursor          = "reward"
+	rewardReasonReferral uint64 = 6 // 开卡推荐奖励
+)
+
+var notifyChannels = []string{NotifyChannelEmail, NotifyChannelTelegram, NotifyChannelInbox}
+
+var notifyEvents = []string{
+	NotifyEventCardTransaction,
+	NotifyEventCardDecline,
+	NotifyEventCardTopUp,
+	NotifyEventCardFrozen,
+	NotifyEventWithdraw,
+	NotifyEventWithdrawAddressAdd,
+	NotifyEventWithdrawAddressDelete,
+	NotifyEventRiskHold,
+	NotifyEventRiskReject,
+	NotifyEventReferralReward,
+	NotifyEventVipChange,
+}
+
+// 安全相关事件，用户不能关闭，防止账户被盗后先关闭通知再操作
+var securityNotifyEvents = map[string]bool{
+	NotifyEventWithdrawAddressAdd:    true,
+	NotifyEventWithdrawAddressDelete: true,
+	NotifyEventCardFrozen:            true,
+	NotifyEventRiskHold:              true,
+}
+
+// 默认模板，config 表 notify_<event>_title / notify_<event>_body 可覆盖
+var notifyTemplates = map[string][2]string{
+	NotifyEventCardTransaction:       {"卡片交易 {{.Amount}} {{.Currency}}", "您的卡片 {{.Pan}} 在 {{.Merchant}} 发生交易 {{.Amount}} {{.Currency}}，状态 {{.Status}}。"},
+	NotifyEventCardDecline:           {"卡片交易失败", "您的卡片 {{.Pan}} 在 {{.Merchant}} 的交易 {{.Amount}} {{.Currency}} 未成功。"},
+	NotifyEventCardTopUp:             {"划转到卡成功", "{{.Amount}} USD 已入账卡片 {{.Pan}}。"},
+	NotifyEventCardFrozen:            {"卡片已冻结", "您的卡片 {{.Pan}} 已冻结，如非本人操作请联系客服。"},
+	NotifyEventWithdraw:              {"提现已提交", "提现 {{.Amount}} {{.Currency}} 到 {{.Address}} 已提交，到账 {{.AmountRel}} {{.Currency}}。"},
+	NotifyEventWithdrawAddressAdd:    {"新增提现地址", "已添加提现地址 {{.Label}} {{.Chain}} {{.Address}}，{{.AvailableAt}} 后可用于提现，如非本人操作请立即删除并联系客服。"},
+	NotifyEventWithdrawAddressDelete: {"删除提现地址", "已删除提现地址 {{.Label}} {{.Chain}} {{.Address}}，如非本人操作请联系客服。"},
+	NotifyEventRiskHold:              {"{{.Operation}}审核中", "{{.Operation}} {{.Amount}} {{.Currency}} 需要人工审核，金额已冻结，审核通过后自动处理。"},
+	NotifyEventRiskReject:            {"{{.Operation}}未通过审核", "{{.Operation}} {{.Amount}} {{.Currency}} 未通过审核，冻结金额已退回。{{.Remark}}"},
+	NotifyEventReferralReward:        {"推荐奖励到账", "推荐用户 {{.Address}} 开卡，奖励 {{.Amount}} 已到账。"},
+	NotifyEventVipChange:             {"VIP 等级变更", "您的 VIP 等级已调整为 {{.Vip}}。"},
+}
+
+type Notification struct {
+	ID        uint64
+	UserId    uint64
+	Event     string
+	Channel   string
+	Address   string
+	Title     string
+	Body      string
+	Status    string
+	Error     string
+	Attempts  uint64
+	Read      bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NotifyTarget 用户在某个渠道的接收地址
+type NotifyTarget struct {
+	UserId  uint64
+	Channel string
+	Address string
+}
+
+// NotifyPreference 用户关闭或打开某个事件在某个渠道的通知，没有记录时默认打开
+type NotifyPreference struct {
+	UserId  uint64
+	Event   string
+	Channel string
+	Enabled bool
+}
+
+type NotifyRepo interface {
+	CreateNotifications(ctx context.Context, ns []*Notification) error
+	GetPendingNotifications(ctx context.Context, limit int) ([]*Notification, error)
+	// UpdateNotification 修改状态并累加尝试次数
+	UpdateNotification(ctx context.Context, id uint64, status, errMsg string) error
+	GetInboxPage(ctx context.Context, b *Pagination, userId uint64) ([]*Notification, error, int64)
+	CountUnread(ctx context.Context, userId uint64) (int64, error)
+	// ReadInbox id 为 0 时全部标记已读
+	ReadInbox(ctx context.Context, userId, id uint64) error
+	GetNotifyTargets(ctx context.Context, userId uint64) ([]*NotifyTarget, error)
+	SaveNotifyTarget(ctx context.Context, t *NotifyTarget) error
+	DeleteNotifyTarget(ctx context.Context, userId uint64, channel string) error
+	GetNotifyPreferences(ctx context.Context, userId uint64) ([]*NotifyPreference, error)
+	SaveNotifyPreference(ctx context.Context, p *NotifyPreference) error
+	GetNotifyCursor(ctx context.Context, name string) (uint64, bool, error)
+	SetNotifyCursor(ctx context.Context, name string, value uint64) error
+}
+
+// NotifySender 邮件、Telegram 等外部渠道，未配置的渠道不会注册
+type NotifySender interface {
+	Channel() string
+	Send(ctx context.Context, address, title, body string) error
+}
+
+// Notifier 按模板和用户设置生成通知，由定时任务投递
+type Notifier struct {
+	repo     NotifyRepo
+	userRepo UserRepo
+	senders  map[string]NotifySender
+	log      *log.Helper
+}
+
+func NewNotifier(repo NotifyRepo, userRepo UserRepo, senders []NotifySender, logger log.Logger) *Notifier {
+	n := &Notifier{
+		repo:     repo,
+		userRepo: userRepo,
+		senders:  make(map[string]NotifySender, len(senders)),
+		log:      log.NewHelper(logger),
+	}
+	for _, v := range senders {
+		n.senders[v.Channel()] = v
+	}
+
+	return n
+}
+
+// render 渲染事件模板，config 表中的模板优先
+func (n *Notifier) render(event string, data map[string]interface{}) (string, string, error) {
+	tpl, ok := notifyTemplates[event]
+	if !ok {
+		return "", "", fmt.Errorf("unknown notify event %s", event)
+	}
+
+	titleKey, bodyKey := "notify_"+event+"_title", "notify_"+event+"_body"
+	if configs, err := n.userRepo.GetConfigByKeys(titleKey, bodyKey); nil == err {
+		for _, v := range configs {
+			if "" == v.Value {
+				continue
+			}
+
+			switch v.KeyName {
+			case titleKey:
+				tpl[0] = v.Value
+			case bodyKey:
+				tpl[1] = v.Value
+			}
+		}
+	}
+
+	res := [2]string{}
+	for k, v := range tpl {
+		t, err := template.New(event).Option("missingkey=zero").Parse(v)
+		if nil != err {
+			return "", "", err
+		}
+
+		var buf bytes.Buffer
+		if err = t.Execute(&buf, data); nil != err {
+			return "", "", err
+		}
+		res[k] = buf.String()
+	}
+
+	return res[0], res[1], nil
+}
+
+// addresses 用户各渠道的接收地址，邮箱默认使用开卡邮箱
+func (n *Notifier) addresses(ctx context.Context, userId uint64) (map[string]string, error) {
+	res := map[string]string{NotifyChannelInbox: ""}
+
+	if user, err := n.userRepo.GetUserById(userId); nil == err && nil != user && "" != user.Email {
+		res[NotifyChannelEmail] = user.Email
+	}
+
+	targets, err := n.repo.GetNotifyTargets(ctx, userId)
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range targets {
+		res[v.Channel] = v.Address
+	}
+
+	return res, nil
+}
+
+// Notify 生成通知，失败只记录日志，不影响已完成的业务
+func (n *Notifier) Notify(ctx context.Context, userId uint64, event string, data map[string]interface{}) {
+	if err := n.notify(ctx, userId, event, data); nil != err {
+		n.log.Errorw("msg", "通知生成失败", "err", err, "user_id", userId, "event", event)
+	}
+}
+
+func (n *Notifier) notify(ctx context.Context, userId uint64, event string, data map[string]interface{}) error {
+	title, body, err := n.render(event, data)
+	if nil != err {
+		return err
+	}
+
+	addresses, err := n.addresses(ctx, userId)
+	if nil != err {
+		return err
+	}
+
+	prefs, err := n.repo.GetNotifyPreferences(ctx, userId)
+	if nil != err {
+		return err
+	}
+	// 安全事件不受用户设置影响，包括修复前已关闭的设置
+	disabled := make(map[string]bool, len(prefs))
+	for _, v := range prefs {
+		if event == v.Event && !v.Enabled && !securityNotifyEvents[event] {
+			disabled[v.Channel] = true
+		}
+	}
+
+	ns := make([]*Notification, 0, len(notifyChannels))
+	for _, channel := range notifyChannels {
+		address, ok := addresses[channel]
+		if !ok || disabled[channel] {
+			continue
+		}
+
+		status := NotificationPending
+		if NotifyChannelInbox == channel {
+			status = NotificationSent
+		} else if _, ok = n.senders[channel]; !ok {
+			continue
+		}
+
+		ns = append(ns, &Notification{
+			UserId:  userId,
+			Event:   event,
+			Channel: channel,
+			Address: address,
+			Title:   title,
+			Body:    body,
+			Status:  status,
+		})
+	}
+
+	if 0 == len(ns) {
+		return nil
+	}
+
+	return n.repo.CreateNotifications(ctx, ns)
+}
+
+// Dispatch 投递待发送的邮件和 Telegram，失败的下次重试，超过次数后放弃
+func (n *Notifier) Dispatch(ctx context.Context) error {
+	ns, err := n.repo.GetPendingNotifications(ctx, notifyDispatchBatch)
+	if nil != err {
+		return err
+	}
+
+	failed := 0
+	for _, v := range ns {
+		sender, ok := n.senders[v.Channel]
+		if !ok {
+			_ = n.repo.UpdateNotification(ctx, v.ID, NotificationFailed, "channel not configured")
+			continue
+		}
+
+		status, errMsg := NotificationSent, ""
+		if errSend := sender.Send(ctx, v.Address, v.Title, v.Body); nil != errSend {
+			failed++
+			status, errMsg = NotificationPending, errSend.Error()
+			if notifyMaxAttempts <= v.Attempts+1 {
+				status = NotificationFailed
+			}
+			n.log.Warnw("msg", "通知发送失败", "err", errSend, "user_id", v.UserId, "channel", v.Channel, "attempts", v.Attempts+1)
+		}
+
+		if errUpdate := n.repo.UpdateNotification(ctx, v.ID, status, errMsg); nil != errUpdate {
+			return errUpdate
+		}
+	}
+
+	if 0 < failed {
+		return fmt.Errorf("%d of %d notifications failed", failed, len(ns))
+	}
+
+	return nil
+}
+
+// NotifyRewards 推荐奖励由外部流程写入 reward 表，按 id 游标扫描新记录生成通知
+func (n *Notifier) NotifyRewards(ctx context.Context) error {
+	cursor, ok, err := n.repo.GetNotifyCursor(ctx, notifyRewardCursor)
+	if nil != err {
+		return err
+	}
+
+	// 第一次运行从当前位置开始，不补发历史奖励
+	if !ok {
+		last, err := n.userRepo.GetLastRewardId(ctx)
+		if nil != err {
+			return err
+		}
+
+		return n.repo.SetNotifyCursor(ctx, notifyRewardCursor, last)
+	}
+
+	rewards, err := n.userRepo.GetRewardsAfterId(ctx, cursor, rewardReasonReferral, notifyDispatchBatch)
+	if nil != err {
+		return err
+	}
+
+	for _, v := range rewards {
+		n.Notify(ctx, v.UserId, NotifyEventReferralReward, map[string]interface{}{
+			"Amount":  strconv.FormatFloat(v.Amount, 'f', 2, 64),
+			"Address": v.Address,
+		})
+		cursor = v.ID
+	}
+
+	if 0 == len(rewards) {
+		return nil
+	}
+
+	return n.repo.SetNotifyCursor(ctx, notifyRewardCursor, cursor)
+}
+
+// NotifyList .
+func (uuc *UserUseCase) NotifyList(ctx context.Context, req *pb.NotifyListRequest, userId uint64) (*pb.NotifyListReply, error) {
+	res := make([]*pb.NotifyListReply_List, 0)
+
+	ns, err, count := uuc.notifier.repo.GetInboxPage(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 20,
+	}, userId)
+	if nil != err {
+		return &pb.NotifyListReply{Status: "查询错误"}, nil
+	}
+
+	unread, err := uuc.notifier.repo.CountUnread(ctx, userId)
+	if nil != err {
+		return &pb.NotifyListReply{Status: "查询错误"}, nil
+	}
+
+	for _, v := range ns {
+		res = append(res, &pb.NotifyListReply_List{
+			Id:        v.ID,
+			Event:     v.Event,
+			Title:     v.Title,
+			Body:      v.Body,
+			Read:      v.Read,
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &pb.NotifyListReply{
+		Status: "ok",
+		Count:  uint64(count),
+		Unread: uint64(unread),
+		List:   res,
+	}, nil
+}
+
+// NotifyRead .
+func (uuc *UserUseCase) NotifyRead(ctx context.Context, req *pb.NotifyReadRequest, userId uint64) (*pb.NotifyReadReply, error) {
+	if err := uuc.notifier.repo.ReadInbox(ctx, userId, req.SendBody.Id); nil != err {
+		return &pb.NotifyReadReply{Status: "修改失败"}, nil
+	}
+
+	return &pb.NotifyReadReply{Status: "ok"}, nil
+}
+
+// NotifySetting .
+func (uuc *UserUseCase) NotifySetting(ctx context.Context, req *pb.NotifySettingRequest, userId uint64) (*pb.NotifySettingReply, error) {
+	addresses, err := uuc.notifier.addresses(ctx, userId)
+	if nil != err {
+		return &pb.NotifySettingReply{Status: "查询错误"}, nil
+	}
+
+	prefs, err := uuc.notifier.repo.GetNotifyPreferences(ctx, userId)
+	if nil != err {
+		return &pb.NotifySettingReply{Status: "查询错误"}, nil
+	}
+	disabled := make(map[string]bool, len(prefs))
+	for _, v := range prefs {
+		if !v.Enabled {
+			disabled[v.Event+"."+v.Channel] = true
+		}
+	}
+
+	list := make([]*pb.NotifyPreference, 0, len(notifyEvents)*len(notifyChannels))
+	for _, event := range notifyEvents {
+		for _, channel := range notifyChannels {
+			list = append(list, &pb.NotifyPreference{
+				Event:   event,
+				Channel: channel,
+				Enabled: !disabled[event+"."+channel],
+			})
+		}
+	}
+
+	return &pb.NotifySettingReply{
+		Status:         "ok",
+		Email:          addresses[NotifyChannelEmail],
+		TelegramChatId: addresses[NotifyChannelTelegram],
+		List:           list,
+	}, nil
+}
+
+// SetNotifySetting .
+func (uuc *UserUseCase) SetNotifySetting(ctx context.Context, req *pb.SetNotifySettingRequest, userId uint64) (*pb.SetNotifySettingReply, error) {
+	if "" != req.SendBody.Email {
+		if _, err := mail.ParseAddress(req.SendBody.Email); nil != err || 99 < len(req.SendBody.Email) {
+			return &pb.SetNotifySettingReply{Status: "邮箱错误"}, nil
+		}
+	}
+
+	if "" != req.SendBody.TelegramChatId && "-" != req.SendBody.TelegramChatId {
+		if _, err := strconv.ParseInt(req.SendBody.TelegramChatId, 10, 64); nil != err {
+			return &pb.SetNotifySettingReply{Status: "Telegram chat id错误"}, nil
+		}
+	}
+
+	validEvents := make(map[string]bool, len(notifyEvents))
+	for _, v := range notifyEvents {
+		validEvents[v] = true
+	}
+	validChannels := make(map[string]bool, len(notifyChannels))
+	for _, v := range notifyChannels {
+		validChannels[v] = true
+	}
+	for _, v := range req.SendBody.List {
+		if !validEvents[v.Event] || !validChannels[v.Channel] {
+			return &pb.SetNotifySettingReply{Status: "通知类型错误"}, nil
+		}
+
+		if !v.Enabled && securityNotifyEvents[v.Event] {
+			return &pb.SetNotifySettingReply{Status: "安全通知不能关闭"}, nil
+		}
+	}
+
+	if err := uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		if "" != req.SendBody.Email {
+			if err := uuc.notifier.repo.SaveNotifyTarget(ctx, &NotifyTarget{UserId: userId, Channel: NotifyChannelEmail, Address: req.SendBody.Email}); nil != err {
+				return err
+			}
+		}
+
+		switch req.SendBody.TelegramChatId {
+		case "":
+		case "-":
+			if err := uuc.notifier.repo.DeleteNotifyTarget(ctx, userId, NotifyChannelTelegram); nil != err {
+				return err
+			}
+		default:
+			if err := uuc.notifier.repo.SaveNotifyTarget(ctx, &NotifyTarget{UserId: userId, Channel: NotifyChannelTelegram, Address: req.SendBody.TelegramChatId}); nil != err {
+				return err
+			}
+		}
+
+		for _, v := range req.SendBody.List {
+			if err := uuc.notifier.repo.SaveNotifyPreference(ctx, &NotifyPreference{UserId: userId, Event: v.Event, Channel: v.Channel, Enabled: v.Enabled}); nil != err {
+				return err
+			}
+		}
+
+		return nil
+	}); nil != err {
+		uuc.log.Errorw("msg", "通知设置写入mysql错误", "err", err, "user_id", userId)
+		return &pb.SetNotifySettingReply{Status: "修改失败"}, nil
+	}
+
+	return &pb.SetNotifySettingReply{Status: "ok"}, nil
+}
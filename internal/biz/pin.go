@@ -0,0 +1,211 @@
+package biz
+
+import (
+	pb "cardbinance/api/user/v1"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	pinLength        = 6
+	pinMaxAttempts   = 5
+	pinAttemptWindow = time.Hour // 每张卡窗口内最多提交到发卡方的次数，包括成功的修改
+)
+
+// 卡片记录类型，其余类型由后台写入
+const CardRecordTypePin uint64 = 101
+
+// PinKey 客户端加密PIN的RSA密钥
+type PinKey struct {
+	private        *rsa.PrivateKey
+	id             string
+	publicPEM      string
+	allowPlaintext bool
+}
+
+// NewPinKey private 为 nil 时不接受加密PIN，allowPlaintext 决定是否接受明文PIN
+func NewPinKey(private *rsa.PrivateKey, allowPlaintext bool) (*PinKey, error) {
+	k := &PinKey{private: private, allowPlaintext: allowPlaintext}
+	if nil == private {
+		return k, nil
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(&private.PublicKey)
+	if nil != err {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(der)
+	k.id = hex.EncodeToString(sum[:8])
+	k.publicPEM = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	return k, nil
+}
+
+// open 取出PIN，返回空状态表示成功
+func (k *PinKey) open(encrypted, keyId, plaintext string) (string, string) {
+	if "" == encrypted {
+		if !k.allowPlaintext {
+			return "", "请升级客户端后修改PIN"
+		}
+
+		return plaintext, ""
+	}
+
+	if nil == k.private {
+		return "", "暂不支持加密PIN"
+	}
+
+	if "" != keyId && keyId != k.id {
+		return "", "PIN加密密钥已更新，请重试"
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(encrypted)
+	if nil != err {
+		return "", "PIN解密失败"
+	}
+
+	pin, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, k.private, ciphertext, nil)
+	if nil != err {
+		return "", "PIN解密失败"
+	}
+
+	return string(pin), ""
+}
+
+// checkPin 6位数字，不能全部相同、连续递增递减或由两三位重复组成
+func checkPin(pin string) string {
+	if pinLength != len(pin) {
+		return "PIN必须为6位数字"
+	}
+
+	for _, c := range pin {
+		if '0' > c || '9' < c {
+			return "PIN必须为6位数字"
+		}
+	}
+
+	ascending, descending := true, true
+	for i := 1; i < len(pin); i++ {
+		if (pin[i-1]-'0'+1)%10 != pin[i]-'0' {
+			ascending = false
+		}
+		if (pin[i]-'0'+1)%10 != pin[i-1]-'0' {
+			descending = false
+		}
+	}
+	if ascending || descending {
+		return "PIN不能为连续数字"
+	}
+
+	for _, period := range []int{1, 2, 3} {
+		if strings.Repeat(pin[:period], pinLength/period) == pin {
+			return "PIN过于简单"
+		}
+	}
+
+	return ""
+}
+
+// PinKey 客户端加密用的公钥
+func (uuc *UserUseCase) PinKey(ctx context.Context, key *PinKey) (*pb.PinKeyReply, error) {
+	if nil == key || nil == key.private {
+		return &pb.PinKeyReply{Status: "暂不支持加密PIN"}, nil
+	}
+
+	return &pb.PinKeyReply{Status: "ok", PublicKey: key.publicPEM, KeyId: key.id}, nil
+}
+
+func (uuc *UserUseCase) ChangePin(ctx context.Context, req *pb.ChangePinRequest, userId uint64, key *PinKey) (*pb.ChangePinReply, error) {
+	var (
+		card     *Card
+		err      error
+		cardType = CardTypeVirtual
+	)
+
+	if 0 == req.SendBody.CardId {
+		switch req.SendBody.CardType {
+		case 0:
+		case 1:
+			cardType = CardTypePhysical
+		default:
+			return &pb.ChangePinReply{Status: "卡片类型错误"}, nil
+		}
+	}
+
+	card, err = uuc.getUserCard(ctx, userId, req.SendBody.CardId, cardType)
+	if nil != err {
+		return &pb.ChangePinReply{Status: "查询卡片错误"}, nil
+	}
+
+	if nil == card || !card.Usable() {
+		return &pb.ChangePinReply{Status: "卡片未激活"}, nil
+	}
+
+	attempts, err := uuc.cardRepo.GetPinAttempts(ctx, card.ID)
+	if nil != err {
+		uuc.log.Errorw("msg", "查询PIN修改次数错误", "err", err, "card_id", card.ID)
+		return &pb.ChangePinReply{Status: "修改PIN错误"}, nil
+	}
+
+	if pinMaxAttempts <= attempts {
+		return &pb.ChangePinReply{Status: "PIN修改次数过多，请稍后再试"}, nil
+	}
+
+	pin, status := key.open(req.SendBody.EncryptedPin, req.SendBody.KeyId, req.SendBody.Pin)
+	if "" == status {
+		status = checkPin(pin)
+	}
+	if "" != status {
+		return &pb.ChangePinReply{Status: status}, nil
+	}
+
+	if attempts, err = uuc.cardRepo.IncrPinAttempts(ctx, card.ID, pinAttemptWindow); nil != err {
+		uuc.log.Errorw("msg", "记录PIN修改次数错误", "err", err, "card_id", card.ID)
+		return &pb.ChangePinReply{Status: "修改PIN错误"}, nil
+	}
+
+	if pinMaxAttempts < attempts {
+		uuc.log.Warnw("msg", "PIN修改次数过多", "user_id", userId, "card_id", card.ID, "attempts", attempts)
+		return &pb.ChangePinReply{Status: "PIN修改次数过多，请稍后再试"}, nil
+	}
+
+	res, errTwo := uuc.interlace.SetCardPin(ctx, card.IssuerCardId, pin)
+	if !res || errTwo != nil {
+		uuc.log.Errorw("msg", "修改PIN错误", "err", errTwo, "user_id", userId, "card_id", card.ID)
+		if CardTypePhysical == card.CardType {
+			return &pb.ChangePinReply{Status: "实体卡修改pin失败"}, nil
+		}
+
+		return &pb.ChangePinReply{Status: "虚拟卡修改pin失败"}, nil
+	}
+
+	remark := "虚拟卡修改PIN"
+	if CardTypePhysical == card.CardType {
+		remark = "实体卡修改PIN"
+	}
+	if "" != card.MaskedPan {
+		remark += " " + card.MaskedPan
+	}
+
+	// PIN 已在发卡方生效，记录失败不影响结果
+	if err = uuc.cardRepo.CreateCardRecord(ctx, &CardRecord{
+		UserId:     userId,
+		RecordType: CardRecordTypePin,
+		Remark:     remark,
+		Code:       strconv.FormatUint(card.ID, 10),
+		Opt:        "change_pin",
+	}); nil != err {
+		uuc.log.Errorw("msg", "卡片记录写入失败", "err", err, "user_id", userId, "card_id", card.ID)
+	}
+
+	return &pb.ChangePinReply{Status: "ok"}, nil
+}
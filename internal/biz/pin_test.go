@@ -0,0 +1,89 @@
+package biz
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+)
+
+func TestCheckPin(t *testing.T) {
+	tests := []struct {
+		name string
+		pin  string
+		want string
+	}{
+		{name: "valid", pin: "284915", want: ""},
+		{name: "too short", pin: "28491", want: "PIN必须为6位数字"},
+		{name: "too long", pin: "2849150", want: "PIN必须为6位数字"},
+		{name: "not digits", pin: "28491a", want: "PIN必须为6位数字"},
+		{name: "full width digits", pin: "２８４９１５", want: "PIN必须为6位数字"},
+		{name: "ascending", pin: "123456", want: "PIN不能为连续数字"},
+		{name: "ascending wraps", pin: "789012", want: "PIN不能为连续数字"},
+		{name: "descending", pin: "654321", want: "PIN不能为连续数字"},
+		{name: "descending wraps", pin: "210987", want: "PIN不能为连续数字"},
+		{name: "all same", pin: "777777", want: "PIN过于简单"},
+		{name: "two digit repeat", pin: "121212", want: "PIN过于简单"},
+		{name: "three digit repeat", pin: "583583", want: "PIN过于简单"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := checkPin(tt.pin); tt.want != got {
+				t.Errorf("checkPin(%q) = %q, want %q", tt.pin, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPinKeyOpen(t *testing.T) {
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encrypted, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, &private.PublicKey, []byte("284915"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext := base64.StdEncoding.EncodeToString(encrypted)
+
+	withKey, err := NewPinKey(private, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	withKeyPlaintext, _ := NewPinKey(private, true)
+	noKey, _ := NewPinKey(nil, false)
+	noKeyPlaintext, _ := NewPinKey(nil, true)
+
+	tests := []struct {
+		name       string
+		key        *PinKey
+		encrypted  string
+		keyId      string
+		plaintext  string
+		wantPin    string
+		wantStatus string
+	}{
+		{name: "encrypted", key: withKey, encrypted: ciphertext, keyId: withKey.id, wantPin: "284915"},
+		{name: "encrypted without key id", key: withKey, encrypted: ciphertext, wantPin: "284915"},
+		{name: "stale key id", key: withKey, encrypted: ciphertext, keyId: "0000000000000000", wantStatus: "PIN加密密钥已更新，请重试"},
+		{name: "not base64", key: withKey, encrypted: "%%%", wantStatus: "PIN解密失败"},
+		{name: "wrong ciphertext", key: withKey, encrypted: base64.StdEncoding.EncodeToString([]byte("garbage")), wantStatus: "PIN解密失败"},
+		{name: "plaintext rejected", key: withKey, plaintext: "284915", wantStatus: "请升级客户端后修改PIN"},
+		{name: "plaintext allowed", key: withKeyPlaintext, plaintext: "284915", wantPin: "284915"},
+		{name: "no key rejects plaintext", key: noKey, plaintext: "284915", wantStatus: "请升级客户端后修改PIN"},
+		{name: "no key rejects encrypted", key: noKey, encrypted: ciphertext, wantStatus: "暂不支持加密PIN"},
+		{name: "no key plaintext allowed", key: noKeyPlaintext, plaintext: "284915", wantPin: "284915"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pin, status := tt.key.open(tt.encrypted, tt.keyId, tt.plaintext)
+			if tt.wantPin != pin || tt.wantStatus != status {
+				t.Errorf("open() = %q, %q, want %q, %q", pin, status, tt.wantPin, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,364 @@
+package biz
+
+import (
+	"archive/zip"
+	"bytes"
+	pb "cardbinance/api/user/v1"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+// 导出时每页读取的流水条数
+const exportPageSize = 100
+
+// 注销账户时视为零余额的误差
+const eraseBalanceEpsilon = 1e-8
+
+// CardApplication 实体卡申请资料，card_two 表
+type CardApplication struct {
+	ID               uint64
+	UserId           uint64
+	FirstName        string
+	LastName         string
+	Email            string
+	CountryCode      string
+	Phone            string
+	PhoneCountryCode string
+	City             string
+	Country          string
+	Street           string
+	PostalCode       string
+	BirthDate        string
+	State            string
+	IdCard           string
+	Gender           string
+	Status           uint64
+	ScreeningResult  string
+	CreatedAt        time.Time
+}
+
+type PrivacyRepo interface {
+	GetCardApplications(ctx context.Context, userId uint64) ([]*CardApplication, error)
+	GetWithdrawals(ctx context.Context, userId uint64) ([]*Withdraw, error)
+	// ErasePersonalData 匿名化个人信息并删除通知、两步验证、提现地址簿，交易记录保留，需要在事务中调用
+	ErasePersonalData(ctx context.Context, userId uint64) error
+}
+
+// ExportBundle 导出的数据和 ed25519 签名，用户可用公钥校验数据未被篡改
+type ExportBundle struct {
+	Data      []byte
+	Signature []byte
+	PublicKey ed25519.PublicKey
+}
+
+// Zip data.json、data.json.sig 和 public_key.txt，签名和公钥为 base64
+func (b *ExportBundle) Zip() ([]byte, error) {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	files := []struct {
+		name string
+		body []byte
+	}{
+		{"data.json", b.Data},
+		{"data.json.sig", []byte(base64.StdEncoding.EncodeToString(b.Signature))},
+		{"public_key.txt", []byte(base64.StdEncoding.EncodeToString(b.PublicKey))},
+	}
+
+	for _, v := range files {
+		f, err := w.Create(v.name)
+		if nil != err {
+			return nil, err
+		}
+
+		if _, err = f.Write(v.body); nil != err {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); nil != err {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// ExportPersonalData 导出用户的全部数据并签名，key 为空时不支持导出
+func (uuc *UserUseCase) ExportPersonalData(ctx context.Context, userId uint64, key ed25519.PrivateKey) (*ExportBundle, error) {
+	if nil == key {
+		return nil, fmt.Errorf("未配置数据导出签名密钥")
+	}
+
+	user, err := uuc.repo.GetUserById(userId)
+	if nil != err {
+		return nil, err
+	}
+
+	if nil == user {
+		return nil, fmt.Errorf("用户不存在: %d", userId)
+	}
+
+	applications, err := uuc.privacyRepo.GetCardApplications(ctx, userId)
+	if nil != err {
+		return nil, err
+	}
+
+	cards, err := uuc.getUserCards(ctx, userId)
+	if nil != err {
+		return nil, err
+	}
+
+	balances, err := uuc.balanceRepo.GetBalancesByUserId(ctx, userId)
+	if nil != err {
+		return nil, err
+	}
+
+	withdrawals, err := uuc.privacyRepo.GetWithdrawals(ctx, userId)
+	if nil != err {
+		return nil, err
+	}
+
+	rewards := make([]*Reward, 0)
+	for page := 1; ; page++ {
+		tmp, errTwo, _ := uuc.repo.GetUserRewardByUserIdPage(ctx, &Pagination{PageNum: page, PageSize: exportPageSize}, userId, 0, 0)
+		if nil != errTwo {
+			return nil, errTwo
+		}
+
+		rewards = append(rewards, tmp...)
+		if exportPageSize > len(tmp) {
+			break
+		}
+	}
+
+	records := make([]*CardRecord, 0)
+	for page := 1; ; page++ {
+		tmp, errTwo, _ := uuc.repo.GetUserRecordByUserIdPage(ctx, &Pagination{PageNum: page, PageSize: exportPageSize}, userId)
+		if nil != errTwo {
+			return nil, errTwo
+		}
+
+		records = append(records, tmp...)
+		if exportPageSize > len(tmp) {
+			break
+		}
+	}
+
+	data, err := json.MarshalIndent(exportDocument(user, applications, cards, balances, rewards, withdrawals, records), "", "  ")
+	if nil != err {
+		return nil, err
+	}
+
+	return &ExportBundle{
+		Data:      data,
+		Signature: ed25519.Sign(key, data),
+		PublicKey: key.Public().(ed25519.PublicKey),
+	}, nil
+}
+
+func exportDocument(user *User, applications []*CardApplication, cards []*Card, balances []*Balance, rewards []*Reward, withdrawals []*Withdraw, records []*CardRecord) map[string]interface{} {
+	profile := map[string]interface{}{
+		"id":           user.ID,
+		"address":      user.Address,
+		"first_name":   user.FirstName,
+		"last_name":    user.LastName,
+		"email":        user.Email,
+		"country_code": user.CountryCode,
+		"phone":        user.Phone,
+		"city":         user.City,
+		"country":      user.Country,
+		"street":       user.Street,
+		"postal_code":  user.PostalCode,
+		"birth_date":   user.BirthDate,
+		"vip":          user.Vip,
+		"created_at":   user.CreatedAt,
+	}
+
+	applicationRows := make([]map[string]interface{}, 0, len(applications))
+	for _, v := range applications {
+		applicationRows = append(applicationRows, map[string]interface{}{
+			"id":                 v.ID,
+			"first_name":         v.FirstName,
+			"last_name":          v.LastName,
+			"email":              v.Email,
+			"country_code":       v.CountryCode,
+			"phone":              v.Phone,
+			"phone_country_code": v.PhoneCountryCode,
+			"city":               v.City,
+			"country":            v.Country,
+			"street":             v.Street,
+			"postal_code":        v.PostalCode,
+			"birth_date":         v.BirthDate,
+			"state":              v.State,
+			"id_card":            v.IdCard,
+			"gender":             v.Gender,
+			"status":             v.Status,
+			"screening_result":   v.ScreeningResult,
+			"created_at":         v.CreatedAt,
+		})
+	}
+
+	cardRows := make([]map[string]interface{}, 0, len(cards))
+	for _, v := range cards {
+		cardRows = append(cardRows, map[string]interface{}{
+			"id":         v.ID,
+			"card_type":  v.CardType,
+			"status":     v.Status,
+			"masked_pan": v.MaskedPan,
+			"created_at": v.CreatedAt,
+		})
+	}
+
+	balanceRows := make([]map[string]interface{}, 0, len(balances))
+	for _, v := range balances {
+		balanceRows = append(balanceRows, map[string]interface{}{
+			"currency": v.Currency,
+			"amount":   v.Amount,
+		})
+	}
+
+	rewardRows := make([]map[string]interface{}, 0, len(rewards))
+	for _, v := range rewards {
+		rewardRows = append(rewardRows, map[string]interface{}{
+			"id":         v.ID,
+			"amount":     v.Amount,
+			"reason":     v.Reason,
+			"address":    v.Address,
+			"one":        v.One,
+			"currency":   v.Currency,
+			"created_at": v.CreatedAt,
+		})
+	}
+
+	withdrawRows := make([]map[string]interface{}, 0, len(withdrawals))
+	for _, v := range withdrawals {
+		withdrawRows = append(withdrawRows, map[string]interface{}{
+			"id":         v.ID,
+			"amount":     v.Amount,
+			"rel_amount": v.RelAmount,
+			"status":     v.Status,
+			"address":    v.Address,
+			"currency":   v.Currency,
+			"chain":      v.Chain,
+			"created_at": v.CreatedAt,
+		})
+	}
+
+	recordRows := make([]map[string]interface{}, 0, len(records))
+	for _, v := range records {
+		recordRows = append(recordRows, map[string]interface{}{
+			"id":         v.ID,
+			"remark":     v.Remark,
+			"created_at": v.CreatedAt,
+		})
+	}
+
+	return map[string]interface{}{
+		"exported_at":       time.Now().UTC(),
+		"profile":           profile,
+		"card_applications": applicationRows,
+		"cards":             cardRows,
+		"balances":          balanceRows,
+		"rewards":           rewardRows,
+		"withdrawals":       withdrawRows,
+		"card_records":      recordRows,
+	}
+}
+
+func (uuc *UserUseCase) DataExport(ctx context.Context, userId uint64, key ed25519.PrivateKey) (*pb.DataExportReply, error) {
+	if nil == key {
+		return &pb.DataExportReply{Status: "暂不支持数据导出"}, nil
+	}
+
+	bundle, err := uuc.ExportPersonalData(ctx, userId, key)
+	if nil != err {
+		uuc.log.Errorw("msg", "导出用户数据错误", "err", err, "user_id", userId)
+		return &pb.DataExportReply{Status: "导出错误"}, nil
+	}
+
+	return &pb.DataExportReply{
+		Status:    "ok",
+		Data:      string(bundle.Data),
+		Signature: base64.StdEncoding.EncodeToString(bundle.Signature),
+		PublicKey: base64.StdEncoding.EncodeToString(bundle.PublicKey),
+	}, nil
+}
+
+func (uuc *UserUseCase) AccountErase(ctx context.Context, req *pb.AccountEraseRequest, userId uint64) (*pb.AccountEraseReply, error) {
+	if status := uuc.CheckTotp(ctx, userId, req.SendBody.TotpCode); "" != status {
+		return &pb.AccountEraseReply{Status: status}, nil
+	}
+
+	return &pb.AccountEraseReply{Status: uuc.EraseAccount(ctx, userId)}, nil
+}
+
+// AdminAccountErase admin 为校验通过的后台密钥名称，请求中的 operator 只作备注
+func (uuc *UserUseCase) AdminAccountErase(ctx context.Context, req *pb.AdminAccountEraseRequest, admin string) (*pb.AccountEraseReply, error) {
+	uuc.log.Infow("msg", "后台注销账户", "user_id", req.SendBody.UserId, "admin", admin, "operator", req.SendBody.Operator, "remark", req.SendBody.Remark)
+	return &pb.AccountEraseReply{Status: uuc.EraseAccount(ctx, req.SendBody.UserId)}, nil
+}
+
+// EraseAccount 余额为零、卡片都已注销且没有待审核的风控单时匿名化个人信息，返回 ok 或不能注销的原因
+func (uuc *UserUseCase) EraseAccount(ctx context.Context, userId uint64) string {
+	user, err := uuc.repo.GetUserById(userId)
+	if nil != err || nil == user {
+		return "用户不存在"
+	}
+
+	if 1 == user.IsDelete {
+		return "用户已删除"
+	}
+
+	cards, err := uuc.getUserCards(ctx, userId)
+	if nil != err {
+		return "查询错误"
+	}
+
+	for _, v := range cards {
+		if CardStatusClosed != v.Status {
+			return "请先注销全部卡片"
+		}
+	}
+
+	_, err, count := uuc.riskRepo.GetRiskRecordsPage(ctx, &Pagination{PageNum: 1, PageSize: 1}, RiskReview, userId)
+	if nil != err {
+		return "查询错误"
+	}
+
+	if 0 < count {
+		return "有审核中的提现或转账"
+	}
+
+	var status string
+	if err = uuc.lockedTx(ctx, []string{balanceLockKey(userId)}, func(ctx context.Context) error { // 事务
+		// 在锁内重新检查余额，避免与入账、提现并发
+		balances, errTwo := uuc.balanceRepo.GetBalancesByUserId(ctx, userId)
+		if nil != errTwo {
+			return errTwo
+		}
+
+		for _, v := range balances {
+			if eraseBalanceEpsilon < math.Abs(v.Amount) {
+				status = "请先提取全部余额"
+				return nil
+			}
+		}
+
+		return uuc.privacyRepo.ErasePersonalData(ctx, userId)
+	}); nil != err {
+		uuc.log.Errorw("msg", "注销账户错误", "err", err, "user_id", userId)
+		return "注销错误"
+	}
+
+	if "" != status {
+		return status
+	}
+
+	uuc.log.Infow("msg", "账户已注销", "user_id", userId)
+	return "ok"
+}
@@ -0,0 +1,489 @@
+package biz
+
+import (
+	pb "cardbinance/api/user/v1"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 风控结果，审核中的金额已从余额扣除
+const (
+	RiskAllow    = "allow"
+	RiskReview   = "review"
+	RiskDeny     = "deny"
+	RiskApproved = "approved" // 审核通过并已执行
+	RiskRejected = "rejected" // 审核拒绝并已退回
+)
+
+// 风控规则
+const (
+	RiskRuleSingleLimit   = "single_limit"
+	RiskRuleDailyLimit    = "daily_limit"
+	RiskRuleMonthlyLimit  = "monthly_limit"
+	RiskRuleReviewAmount  = "review_amount"
+	RiskRuleVelocity      = "velocity"
+	RiskRuleNewAccount    = "new_account"
+	RiskRuleNewAddress    = "new_address"
+	RiskRuleLargeTransfer = "large_transfer"
+)
+
+// KYC 等级
+const (
+	KycTierNone  uint64 = 0 // 未提交身份信息
+	KycTierBasic uint64 = 1 // 已提交开卡身份信息
+	KycTierCard  uint64 = 2 // 有可用卡片
+)
+
+var riskSeverity = map[string]int{RiskAllow: 0, RiskReview: 1, RiskDeny: 2}
+
+var riskRuleNames = map[string]string{
+	RiskRuleSingleLimit:   "超过单笔限额",
+	RiskRuleDailyLimit:    "超过24小时限额",
+	RiskRuleMonthlyLimit:  "超过30天限额",
+	RiskRuleReviewAmount:  "大额",
+	RiskRuleVelocity:      "操作频繁",
+	RiskRuleNewAccount:    "新注册账户",
+	RiskRuleNewAddress:    "首次提现到该地址",
+	RiskRuleLargeTransfer: "大额转账给无推荐关系的用户",
+}
+
+var riskOperationNames = map[string]string{
+	FeeOpWithdraw: "提现",
+	FeeOpAmountTo: "转账",
+}
+
+// RiskLimit 限额按 USD 等值计，稳定币按 1:1；0 不限制
+// 选取不超过用户等级的规则，KYC 等级高的优先，其次 VIP 级别高的优先
+type RiskLimit struct {
+	ID           uint64
+	Operation    string
+	Vip          uint64
+	KycTier      uint64
+	SingleMax    float64 // 单笔上限，超过拒绝
+	Daily        float64 // 近24小时累计上限，超过拒绝
+	Monthly      float64 // 近30天累计上限，超过拒绝
+	ReviewAmount float64 // 单笔达到该金额转人工审核
+}
+
+// 未配置 risk_limit 时的默认规则
+var defaultRiskLimits = []*RiskLimit{
+	{Operation: FeeOpWithdraw, KycTier: KycTierNone, Daily: 2000, Monthly: 10000, ReviewAmount: 1000},
+	{Operation: FeeOpWithdraw, KycTier: KycTierBasic, Daily: 10000, Monthly: 50000, ReviewAmount: 5000},
+	{Operation: FeeOpWithdraw, KycTier: KycTierCard, Daily: 50000, Monthly: 300000, ReviewAmount: 20000},
+	{Operation: FeeOpAmountTo, KycTier: KycTierNone, Daily: 2000, Monthly: 10000, ReviewAmount: 1000},
+	{Operation: FeeOpAmountTo, KycTier: KycTierBasic, Daily: 10000, Monthly: 50000, ReviewAmount: 5000},
+	{Operation: FeeOpAmountTo, KycTier: KycTierCard, Daily: 50000, Monthly: 300000, ReviewAmount: 20000},
+}
+
+// config 表中的风控参数，未配置时使用默认值
+var riskConfigDefaults = map[string]float64{
+	"risk_velocity_count":    5,    // 1小时内同类操作达到该次数后转人工审核
+	"risk_new_account_hours": 24,   // 注册不满该小时数的账户转人工审核
+	"risk_large_transfer":    5000, // 转账给没有推荐关系的用户达到该金额转人工审核
+}
+
+// RiskRecord 每次提现和转账的风控结果，status 为 review 的即人工审核队列
+type RiskRecord struct {
+	ID         uint64
+	UserId     uint64
+	Operation  string
+	Currency   string
+	Amount     float64
+	AmountRel  float64
+	Address    string // 提现地址或转账对象地址
+	Chain      string
+	ToUserId   uint64
+	Status     string
+	Reasons    string // 触发的规则，逗号分隔
+	Operator   string
+	Remark     string
+	CreatedAt  time.Time
+	ReviewedAt *time.Time
+}
+
+type RiskRepo interface {
+	GetRiskLimitsByOperation(ctx context.Context, operation string) ([]*RiskLimit, error)
+	// SumRiskAmount since 之后放行、审核中和审核通过的金额合计
+	SumRiskAmount(ctx context.Context, userId uint64, operation string, since time.Time) (float64, error)
+	// CountRiskRecords since 之后放行、审核中和审核通过的次数
+	CountRiskRecords(ctx context.Context, userId uint64, operation string, since time.Time) (int64, error)
+	// HasWithdrawAddress 是否提现到过该地址
+	HasWithdrawAddress(ctx context.Context, userId uint64, address string) (bool, error)
+	CreateRiskRecord(ctx context.Context, r *RiskRecord) (*RiskRecord, error)
+	// HoldRiskRecord 扣除金额并记录为审核中，需要在事务中调用
+	HoldRiskRecord(ctx context.Context, r *RiskRecord) (*RiskRecord, error)
+	GetRiskRecordById(ctx context.Context, id uint64) (*RiskRecord, error)
+	GetRiskRecordsPage(ctx context.Context, b *Pagination, status string, userId uint64) ([]*RiskRecord, error, int64)
+	// FinishRiskReview 审核中的记录改为 status 并退回冻结金额，需要在事务中调用
+	FinishRiskReview(ctx context.Context, r *RiskRecord, status, operator, remark string) error
+}
+
+// RiskOperation 待评估的提现或转账
+type RiskOperation struct {
+	Operation string
+	User      *User
+	ToUser    *User // 转账对象
+	Currency  string
+	Amount    float64
+	AmountRel float64
+	Address   string
+	Chain     string // 为空时为登录钱包地址
+}
+
+type RiskDecision struct {
+	Decision string
+	Reasons  []string
+}
+
+func (d *RiskDecision) add(decision, rule string) {
+	d.Reasons = append(d.Reasons, rule)
+	if riskSeverity[decision] > riskSeverity[d.Decision] {
+		d.Decision = decision
+	}
+}
+
+// Status 拒绝时返回给客户端的状态
+func (d *RiskDecision) Status() string {
+	names := make([]string, 0, len(d.Reasons))
+	for _, v := range d.Reasons {
+		switch v {
+		case RiskRuleSingleLimit, RiskRuleDailyLimit, RiskRuleMonthlyLimit:
+			names = append(names, riskRuleNames[v])
+		}
+	}
+
+	return "风控拒绝：" + strings.Join(names, "，")
+}
+
+// kycTier 按已提交的身份信息和卡片判断
+func (uuc *UserUseCase) kycTier(ctx context.Context, user *User) uint64 {
+	cards, err := uuc.cardRepo.GetCardsByUserId(ctx, user.ID)
+	if nil == err {
+		for _, v := range cards {
+			if v.Usable() {
+				return KycTierCard
+			}
+		}
+	}
+
+	if "" != user.IdCard && "" != user.FirstName {
+		return KycTierBasic
+	}
+
+	return KycTierNone
+}
+
+// getRiskLimit risk_limit 中没有适用的规则时使用默认规则
+func (uuc *UserUseCase) getRiskLimit(ctx context.Context, operation string, vip, kyc uint64) *RiskLimit {
+	limits, err := uuc.riskRepo.GetRiskLimitsByOperation(ctx, operation)
+	if nil != err {
+		uuc.log.Errorw("msg", "查询风控限额错误", "err", err, "operation", operation)
+	}
+
+	for _, candidates := range [][]*RiskLimit{limits, defaultRiskLimits} {
+		var res *RiskLimit
+		for _, v := range candidates {
+			if operation != v.Operation || v.Vip > vip || v.KycTier > kyc {
+				continue
+			}
+
+			if nil == res || v.KycTier > res.KycTier || (v.KycTier == res.KycTier && v.Vip > res.Vip) {
+				res = v
+			}
+		}
+
+		if nil != res {
+			return res
+		}
+	}
+
+	return &RiskLimit{Operation: operation}
+}
+
+func (uuc *UserUseCase) riskConfig() map[string]float64 {
+	res := make(map[string]float64, len(riskConfigDefaults))
+	keys := make([]string, 0, len(riskConfigDefaults))
+	for k, v := range riskConfigDefaults {
+		res[k] = v
+		keys = append(keys, k)
+	}
+
+	configs, _ := uuc.repo.GetConfigByKeys(keys...)
+	for _, v := range configs {
+		if tmp, err := strconv.ParseFloat(v.Value, 64); nil == err {
+			res[v.KeyName] = tmp
+		}
+	}
+
+	return res
+}
+
+// recommendRelated 一方是另一方的上级推荐人
+func (uuc *UserUseCase) recommendRelated(user, toUser *User) bool {
+	for _, pair := range [][2]*User{{user, toUser}, {toUser, user}} {
+		tmpRecommend, err := uuc.repo.GetUserRecommendByUserId(pair[0].ID)
+		if nil != err || nil == tmpRecommend {
+			continue
+		}
+
+		for _, v := range strings.Split(tmpRecommend.RecommendCode, "D") {
+			if tmp, _ := strconv.ParseUint(v, 10, 64); pair[1].ID == tmp {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// evaluateRisk 依次检查所有规则，结果取最严重的一个；需要在持有用户余额锁时调用，累计金额才准确
+func (uuc *UserUseCase) evaluateRisk(ctx context.Context, op *RiskOperation) (*RiskDecision, error) {
+	d := &RiskDecision{Decision: RiskAllow}
+	now := time.Now()
+	params := uuc.riskConfig()
+
+	limit := uuc.getRiskLimit(ctx, op.Operation, op.User.Vip, uuc.kycTier(ctx, op.User))
+	if 0 < limit.SingleMax && op.Amount > limit.SingleMax {
+		d.add(RiskDeny, RiskRuleSingleLimit)
+	}
+
+	if 0 < limit.Daily {
+		total, err := uuc.riskRepo.SumRiskAmount(ctx, op.User.ID, op.Operation, now.Add(-24*time.Hour))
+		if nil != err {
+			return nil, err
+		}
+
+		if total+op.Amount > limit.Daily {
+			d.add(RiskDeny, RiskRuleDailyLimit)
+		}
+	}
+
+	if 0 < limit.Monthly {
+		total, err := uuc.riskRepo.SumRiskAmount(ctx, op.User.ID, op.Operation, now.Add(-30*24*time.Hour))
+		if nil != err {
+			return nil, err
+		}
+
+		if total+op.Amount > limit.Monthly {
+			d.add(RiskDeny, RiskRuleMonthlyLimit)
+		}
+	}
+
+	if 0 < limit.ReviewAmount && op.Amount >= limit.ReviewAmount {
+		d.add(RiskReview, RiskRuleReviewAmount)
+	}
+
+	if velocity := params["risk_velocity_count"]; 0 < velocity {
+		count, err := uuc.riskRepo.CountRiskRecords(ctx, op.User.ID, op.Operation, now.Add(-time.Hour))
+		if nil != err {
+			return nil, err
+		}
+
+		if float64(count) >= velocity {
+			d.add(RiskReview, RiskRuleVelocity)
+		}
+	}
+
+	if hours := params["risk_new_account_hours"]; 0 < hours && now.Sub(op.User.CreatedAt) < time.Duration(hours*float64(time.Hour)) {
+		d.add(RiskReview, RiskRuleNewAccount)
+	}
+
+	// 登录钱包地址已通过签名验证，只检查地址簿中的地址
+	if FeeOpWithdraw == op.Operation && "" != op.Chain {
+		used, err := uuc.riskRepo.HasWithdrawAddress(ctx, op.User.ID, op.Address)
+		if nil != err {
+			return nil, err
+		}
+
+		if !used {
+			d.add(RiskReview, RiskRuleNewAddress)
+		}
+	}
+
+	if FeeOpAmountTo == op.Operation && nil != op.ToUser {
+		if large := params["risk_large_transfer"]; 0 < large && op.Amount >= large && !uuc.recommendRelated(op.User, op.ToUser) {
+			d.add(RiskReview, RiskRuleLargeTransfer)
+		}
+	}
+
+	return d, nil
+}
+
+// applyRiskDecision 记录风控结果，审核时冻结金额；返回审核中的记录
+func (uuc *UserUseCase) applyRiskDecision(ctx context.Context, op *RiskOperation, d *RiskDecision) (*RiskRecord, error) {
+	r := &RiskRecord{
+		UserId:    op.User.ID,
+		Operation: op.Operation,
+		Currency:  op.Currency,
+		Amount:    op.Amount,
+		AmountRel: op.AmountRel,
+		Address:   op.Address,
+		Chain:     op.Chain,
+		Status:    d.Decision,
+		Reasons:   strings.Join(d.Reasons, ","),
+	}
+	if nil != op.ToUser {
+		r.ToUserId = op.ToUser.ID
+	}
+
+	if RiskReview != d.Decision {
+		_, err := uuc.riskRepo.CreateRiskRecord(ctx, r)
+		return nil, err
+	}
+
+	return uuc.riskRepo.HoldRiskRecord(ctx, r)
+}
+
+// notifyRiskHold 冻结后通知用户
+func (uuc *UserUseCase) notifyRiskHold(ctx context.Context, r *RiskRecord) {
+	uuc.log.Warnw("msg", "风控转人工审核", "user_id", r.UserId, "id", r.ID, "operation", r.Operation, "reasons", r.Reasons)
+	uuc.notifier.Notify(ctx, r.UserId, NotifyEventRiskHold, map[string]interface{}{
+		"Operation": riskOperationNames[r.Operation],
+		"Amount":    strconv.FormatFloat(r.Amount, 'f', 2, 64),
+		"Currency":  r.Currency,
+	})
+}
+
+// ReviewRisk 人工审核，通过后按原请求执行，拒绝后退回冻结金额
+func (uuc *UserUseCase) ReviewRisk(ctx context.Context, id uint64, approve bool, operator, remark string) (*RiskRecord, error) {
+	r, err := uuc.riskRepo.GetRiskRecordById(ctx, id)
+	if nil != err {
+		return nil, err
+	}
+
+	if nil == r {
+		return nil, fmt.Errorf("风控记录不存在: %d", id)
+	}
+
+	if RiskReview != r.Status {
+		return r, fmt.Errorf("风控记录已处理: %d %s", id, r.Status)
+	}
+
+	status := RiskRejected
+	if approve {
+		status = RiskApproved
+	}
+
+	keys := []string{balanceLockKey(r.UserId)}
+	if 0 < r.ToUserId {
+		keys = append(keys, balanceLockKey(r.ToUserId))
+	}
+
+	if err = uuc.lockedTx(ctx, keys, func(ctx context.Context) error { // 事务
+		if err := uuc.riskRepo.FinishRiskReview(ctx, r, status, operator, remark); nil != err {
+			return err
+		}
+
+		if !approve {
+			return nil
+		}
+
+		switch r.Operation {
+		case FeeOpWithdraw:
+			return uuc.repo.Withdraw(ctx, r.UserId, r.Amount, r.AmountRel, r.Address, r.Chain, r.Currency)
+		case FeeOpAmountTo:
+			return uuc.repo.AmountTo(ctx, r.UserId, r.ToUserId, r.Address, r.Amount, r.AmountRel, r.Currency)
+		}
+
+		return fmt.Errorf("操作类型错误: %s", r.Operation)
+	}); nil != err {
+		return r, err
+	}
+
+	r.Status = status
+	uuc.publishBalance(ctx, r.UserId)
+
+	if !approve {
+		uuc.notifier.Notify(ctx, r.UserId, NotifyEventRiskReject, map[string]interface{}{
+			"Operation": riskOperationNames[r.Operation],
+			"Amount":    strconv.FormatFloat(r.Amount, 'f', 2, 64),
+			"Currency":  r.Currency,
+			"Remark":    remark,
+		})
+		return r, nil
+	}
+
+	if FeeOpAmountTo == r.Operation {
+		uuc.publishBalance(ctx, r.ToUserId)
+		return r, nil
+	}
+
+	uuc.notifier.Notify(ctx, r.UserId, NotifyEventWithdraw, map[string]interface{}{
+		"Amount":    strconv.FormatFloat(r.Amount, 'f', 2, 64),
+		"AmountRel": strconv.FormatFloat(r.AmountRel, 'f', 2, 64),
+		"Currency":  r.Currency,
+		"Address":   r.Address,
+	})
+	return r, nil
+}
+
+// RiskReview 后台审核
+func (uuc *UserUseCase) RiskReview(ctx context.Context, req *pb.RiskReviewRequest) (*pb.RiskReviewReply, error) {
+	operator := strings.TrimSpace(req.SendBody.Operator)
+	if "" == operator {
+		return &pb.RiskReviewReply{Status: "审核人不能为空"}, nil
+	}
+
+	if _, err := uuc.ReviewRisk(ctx, req.SendBody.Id, req.SendBody.Approve, operator, strings.TrimSpace(req.SendBody.Remark)); nil != err {
+		uuc.log.Errorw("msg", "风控审核错误", "err", err, "id", req.SendBody.Id)
+		return &pb.RiskReviewReply{Status: err.Error()}, nil
+	}
+
+	return &pb.RiskReviewReply{Status: "ok"}, nil
+}
+
+// RiskReviewList 后台查询，默认为待审核队列
+func (uuc *UserUseCase) RiskReviewList(ctx context.Context, req *pb.RiskReviewListRequest) (*pb.RiskReviewListReply, error) {
+	res := make([]*pb.RiskReviewListReply_List, 0)
+
+	status := req.Status
+	if "" == status {
+		status = RiskReview
+	}
+
+	if 1 > req.Page {
+		req.Page = 1
+	}
+
+	records, err, count := uuc.riskRepo.GetRiskRecordsPage(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 20,
+	}, status, req.UserId)
+	if nil != err {
+		return &pb.RiskReviewListReply{Status: "查询错误"}, nil
+	}
+
+	for _, v := range records {
+		reviewedAt := ""
+		if nil != v.ReviewedAt {
+			reviewedAt = v.ReviewedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05")
+		}
+
+		res = append(res, &pb.RiskReviewListReply_List{
+			Id:         v.ID,
+			UserId:     v.UserId,
+			Operation:  v.Operation,
+			Currency:   v.Currency,
+			Amount:     fmt.Sprintf("%.2f", v.Amount),
+			AmountRel:  fmt.Sprintf("%.2f", v.AmountRel),
+			Address:    v.Address,
+			Chain:      v.Chain,
+			ToUserId:   v.ToUserId,
+			Status:     v.Status,
+			Reasons:    v.Reasons,
+			Operator:   v.Operator,
+			Remark:     v.Remark,
+			CreatedAt:  v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			ReviewedAt: reviewedAt,
+		})
+	}
+
+	return &pb.RiskReviewListReply{
+		Status: "ok",
+		Count:  uint64(count),
+		List:   res,
+	}, nil
+}
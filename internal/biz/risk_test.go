@@ -0,0 +1,180 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// stubRiskUserRepo 配置和推荐关系
+type stubRiskUserRepo struct {
+	stubConfigRepo
+	recommends map[uint64]string
+}
+
+func (s *stubRiskUserRepo) GetUserRecommendByUserId(userId uint64) (*UserRecommend, error) {
+	if v, ok := s.recommends[userId]; ok {
+		return &UserRecommend{UserId: userId, RecommendCode: v}, nil
+	}
+
+	return nil, nil
+}
+
+type stubRiskCardRepo struct {
+	CardRepo
+	cards []*Card
+}
+
+func (s *stubRiskCardRepo) GetCardsByUserId(ctx context.Context, userId uint64) ([]*Card, error) {
+	return s.cards, nil
+}
+
+// stubRiskRepo daily、monthly 为近24小时和近30天的累计金额
+type stubRiskRepo struct {
+	RiskRepo
+	limits  []*RiskLimit
+	daily   float64
+	monthly float64
+	count   int64
+	used    bool
+	created []*RiskRecord
+	held    []*RiskRecord
+}
+
+func (s *stubRiskRepo) GetRiskLimitsByOperation(ctx context.Context, operation string) ([]*RiskLimit, error) {
+	return s.limits, nil
+}
+
+func (s *stubRiskRepo) SumRiskAmount(ctx context.Context, userId uint64, operation string, since time.Time) (float64, error) {
+	if time.Since(since) > 25*time.Hour {
+		return s.monthly, nil
+	}
+
+	return s.daily, nil
+}
+
+func (s *stubRiskRepo) CountRiskRecords(ctx context.Context, userId uint64, operation string, since time.Time) (int64, error) {
+	return s.count, nil
+}
+
+func (s *stubRiskRepo) HasWithdrawAddress(ctx context.Context, userId uint64, address string) (bool, error) {
+	return s.used, nil
+}
+
+func (s *stubRiskRepo) CreateRiskRecord(ctx context.Context, r *RiskRecord) (*RiskRecord, error) {
+	s.created = append(s.created, r)
+	return r, nil
+}
+
+func (s *stubRiskRepo) HoldRiskRecord(ctx context.Context, r *RiskRecord) (*RiskRecord, error) {
+	s.held = append(s.held, r)
+	return r, nil
+}
+
+func TestEvaluateRisk(t *testing.T) {
+	old := time.Now().Add(-30 * 24 * time.Hour)
+	user := &User{ID: 1, CreatedAt: old}
+	toUser := &User{ID: 2, CreatedAt: old}
+	usableCard := []*Card{{ID: 1, Status: CardStatusActive, IssuerCardId: "1234567890"}}
+
+	tests := []struct {
+		name        string
+		op          *RiskOperation
+		risk        stubRiskRepo
+		cards       []*Card
+		configs     map[string]string
+		recommends  map[uint64]string
+		wantResult  string
+		wantReasons []string
+	}{
+		{name: "small withdraw to login wallet", op: &RiskOperation{Operation: FeeOpWithdraw, User: user, Amount: 100}, wantResult: RiskAllow},
+		{name: "review amount", op: &RiskOperation{Operation: FeeOpWithdraw, User: user, Amount: 1000}, wantResult: RiskReview, wantReasons: []string{RiskRuleReviewAmount}},
+		{name: "daily limit", op: &RiskOperation{Operation: FeeOpWithdraw, User: user, Amount: 600}, risk: stubRiskRepo{daily: 1500, monthly: 1500}, wantResult: RiskDeny, wantReasons: []string{RiskRuleDailyLimit}},
+		{name: "monthly limit", op: &RiskOperation{Operation: FeeOpWithdraw, User: user, Amount: 300}, risk: stubRiskRepo{monthly: 9800}, wantResult: RiskDeny, wantReasons: []string{RiskRuleMonthlyLimit}},
+		{name: "deny outranks review", op: &RiskOperation{Operation: FeeOpWithdraw, User: user, Amount: 1500}, risk: stubRiskRepo{daily: 1000, monthly: 1000}, wantResult: RiskDeny, wantReasons: []string{RiskRuleDailyLimit, RiskRuleReviewAmount}},
+		{name: "configured single limit", op: &RiskOperation{Operation: FeeOpWithdraw, User: user, Amount: 200}, risk: stubRiskRepo{limits: []*RiskLimit{{Operation: FeeOpWithdraw, SingleMax: 100}}}, wantResult: RiskDeny, wantReasons: []string{RiskRuleSingleLimit}},
+		{name: "card tier raises limits", op: &RiskOperation{Operation: FeeOpWithdraw, User: user, Amount: 5000}, cards: usableCard, wantResult: RiskAllow},
+		{name: "velocity", op: &RiskOperation{Operation: FeeOpWithdraw, User: user, Amount: 100}, risk: stubRiskRepo{count: 5}, wantResult: RiskReview, wantReasons: []string{RiskRuleVelocity}},
+		{name: "velocity disabled by config", op: &RiskOperation{Operation: FeeOpWithdraw, User: user, Amount: 100}, risk: stubRiskRepo{count: 10}, configs: map[string]string{"risk_velocity_count": "0"}, wantResult: RiskAllow},
+		{name: "new account", op: &RiskOperation{Operation: FeeOpWithdraw, User: &User{ID: 1, CreatedAt: time.Now().Add(-time.Hour)}, Amount: 100}, wantResult: RiskReview, wantReasons: []string{RiskRuleNewAccount}},
+		{name: "new address", op: &RiskOperation{Operation: FeeOpWithdraw, User: user, Amount: 100, Address: "T1", Chain: "TRC20"}, wantResult: RiskReview, wantReasons: []string{RiskRuleNewAddress}},
+		{name: "used address", op: &RiskOperation{Operation: FeeOpWithdraw, User: user, Amount: 100, Address: "T1", Chain: "TRC20"}, risk: stubRiskRepo{used: true}, wantResult: RiskAllow},
+		{name: "large transfer to unrelated user", op: &RiskOperation{Operation: FeeOpAmountTo, User: user, ToUser: toUser, Amount: 5000}, cards: usableCard, wantResult: RiskReview, wantReasons: []string{RiskRuleLargeTransfer}},
+		{name: "large transfer to recommender", op: &RiskOperation{Operation: FeeOpAmountTo, User: user, ToUser: toUser, Amount: 5000}, cards: usableCard, recommends: map[uint64]string{1: "D2"}, wantResult: RiskAllow},
+		{name: "large transfer to invitee", op: &RiskOperation{Operation: FeeOpAmountTo, User: user, ToUser: toUser, Amount: 5000}, cards: usableCard, recommends: map[uint64]string{2: "D5D1"}, wantResult: RiskAllow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			risk := tt.risk
+			uuc := &UserUseCase{
+				repo:     &stubRiskUserRepo{stubConfigRepo: stubConfigRepo{configs: tt.configs}, recommends: tt.recommends},
+				cardRepo: &stubRiskCardRepo{cards: tt.cards},
+				riskRepo: &risk,
+				log:      log.NewHelper(log.DefaultLogger),
+			}
+
+			d, err := uuc.evaluateRisk(context.Background(), tt.op)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantResult != d.Decision || !reflect.DeepEqual(tt.wantReasons, d.Reasons) {
+				t.Errorf("evaluateRisk() = %s %v, want %s %v", d.Decision, d.Reasons, tt.wantResult, tt.wantReasons)
+			}
+		})
+	}
+}
+
+func TestApplyRiskDecision(t *testing.T) {
+	op := &RiskOperation{Operation: FeeOpAmountTo, User: &User{ID: 1}, ToUser: &User{ID: 2}, Currency: "USDT", Amount: 5000}
+
+	tests := []struct {
+		name     string
+		decision *RiskDecision
+		wantHeld bool
+	}{
+		{name: "allow is recorded", decision: &RiskDecision{Decision: RiskAllow}},
+		{name: "deny is recorded", decision: &RiskDecision{Decision: RiskDeny, Reasons: []string{RiskRuleDailyLimit}}},
+		{name: "review holds the amount", decision: &RiskDecision{Decision: RiskReview, Reasons: []string{RiskRuleLargeTransfer, RiskRuleVelocity}}, wantHeld: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			risk := &stubRiskRepo{}
+			uuc := &UserUseCase{riskRepo: risk, log: log.NewHelper(log.DefaultLogger)}
+
+			r, err := uuc.applyRiskDecision(context.Background(), op, tt.decision)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantHeld != (nil != r) || tt.wantHeld != (1 == len(risk.held)) || tt.wantHeld == (1 == len(risk.created)) {
+				t.Fatalf("applyRiskDecision() held %d created %d, want held %v", len(risk.held), len(risk.created), tt.wantHeld)
+			}
+
+			stored := append(risk.held, risk.created...)[0]
+			if tt.decision.Decision != stored.Status || 2 != stored.ToUserId || 5000 != stored.Amount {
+				t.Errorf("stored record %+v", stored)
+			}
+		})
+	}
+}
+
+func TestRiskDecisionStatus(t *testing.T) {
+	d := &RiskDecision{Decision: RiskAllow}
+	d.add(RiskReview, RiskRuleReviewAmount)
+	d.add(RiskDeny, RiskRuleDailyLimit)
+	d.add(RiskReview, RiskRuleVelocity)
+
+	if RiskDeny != d.Decision {
+		t.Errorf("Decision = %s, want %s", d.Decision, RiskDeny)
+	}
+
+	// 只返回限额规则，不暴露审核规则
+	if want := "风控拒绝：超过24小时限额"; want != d.Status() {
+		t.Errorf("Status() = %s, want %s", d.Status(), want)
+	}
+}
@@ -0,0 +1,174 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+const defaultJobTimeout = 5 * time.Minute
+
+// Job 定时任务，Spec 为 cron 表达式，也可以写 @every 1m
+type Job struct {
+	Name    string
+	Spec    string
+	Local   bool // 每个实例都执行，例如刷新进程内缓存；否则只在主节点执行
+	Timeout time.Duration
+	Run     func(ctx context.Context) error
+}
+
+// JobRun 任务最近一次执行结果，每个任务一条
+type JobRun struct {
+	Name      string
+	Owner     string
+	LastRunAt time.Time
+	Duration  time.Duration
+	Error     string
+	Runs      uint64
+	Failures  uint64
+}
+
+type leaderCheckKey struct{}
+
+// stillLeader 主节点任务分页执行时在每页之间检查，失去主节点身份后停止；不在定时任务中调用时返回 true
+func stillLeader(ctx context.Context) bool {
+	if isLeader, ok := ctx.Value(leaderCheckKey{}).(func() bool); ok {
+		return isLeader()
+	}
+
+	return true
+}
+
+type SchedulerRepo interface {
+	// AcquireLeader 获取主节点租约，owner 已持有时续期
+	AcquireLeader(ctx context.Context, owner string, ttl time.Duration) (bool, error)
+	ReleaseLeader(ctx context.Context, owner string) error
+	// SaveJobRun 覆盖最近一次结果并累加执行和失败次数
+	SaveJobRun(ctx context.Context, run *JobRun) error
+	GetJobRuns(ctx context.Context) ([]*JobRun, error)
+}
+
+type SchedulerUseCase struct {
+	repo   SchedulerRepo
+	uuc    *UserUseCase
+	owner  string
+	leader atomic.Bool
+	log    *log.Helper
+}
+
+func NewSchedulerUseCase(repo SchedulerRepo, uuc *UserUseCase, logger log.Logger) *SchedulerUseCase {
+	host, _ := os.Hostname()
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+
+	return &SchedulerUseCase{
+		repo:  repo,
+		uuc:   uuc,
+		owner: fmt.Sprintf("%s-%d-%s", host, os.Getpid(), hex.EncodeToString(b)),
+		log:   log.NewHelper(logger),
+	}
+}
+
+// Jobs 默认任务，cron 表达式可以在配置 scheduler.jobs 中覆盖
+func (s *SchedulerUseCase) Jobs() []*Job {
+	return []*Job{
+		{Name: "open_card", Spec: "@every 1m", Run: s.uuc.ProcessOpenCardUsers},
+		// token 缓存在进程内，每个实例各自刷新
+		{Name: "interlace_token", Spec: "@every 5m", Local: true, Timeout: 30 * time.Second, Run: s.uuc.interlace.RefreshAccessToken},
+		{Name: "sync_transactions", Spec: "@every 5m", Run: s.uuc.SyncCardTransactions},
+		{Name: "expire_stale", Spec: "@every 1m", Run: s.uuc.ExpireStale},
+		{Name: "notify_dispatch", Spec: "@every 30s", Run: s.uuc.notifier.Dispatch},
+		{Name: "notify_rewards", Spec: "@every 1m", Run: s.uuc.notifier.NotifyRewards},
+		{Name: "reencrypt_personal_data", Spec: "@every 1h", Timeout: 30 * time.Minute, Run: s.uuc.ReencryptPersonalData},
+	}
+}
+
+// Owner 本实例在选主中的标识
+func (s *SchedulerUseCase) Owner() string {
+	return s.owner
+}
+
+// IsLeader .
+func (s *SchedulerUseCase) IsLeader() bool {
+	return s.leader.Load()
+}
+
+// Campaign 获取或续期主节点租约，Redis 不可用时放弃主节点身份，避免多个实例同时执行
+func (s *SchedulerUseCase) Campaign(ctx context.Context, ttl time.Duration) bool {
+	ok, err := s.repo.AcquireLeader(ctx, s.owner, ttl)
+	if nil != err {
+		s.log.Errorw("msg", "选主失败", "err", err, "owner", s.owner)
+		ok = false
+	}
+
+	if s.leader.Swap(ok) != ok {
+		s.log.Infow("msg", "主节点变更", "owner", s.owner, "leader", ok)
+	}
+
+	return ok
+}
+
+// Resign 退出时释放租约，其他实例无需等待过期
+func (s *SchedulerUseCase) Resign(ctx context.Context) {
+	if !s.leader.Swap(false) {
+		return
+	}
+
+	if err := s.repo.ReleaseLeader(ctx, s.owner); nil != err {
+		s.log.Errorw("msg", "释放主节点失败", "err", err, "owner", s.owner)
+	}
+}
+
+// RunJob 执行一次任务并记录耗时和错误，非主节点跳过非 Local 任务
+func (s *SchedulerUseCase) RunJob(ctx context.Context, job *Job) {
+	if !job.Local && !s.IsLeader() {
+		return
+	}
+
+	timeout := job.Timeout
+	if 0 >= timeout {
+		timeout = defaultJobTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if !job.Local {
+		ctx = context.WithValue(ctx, leaderCheckKey{}, s.IsLeader)
+	}
+
+	run := &JobRun{
+		Name:      job.Name,
+		Owner:     s.owner,
+		LastRunAt: time.Now(),
+	}
+
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); nil != r {
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+
+		return job.Run(ctx)
+	}()
+
+	run.Duration = time.Since(run.LastRunAt)
+	if nil != err {
+		run.Error = err.Error()
+		s.log.Errorw("msg", "定时任务失败", "err", err, "job", job.Name, "duration", run.Duration.String())
+	}
+
+	if errSave := s.repo.SaveJobRun(context.Background(), run); nil != errSave {
+		s.log.Errorw("msg", "定时任务记录失败", "err", errSave, "job", job.Name)
+	}
+}
+
+// JobRuns 各任务最近一次执行结果
+func (s *SchedulerUseCase) JobRuns(ctx context.Context) ([]*JobRun, error) {
+	return s.repo.GetJobRuns(ctx)
+}
@@ -0,0 +1,326 @@
+package biz
+
+import (
+	"cardbinance/internal/pkg/watchlist"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 名单类型
+const (
+	WatchlistSanctions = "sanctions" // 制裁名单，高度相似时直接拒绝
+	WatchlistPep       = "pep"       // 政治公众人物，只转人工审核
+)
+
+// 实体卡申请的筛查结果
+const (
+	ScreeningClear    = "clear"    // 未命中
+	ScreeningReview   = "review"   // 疑似命中，等待合规审核
+	ScreeningBlocked  = "blocked"  // 命中制裁名单，拒绝申请
+	ScreeningCleared  = "cleared"  // 人工审核排除
+	ScreeningRejected = "rejected" // 人工审核确认命中
+)
+
+// config 表可覆盖的筛查参数
+var screeningConfigDefaults = map[string]float64{
+	"screening_block_score":  0.95, // 制裁名单相似度达到该值且国家不矛盾时拒绝
+	"screening_review_score": 0.85, // 任一名单相似度达到该值转人工审核
+}
+
+// Watchlist 导入的名单，同名名单重新导入时整体替换
+type Watchlist struct {
+	ID        uint64
+	Name      string
+	Kind      string
+	Version   string
+	Entries   int64
+	UpdatedAt time.Time
+}
+
+type WatchlistEntry struct {
+	ID      uint64
+	EntryId string // 名单中的编号
+	Name    string
+	Aliases []string
+	Country string
+}
+
+// Screening 每次实体卡申请的筛查记录，拒绝的申请没有卡片
+type Screening struct {
+	ID             uint64
+	UserId         uint64
+	CardId         uint64
+	Name           string
+	Country        string
+	Result         string
+	Score          float64
+	ListVersion    string // 筛查时各名单的版本，name@version 逗号分隔
+	MatchedList    string
+	MatchedEntryId string
+	MatchedName    string
+	Fee            float64 // 已扣的开卡费，审核拒绝时退回
+	Refunded       bool
+	CreatedAt      time.Time
+}
+
+type ScreeningRepo interface {
+	GetWatchlists(ctx context.Context) ([]*Watchlist, error)
+	GetWatchlistEntries(ctx context.Context, watchlistId uint64) ([]*WatchlistEntry, error)
+	// ImportWatchlist 按名称替换名单及全部条目，需要在事务中调用
+	ImportWatchlist(ctx context.Context, w *Watchlist, entries []*WatchlistEntry) (*Watchlist, error)
+	CreateScreening(ctx context.Context, s *Screening) (*Screening, error)
+	GetScreeningByCardId(ctx context.Context, cardId uint64) (*Screening, error)
+	// UpdateCardTwoScreening 记录在用户最近一次实体卡申请上，需要在事务中调用
+	UpdateCardTwoScreening(ctx context.Context, userId uint64, result, listVersion string) error
+	// RefundScreeningFee 退回开卡费，已退过或没有扣费时返回 false，需要在事务中调用
+	RefundScreeningFee(ctx context.Context, s *Screening) (bool, error)
+}
+
+// watchlistCache 名单条目缓存在内存中，名单重新导入后按版本重新加载
+type watchlistCache struct {
+	mu        sync.Mutex
+	signature string
+	lists     []*Watchlist
+	entries   map[uint64][]*WatchlistEntry
+}
+
+// loadWatchlists 返回全部名单和条目，版本未变时使用缓存
+func (uuc *UserUseCase) loadWatchlists(ctx context.Context) ([]*Watchlist, map[uint64][]*WatchlistEntry, error) {
+	lists, err := uuc.screeningRepo.GetWatchlists(ctx)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	parts := make([]string, 0, len(lists))
+	for _, v := range lists {
+		parts = append(parts, strconv.FormatUint(v.ID, 10)+"@"+v.Version+"@"+strconv.FormatInt(v.UpdatedAt.UnixNano(), 10))
+	}
+	signature := strings.Join(parts, ",")
+
+	c := uuc.watchlists
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if nil != c.entries && signature == c.signature {
+		return c.lists, c.entries, nil
+	}
+
+	entries := make(map[uint64][]*WatchlistEntry, len(lists))
+	for _, v := range lists {
+		tmp, errTwo := uuc.screeningRepo.GetWatchlistEntries(ctx, v.ID)
+		if nil != errTwo {
+			return nil, nil, errTwo
+		}
+		entries[v.ID] = tmp
+	}
+
+	c.signature, c.lists, c.entries = signature, lists, entries
+	return lists, entries, nil
+}
+
+func (uuc *UserUseCase) screeningConfig() map[string]float64 {
+	res := make(map[string]float64, len(screeningConfigDefaults))
+	keys := make([]string, 0, len(screeningConfigDefaults))
+	for k, v := range screeningConfigDefaults {
+		res[k] = v
+		keys = append(keys, k)
+	}
+
+	configs, _ := uuc.repo.GetConfigByKeys(keys...)
+	for _, v := range configs {
+		if tmp, err := strconv.ParseFloat(v.Value, 64); nil == err {
+			res[v.KeyName] = tmp
+		}
+	}
+
+	return res
+}
+
+func screeningSeverity(result string) int {
+	switch result {
+	case ScreeningBlocked:
+		return 2
+	case ScreeningReview:
+		return 1
+	}
+
+	return 0
+}
+
+// countryConflict 双方统一为 ISO 3166-1 alpha-2 后都能识别且不同；任一方未填写或不能识别时不能排除
+func countryConflict(a, b string) bool {
+	a, b = watchlist.Country(a), watchlist.Country(b)
+	return "" != a && "" != b && a != b
+}
+
+func entryScore(name string, e *WatchlistEntry) float64 {
+	score := watchlist.Similarity(name, e.Name)
+	for _, v := range e.Aliases {
+		if tmp := watchlist.Similarity(name, v); tmp > score {
+			score = tmp
+		}
+	}
+
+	return score
+}
+
+// screenApplicant 姓名与全部名单模糊匹配，取最严重的结果；制裁名单国家不一致时只转人工审核
+func (uuc *UserUseCase) screenApplicant(ctx context.Context, userId uint64, name, country string) (*Screening, error) {
+	lists, entries, err := uuc.loadWatchlists(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	versions := make([]string, 0, len(lists))
+	for _, v := range lists {
+		versions = append(versions, v.Name+"@"+v.Version)
+	}
+	sort.Strings(versions)
+
+	s := &Screening{
+		UserId:      userId,
+		Name:        name,
+		Country:     country,
+		Result:      ScreeningClear,
+		ListVersion: strings.Join(versions, ","),
+	}
+
+	cfg := uuc.screeningConfig()
+	for _, l := range lists {
+		for _, e := range entries[l.ID] {
+			score := entryScore(name, e)
+			if score < cfg["screening_review_score"] {
+				continue
+			}
+
+			result := ScreeningReview
+			if WatchlistSanctions == l.Kind && score >= cfg["screening_block_score"] && !countryConflict(country, e.Country) {
+				result = ScreeningBlocked
+			}
+
+			if screeningSeverity(result) < screeningSeverity(s.Result) ||
+				(screeningSeverity(result) == screeningSeverity(s.Result) && score <= s.Score) {
+				continue
+			}
+
+			s.Result = result
+			s.Score = score
+			s.MatchedList = l.Name
+			s.MatchedEntryId = e.EntryId
+			s.MatchedName = e.Name
+		}
+	}
+
+	if ScreeningClear != s.Result {
+		uuc.log.Warnw("msg", "实体卡申请命中名单", "user_id", userId, "result", s.Result, "score", s.Score, "list", s.MatchedList, "entry_id", s.MatchedEntryId)
+	}
+
+	return s, nil
+}
+
+// ImportWatchlist 导入名单文件解析后的条目，kind 为 sanctions 或 pep
+func (uuc *UserUseCase) ImportWatchlist(ctx context.Context, name, kind, version string, entries []*watchlist.Entry) (*Watchlist, error) {
+	if WatchlistSanctions != kind && WatchlistPep != kind {
+		return nil, fmt.Errorf("名单类型错误: %s", kind)
+	}
+
+	if "" == name || "" == version {
+		return nil, fmt.Errorf("名单名称和版本不能为空")
+	}
+
+	// 空文件多半是格式错误，避免误清空名单
+	if 0 == len(entries) {
+		return nil, fmt.Errorf("名单为空: %s", name)
+	}
+
+	res := make([]*WatchlistEntry, 0, len(entries))
+	for _, v := range entries {
+		res = append(res, &WatchlistEntry{
+			EntryId: v.ID,
+			Name:    v.Name,
+			Aliases: v.Aliases,
+			Country: v.Country,
+		})
+	}
+
+	var w *Watchlist
+	err := uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		var err error
+		w, err = uuc.screeningRepo.ImportWatchlist(ctx, &Watchlist{
+			Name:    name,
+			Kind:    kind,
+			Version: version,
+			Entries: int64(len(res)),
+		}, res)
+		return err
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// ReviewScreening 合规审核疑似命中的实体卡申请，排除后进入发卡，确认命中则注销卡片并退回开卡费
+func (uuc *UserUseCase) ReviewScreening(ctx context.Context, cardId uint64, approve bool) (*Card, error) {
+	card, err := uuc.cardRepo.GetCardById(ctx, cardId)
+	if nil != err {
+		return nil, err
+	}
+
+	if nil == card {
+		return nil, fmt.Errorf("卡片不存在: %d", cardId)
+	}
+
+	if CardTypePhysical != card.CardType || CardStatusReview != card.Status {
+		return card, fmt.Errorf("卡片不在合规审核中: %d", cardId)
+	}
+
+	s, err := uuc.screeningRepo.GetScreeningByCardId(ctx, cardId)
+	if nil != err {
+		return card, err
+	}
+
+	listVersion := ""
+	if nil != s {
+		listVersion = s.ListVersion
+	}
+
+	status, result := CardStatusPending, ScreeningCleared
+	if !approve {
+		status, result = CardStatusClosed, ScreeningRejected
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		if err := uuc.cardRepo.UpdateCardStatus(ctx, card.ID, status); nil != err {
+			return err
+		}
+
+		if !approve && nil != s {
+			refunded, err := uuc.screeningRepo.RefundScreeningFee(ctx, s)
+			if nil != err {
+				return err
+			}
+
+			if refunded {
+				uuc.log.Infow("msg", "实体卡申请审核拒绝，退回开卡费", "user_id", card.UserId, "card_id", card.ID, "fee", s.Fee)
+			}
+		}
+
+		return uuc.screeningRepo.UpdateCardTwoScreening(ctx, card.UserId, result, listVersion)
+	}); nil != err {
+		return card, err
+	}
+
+	card.Status = status
+	uuc.publishCardStatus(ctx, card, status)
+	if !approve {
+		uuc.publishBalance(ctx, card.UserId)
+	}
+	return card, nil
+}
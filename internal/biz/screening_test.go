@@ -0,0 +1,30 @@
+package biz
+
+import "testing"
+
+func TestCountryConflict(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want bool
+	}{
+		{name: "same alpha-2", a: "RU", b: "ru", want: false},
+		{name: "alpha-2 and alpha-3", a: "RU", b: "RUS", want: false},
+		{name: "alpha-2 and name", a: "RU", b: "Russian Federation", want: false},
+		{name: "common name", a: "Russia", b: "RU", want: false},
+		{name: "name with accents", a: "Côte d'Ivoire", b: "CIV", want: false},
+		{name: "different countries", a: "RU", b: "Ukraine", want: true},
+		{name: "uk alias", a: "UK", b: "GBR", want: false},
+		{name: "empty side", a: "", b: "RU", want: false},
+		{name: "unknown side", a: "Narnia", b: "RU", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := countryConflict(tt.a, tt.b); got != tt.want {
+				t.Errorf("countryConflict(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,316 @@
+package biz
+
+import (
+	"cardbinance/internal/pkg/interlace"
+	"cardbinance/internal/pkg/watchlist"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// 超过这个时间仍为 pending 的划转视为进程中断，允许重新发起
+const cardTransferStuckAfter = 10 * time.Minute
+
+// SupportUser 客服查询用户的结果
+type SupportUser struct {
+	User     *User      `json:"user"`
+	Cards    []*Card    `json:"cards"`
+	Balances []*Balance `json:"balances"`
+}
+
+// SupportLookup 按地址查询用户、卡片和余额
+func (uuc *UserUseCase) SupportLookup(ctx context.Context, address string) (*SupportUser, error) {
+	user, err := uuc.repo.GetUserByAddress(address)
+	if nil != err {
+		return nil, err
+	}
+
+	if nil == user {
+		return nil, fmt.Errorf("用户不存在: %s", address)
+	}
+
+	cards, err := uuc.cardRepo.GetCardsByUserId(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	balances, err := uuc.balanceRepo.GetBalancesByUserId(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	return &SupportUser{User: user, Cards: cards, Balances: balances}, nil
+}
+
+// SupportCardSummary 卡片在发卡方的余额和消费统计
+func (uuc *UserUseCase) SupportCardSummary(ctx context.Context, cardId uint64) (*Card, *interlace.CardSummary, error) {
+	card, err := uuc.cardRepo.GetCardById(ctx, cardId)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	if nil == card {
+		return nil, nil, fmt.Errorf("卡片不存在: %d", cardId)
+	}
+
+	if "" == card.IssuerCardId {
+		return card, nil, nil
+	}
+
+	summary, err := uuc.interlace.CardSummary(ctx, card.IssuerCardId)
+	if nil != err {
+		return card, nil, err
+	}
+
+	return card, summary, nil
+}
+
+// SupportRewards 用户最近的流水
+func (uuc *UserUseCase) SupportRewards(ctx context.Context, address string, limit int) ([]*Reward, error) {
+	user, err := uuc.repo.GetUserByAddress(address)
+	if nil != err {
+		return nil, err
+	}
+
+	if nil == user {
+		return nil, fmt.Errorf("用户不存在: %s", address)
+	}
+
+	rewards, err, _ := uuc.repo.GetUserRewardByUserIdPage(ctx, &Pagination{PageNum: 1, PageSize: limit}, user.ID, 0, 0)
+	if nil != err {
+		return nil, err
+	}
+
+	return rewards, nil
+}
+
+// SupportFreezeCard 冻结卡片，operator 为执行的客服
+func (uuc *UserUseCase) SupportFreezeCard(ctx context.Context, cardId uint64, operator string) error {
+	card, err := uuc.cardRepo.GetCardById(ctx, cardId)
+	if nil != err {
+		return err
+	}
+
+	if nil == card {
+		return fmt.Errorf("卡片不存在: %d", cardId)
+	}
+
+	if CardStatusFrozen == card.Status {
+		return fmt.Errorf("卡片已冻结: %d", cardId)
+	}
+
+	err = uuc.supportAudited(ctx, operator, "cardctl.freeze", card.UserId, map[string]interface{}{"card_id": cardId}, func() error {
+		// 先冻结发卡方的卡片，失败时本地状态不变
+		if "" != card.IssuerCardId {
+			if _, err := uuc.interlace.FreezeCard(ctx, card.IssuerCardId); nil != err {
+				return err
+			}
+		}
+
+		return uuc.cardRepo.UpdateCardStatus(ctx, card.ID, CardStatusFrozen)
+	})
+	if nil != err {
+		return err
+	}
+
+	uuc.publishCardStatus(ctx, card, CardStatusFrozen)
+	uuc.notifyCard(ctx, NotifyEventCardFrozen, card, nil)
+	return nil
+}
+
+// SupportRedriveTransfer 以同一 clientTransactionId 重新向发卡方发起入账，发卡方按该 id 去重
+func (uuc *UserUseCase) SupportRedriveTransfer(ctx context.Context, orderId string, operator string) (*CardTransfer, error) {
+	transfer, err := uuc.cardRepo.GetCardTransferByOrderId(ctx, orderId)
+	if nil != err {
+		return nil, err
+	}
+
+	if nil == transfer {
+		return nil, fmt.Errorf("划转记录不存在: %s", orderId)
+	}
+
+	switch transfer.Status {
+	case CardTransferSuccess:
+		return transfer, fmt.Errorf("划转已入账: %s", orderId)
+	case CardTransferPending:
+		if time.Since(transfer.UpdatedAt) < cardTransferStuckAfter {
+			return transfer, fmt.Errorf("划转处理中，%s 后再重试: %s", cardTransferStuckAfter, orderId)
+		}
+	}
+
+	card, err := uuc.cardRepo.GetCardById(ctx, transfer.CardId)
+	if nil != err {
+		return transfer, err
+	}
+
+	if nil == card || !card.Usable() {
+		return transfer, fmt.Errorf("卡片不可用: %d", transfer.CardId)
+	}
+
+	err = uuc.supportAudited(ctx, operator, "cardctl.redrive", transfer.UserId, map[string]interface{}{"order_id": orderId}, func() error {
+		return uuc.cardTransferIn(ctx, transfer, card)
+	})
+
+	if tmp, errTwo := uuc.cardRepo.GetCardTransferByOrderId(ctx, orderId); nil == errTwo && nil != tmp {
+		transfer = tmp
+	}
+
+	return transfer, err
+}
+
+// SupportRiskReviews 待人工审核的风控记录，最早的在前
+func (uuc *UserUseCase) SupportRiskReviews(ctx context.Context, limit int) ([]*RiskRecord, error) {
+	records, err, _ := uuc.riskRepo.GetRiskRecordsPage(ctx, &Pagination{PageNum: 1, PageSize: limit}, RiskReview, 0)
+	if nil != err {
+		return nil, err
+	}
+
+	for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+		records[i], records[j] = records[j], records[i]
+	}
+
+	return records, nil
+}
+
+// SupportReviewRisk 审核风控冻结的提现或转账
+func (uuc *UserUseCase) SupportReviewRisk(ctx context.Context, id uint64, approve bool, operator, remark string) (*RiskRecord, error) {
+	var (
+		r      *RiskRecord
+		target uint64
+	)
+	if tmp, err := uuc.riskRepo.GetRiskRecordById(ctx, id); nil == err && nil != tmp {
+		target = tmp.UserId
+	}
+
+	operation := "cardctl.reject"
+	if approve {
+		operation = "cardctl.approve"
+	}
+
+	err := uuc.supportAudited(ctx, operator, operation, target, map[string]interface{}{"id": id, "remark": remark}, func() error {
+		var err error
+		r, err = uuc.ReviewRisk(ctx, id, approve, operator, remark)
+		return err
+	})
+
+	return r, err
+}
+
+// SupportImportWatchlist 导入制裁或 PEP 名单
+func (uuc *UserUseCase) SupportImportWatchlist(ctx context.Context, name, kind, version string, entries []*watchlist.Entry, operator string) (*Watchlist, error) {
+	var w *Watchlist
+	err := uuc.supportAudited(ctx, operator, "cardctl.screening-import", 0, map[string]interface{}{"name": name, "kind": kind, "version": version, "entries": len(entries)}, func() error {
+		var err error
+		w, err = uuc.ImportWatchlist(ctx, name, kind, version, entries)
+		return err
+	})
+
+	return w, err
+}
+
+// SupportScreeningReviews 合规审核中的实体卡申请及命中的名单条目，最早的在前
+func (uuc *UserUseCase) SupportScreeningReviews(ctx context.Context, limit int) ([]*Screening, error) {
+	cards, err := uuc.cardRepo.GetCardsByStatus(ctx, CardStatusReview)
+	if nil != err {
+		return nil, err
+	}
+
+	res := make([]*Screening, 0)
+	for _, card := range cards {
+		if limit <= len(res) {
+			break
+		}
+
+		s, err := uuc.screeningRepo.GetScreeningByCardId(ctx, card.ID)
+		if nil != err {
+			return nil, err
+		}
+
+		if nil == s {
+			s = &Screening{UserId: card.UserId, CardId: card.ID, Result: ScreeningReview, CreatedAt: card.CreatedAt}
+		}
+		res = append(res, s)
+	}
+
+	return res, nil
+}
+
+// SupportReviewScreening 合规审核实体卡申请
+func (uuc *UserUseCase) SupportReviewScreening(ctx context.Context, cardId uint64, approve bool, operator string) (*Card, error) {
+	var (
+		card   *Card
+		target uint64
+	)
+	if tmp, err := uuc.cardRepo.GetCardById(ctx, cardId); nil == err && nil != tmp {
+		target = tmp.UserId
+	}
+
+	operation := "cardctl.screening-reject"
+	if approve {
+		operation = "cardctl.screening-clear"
+	}
+
+	err := uuc.supportAudited(ctx, operator, operation, target, map[string]interface{}{"card_id": cardId}, func() error {
+		var err error
+		card, err = uuc.ReviewScreening(ctx, cardId, approve)
+		return err
+	})
+
+	return card, err
+}
+
+// SupportReencrypt 立即执行一次个人信息重新加密，返回各表更新的行数
+func (uuc *UserUseCase) SupportReencrypt(ctx context.Context, operator string) (map[string]int, error) {
+	var res map[string]int
+	err := uuc.supportAudited(ctx, operator, "cardctl.reencrypt", 0, map[string]interface{}{}, func() error {
+		var err error
+		res, err = uuc.reencryptPersonalData(ctx)
+		return err
+	})
+
+	return res, err
+}
+
+// supportAudited 命令行操作的审计，没有登录用户，操作人记在 Actor 和 UserAgent 中
+func (uuc *UserUseCase) supportAudited(ctx context.Context, operator, operation string, targetId uint64, args interface{}, fn func() error) error {
+	host, _ := os.Hostname()
+	entry := &AuditLog{
+		TargetId:  targetId,
+		Actor:     "cardctl/" + operator,
+		Operation: operation,
+		Ip:        host,
+		UserAgent: "cardctl/" + operator,
+	}
+
+	if b, err := json.Marshal(args); nil == err {
+		entry.Request = string(b)
+	}
+	entry.Before = uuc.auditSnapshot(ctx, targetId)
+
+	err := fn()
+	entry.Result = "ok"
+	if nil != err {
+		entry.Result = err.Error()
+	}
+	entry.After = uuc.auditSnapshot(ctx, targetId)
+
+	if errAudit := uuc.appendAuditLog(ctx, entry); nil != errAudit {
+		uuc.log.Errorw("msg", "审计日志写入失败", "err", errAudit, "user_id", targetId, "operation", operation)
+	}
+
+	return err
+}
+
+// SupportMigrateCards 迁移 0002 之后仍在旧字段上的卡片，返回迁移的用户数
+func (uuc *UserUseCase) SupportMigrateCards(ctx context.Context, operator string) (int, error) {
+	var res int
+	err := uuc.supportAudited(ctx, operator, "cardctl.migrate-cards", 0, map[string]interface{}{}, func() error {
+		var err error
+		res, err = uuc.MigrateLegacyCards(ctx)
+		return err
+	})
+
+	return res, err
+}
@@ -0,0 +1,286 @@
+package biz
+
+import (
+	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/pkg/totp"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+const (
+	totpIssuer         = "CardBinance"
+	totpSkew           = 1 // 允许前后各一个周期的时钟误差
+	totpMaxFailures    = 5
+	totpFailureWindow  = 15 * time.Minute // 窗口内错误次数达到上限后锁定到窗口结束
+	totpRecoveryCodes  = 10
+	totpRecoveryLength = 10
+)
+
+// 恢复码字符，去掉容易混淆的 0 o 1 l
+const totpRecoveryAlphabet = "abcdefghijkmnpqrstuvwxyz23456789"
+
+// UserTotp 两步验证，Enabled 为 false 时为已生成未确认的密钥
+type UserTotp struct {
+	UserId   uint64
+	Secret   string
+	Enabled  bool
+	LastStep int64 // 最近一次使用的验证码计数，同一验证码不能重复使用
+}
+
+type TotpRepo interface {
+	GetUserTotp(ctx context.Context, userId uint64) (*UserTotp, error)
+	// SaveUserTotpSecret 保存未开启的密钥，已开启时不覆盖
+	SaveUserTotpSecret(ctx context.Context, userId uint64, secret string) error
+	// EnableUserTotp 开启并替换恢复码，需要在事务中调用
+	EnableUserTotp(ctx context.Context, userId uint64, step int64, recoveryHashes []string) error
+	// DisableUserTotp 删除密钥和恢复码，需要在事务中调用
+	DisableUserTotp(ctx context.Context, userId uint64) error
+	// UpdateTotpStep 只在 step 大于已使用的计数时更新，返回 false 表示验证码已被使用
+	UpdateTotpStep(ctx context.Context, userId uint64, step int64) (bool, error)
+	// UseTotpRecoveryCode 标记恢复码已使用，返回 false 表示不存在或已使用
+	UseTotpRecoveryCode(ctx context.Context, userId uint64, hash string) (bool, error)
+	CountTotpRecoveryCodes(ctx context.Context, userId uint64) (int64, error)
+	// IncrTotpFailures 窗口内错误次数加一并返回
+	IncrTotpFailures(ctx context.Context, userId uint64, window time.Duration) (int64, error)
+	GetTotpFailures(ctx context.Context, userId uint64) (int64, error)
+	ResetTotpFailures(ctx context.Context, userId uint64) error
+	// ReencryptTotpSecrets 同 UserRepo.ReencryptUsers
+	ReencryptTotpSecrets(ctx context.Context, afterId uint64, limit int) (uint64, int, error)
+}
+
+// TotpStatus .
+func (uuc *UserUseCase) TotpStatus(ctx context.Context, req *pb.TotpStatusRequest, userId uint64) (*pb.TotpStatusReply, error) {
+	t, err := uuc.totpRepo.GetUserTotp(ctx, userId)
+	if nil != err {
+		return &pb.TotpStatusReply{Status: "查询错误"}, nil
+	}
+
+	if nil == t || !t.Enabled {
+		return &pb.TotpStatusReply{Status: "ok"}, nil
+	}
+
+	count, err := uuc.totpRepo.CountTotpRecoveryCodes(ctx, userId)
+	if nil != err {
+		return &pb.TotpStatusReply{Status: "查询错误"}, nil
+	}
+
+	return &pb.TotpStatusReply{Status: "ok", Enabled: true, RecoveryCodes: uint64(count)}, nil
+}
+
+// TotpSetup 生成新密钥，重复调用会替换未开启的密钥
+func (uuc *UserUseCase) TotpSetup(ctx context.Context, req *pb.TotpSetupRequest, userId uint64) (*pb.TotpSetupReply, error) {
+	user, err := uuc.repo.GetUserById(userId)
+	if nil == user || nil != err {
+		return &pb.TotpSetupReply{Status: "用户不存在"}, nil
+	}
+
+	t, err := uuc.totpRepo.GetUserTotp(ctx, userId)
+	if nil != err {
+		return &pb.TotpSetupReply{Status: "查询错误"}, nil
+	}
+
+	if nil != t && t.Enabled {
+		return &pb.TotpSetupReply{Status: "已开启两步验证"}, nil
+	}
+
+	secret, err := totp.GenerateSecret()
+	if nil != err {
+		return &pb.TotpSetupReply{Status: "生成密钥错误"}, nil
+	}
+
+	if err = uuc.totpRepo.SaveUserTotpSecret(ctx, userId, secret); nil != err {
+		uuc.log.Errorw("msg", "保存两步验证密钥错误", "err", err, "user_id", userId)
+		return &pb.TotpSetupReply{Status: "保存错误"}, nil
+	}
+
+	return &pb.TotpSetupReply{
+		Status: "ok",
+		Secret: secret,
+		Uri:    totp.URI(totpIssuer, user.Address, secret),
+	}, nil
+}
+
+// TotpEnable 用验证器中的验证码确认密钥后开启
+func (uuc *UserUseCase) TotpEnable(ctx context.Context, req *pb.TotpEnableRequest, userId uint64) (*pb.TotpEnableReply, error) {
+	t, err := uuc.totpRepo.GetUserTotp(ctx, userId)
+	if nil != err {
+		return &pb.TotpEnableReply{Status: "查询错误"}, nil
+	}
+
+	if nil == t {
+		return &pb.TotpEnableReply{Status: "请先生成密钥"}, nil
+	}
+
+	if t.Enabled {
+		return &pb.TotpEnableReply{Status: "已开启两步验证"}, nil
+	}
+
+	if status := uuc.totpLocked(ctx, userId); "" != status {
+		return &pb.TotpEnableReply{Status: status}, nil
+	}
+
+	step, ok := totp.Validate(t.Secret, req.SendBody.Code, time.Now(), totpSkew)
+	if !ok {
+		return &pb.TotpEnableReply{Status: uuc.totpFailed(ctx, userId)}, nil
+	}
+
+	codes := make([]string, 0, totpRecoveryCodes)
+	hashes := make([]string, 0, totpRecoveryCodes)
+	for i := 0; i < totpRecoveryCodes; i++ {
+		code, errTwo := newRecoveryCode()
+		if nil != errTwo {
+			return &pb.TotpEnableReply{Status: "生成恢复码错误"}, nil
+		}
+
+		codes = append(codes, code)
+		hashes = append(hashes, recoveryCodeHash(code))
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		return uuc.totpRepo.EnableUserTotp(ctx, userId, step, hashes)
+	}); nil != err {
+		uuc.log.Errorw("msg", "开启两步验证错误", "err", err, "user_id", userId)
+		return &pb.TotpEnableReply{Status: "开启错误"}, nil
+	}
+	uuc.resetTotpFailures(ctx, userId)
+
+	return &pb.TotpEnableReply{Status: "ok", RecoveryCodes: codes}, nil
+}
+
+// TotpDisable 需要验证码或恢复码
+func (uuc *UserUseCase) TotpDisable(ctx context.Context, req *pb.TotpDisableRequest, userId uint64) (*pb.TotpDisableReply, error) {
+	t, err := uuc.totpRepo.GetUserTotp(ctx, userId)
+	if nil != err {
+		return &pb.TotpDisableReply{Status: "查询错误"}, nil
+	}
+
+	if nil == t || !t.Enabled {
+		return &pb.TotpDisableReply{Status: "未开启两步验证"}, nil
+	}
+
+	if status := uuc.CheckTotp(ctx, userId, req.SendBody.Code); "" != status {
+		return &pb.TotpDisableReply{Status: status}, nil
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		return uuc.totpRepo.DisableUserTotp(ctx, userId)
+	}); nil != err {
+		uuc.log.Errorw("msg", "关闭两步验证错误", "err", err, "user_id", userId)
+		return &pb.TotpDisableReply{Status: "关闭错误"}, nil
+	}
+
+	return &pb.TotpDisableReply{Status: "ok"}, nil
+}
+
+// CheckTotp 敏感操作前校验，未开启两步验证时直接通过；返回空字符串表示通过，否则为返回给客户端的状态
+func (uuc *UserUseCase) CheckTotp(ctx context.Context, userId uint64, code string) string {
+	t, err := uuc.totpRepo.GetUserTotp(ctx, userId)
+	if nil != err {
+		uuc.log.Errorw("msg", "查询两步验证错误", "err", err, "user_id", userId)
+		return "两步验证错误"
+	}
+
+	if nil == t || !t.Enabled {
+		return ""
+	}
+
+	code = strings.TrimSpace(code)
+	if "" == code {
+		return "请输入两步验证码"
+	}
+
+	if status := uuc.totpLocked(ctx, userId); "" != status {
+		return status
+	}
+
+	// 验证码为6位数字，其余按恢复码处理
+	if step, ok := totp.Validate(t.Secret, code, time.Now(), totpSkew); ok {
+		fresh, errTwo := uuc.totpRepo.UpdateTotpStep(ctx, userId, step)
+		if nil != errTwo {
+			uuc.log.Errorw("msg", "更新两步验证错误", "err", errTwo, "user_id", userId)
+			return "两步验证错误"
+		}
+
+		if !fresh {
+			return "两步验证码已使用，请等待下一个"
+		}
+
+		uuc.resetTotpFailures(ctx, userId)
+		return ""
+	}
+
+	if totp.Digits != len(code) {
+		used, errTwo := uuc.totpRepo.UseTotpRecoveryCode(ctx, userId, recoveryCodeHash(code))
+		if nil != errTwo {
+			uuc.log.Errorw("msg", "使用恢复码错误", "err", errTwo, "user_id", userId)
+			return "两步验证错误"
+		}
+
+		if used {
+			uuc.log.Warnw("msg", "使用恢复码", "user_id", userId)
+			uuc.resetTotpFailures(ctx, userId)
+			return ""
+		}
+	}
+
+	return uuc.totpFailed(ctx, userId)
+}
+
+// totpLocked 错误次数达到上限时拒绝，避免穷举
+func (uuc *UserUseCase) totpLocked(ctx context.Context, userId uint64) string {
+	failures, err := uuc.totpRepo.GetTotpFailures(ctx, userId)
+	if nil != err {
+		uuc.log.Errorw("msg", "查询两步验证错误次数错误", "err", err, "user_id", userId)
+		return "两步验证错误"
+	}
+
+	if totpMaxFailures <= failures {
+		return "两步验证错误次数过多，请稍后再试"
+	}
+
+	return ""
+}
+
+func (uuc *UserUseCase) totpFailed(ctx context.Context, userId uint64) string {
+	failures, err := uuc.totpRepo.IncrTotpFailures(ctx, userId, totpFailureWindow)
+	if nil != err {
+		uuc.log.Errorw("msg", "记录两步验证错误次数错误", "err", err, "user_id", userId)
+	}
+
+	if totpMaxFailures <= failures {
+		uuc.log.Warnw("msg", "两步验证锁定", "user_id", userId, "failures", failures)
+		return "两步验证错误次数过多，请稍后再试"
+	}
+
+	return "两步验证码错误"
+}
+
+func (uuc *UserUseCase) resetTotpFailures(ctx context.Context, userId uint64) {
+	if err := uuc.totpRepo.ResetTotpFailures(ctx, userId); nil != err {
+		uuc.log.Errorw("msg", "重置两步验证错误次数错误", "err", err, "user_id", userId)
+	}
+}
+
+func newRecoveryCode() (string, error) {
+	b := make([]byte, totpRecoveryLength)
+	if _, err := rand.Read(b); nil != err {
+		return "", err
+	}
+
+	for i := range b {
+		b[i] = totpRecoveryAlphabet[int(b[i])%len(totpRecoveryAlphabet)]
+	}
+
+	return string(b[:totpRecoveryLength/2]) + "-" + string(b[totpRecoveryLength/2:]), nil
+}
+
+// recoveryCodeHash 恢复码只保存哈希，输入时忽略大小写和分隔符
+func recoveryCodeHash(code string) string {
+	code = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -3,6 +3,8 @@ package biz
 import (
 	"bytes"
 	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/pkg/interlace"
+	"cardbinance/internal/pkg/telemetry"
 	"context"
 	"crypto/md5"
 	"encoding/hex"
@@ -11,6 +13,7 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	transporthttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -19,7 +22,6 @@ import (
 	"sort"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 )
 
@@ -98,6 +100,8 @@ type Withdraw struct {
 	RelAmount float64
 	Status    string
 	Address   string
+	Currency  string
+	Chain     string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -111,6 +115,7 @@ type Reward struct {
 	UpdatedAt time.Time
 	Address   string
 	One       uint64
+	Currency  string
 }
 
 type CardRecord struct {
@@ -127,8 +132,6 @@ type CardRecord struct {
 type UserRepo interface {
 	SetNonceByAddress(ctx context.Context, wallet string) (int64, error)
 	GetAndDeleteWalletTimestamp(ctx context.Context, wallet string) (string, error)
-	SetLockAmountToCardByAddress(ctx context.Context, wallet string) error
-	GetLockAmountToCardByAddress(ctx context.Context, wallet string) (string, error)
 	GetConfigByKeys(keys ...string) ([]*Config, error)
 	GetUserByAddress(address string) (*User, error)
 	GetUserById(userId uint64) (*User, error)
@@ -139,40 +142,74 @@ type UserRepo interface {
 	GetUserRecommendLikeCode(code string) ([]*UserRecommend, error)
 	GetUserByUserIds(userIds []uint64) (map[uint64]*User, error)
 	CreateCard(ctx context.Context, userId uint64, user *User) error
-	UpdateCardCardNumberRel(ctx context.Context, userId uint64, cardNumberRel string) error
-	UpdateCardCardNumberRelTwo(ctx context.Context, userId uint64, cardNumberRel string) error
+	// CreateCardTwo 扣费并写入实体卡申请，需要在事务中调用
 	CreateCardTwo(ctx context.Context, userId uint64, user *User) error
 	GetAllUsers() ([]*User, error)
 	UpdateCard(ctx context.Context, userId uint64, cardOrderId, card string) error
 	CreateCardRecommend(ctx context.Context, userId uint64, amount float64, vip uint64, address string) error
-	AmountToCard(ctx context.Context, userId uint64, amount float64, amountRel float64, one uint64) (uint64, error)
-	AmountToCardReward(ctx context.Context, userId uint64, amount float64, orderId string, rewardId uint64, one uint64) error
-	AmountTo(ctx context.Context, userId, toUserId uint64, toAddress string, amount float64) error
-	Withdraw(ctx context.Context, userId uint64, amount, amountRel float64, address string) error
+	AmountToCard(ctx context.Context, userId uint64, amount float64, amountRel float64, one uint64, currency string) (uint64, error)
+	AmountToCardReward(ctx context.Context, userId uint64, amount float64, orderId string, rewardId uint64, one uint64, currency string) error
+	AmountTo(ctx context.Context, userId, toUserId uint64, toAddress string, amount, amountRel float64, currency string) error
+	// Withdraw chain 为空时为登录钱包地址
+	Withdraw(ctx context.Context, userId uint64, amount, amountRel float64, address, chain, currency string) error
 	GetUserRewardByUserIdPage(ctx context.Context, b *Pagination, userId uint64, reason uint64, cardType uint64) ([]*Reward, error, int64)
 	GetUserRecordByUserIdPage(ctx context.Context, b *Pagination, userId uint64) ([]*CardRecord, error, int64)
+	GetLastRewardId(ctx context.Context) (uint64, error)
+	GetRewardsAfterId(ctx context.Context, id uint64, reason uint64, limit int) ([]*Reward, error)
 	SetVip(ctx context.Context, userId uint64, vip uint64) error
 	GetUsersOpenCard() ([]*User, error)
 	UploadCardPicTwo(ctx context.Context, userId uint64, pic string) error
 	UploadCardPic(ctx context.Context, userId uint64, pic string) error
-	UploadCardChangeTwo(ctx context.Context, userId uint64) error
-	UploadCardChange(ctx context.Context, userId uint64) error
-	UploadCardOneLock(ctx context.Context, userId uint64) error
-	UploadCardTwoLock(ctx context.Context, userId uint64) error
 	GetUserCodePage(ctx context.Context, b *Pagination, card string) ([]*CardOrder, error, int64)
+	GetLastCardCodeId(ctx context.Context) (uint64, error)
+	GetCardCodesAfterId(ctx context.Context, id uint64, limit int) ([]*CardOrder, error)
+	GetCardTwoUserIdsByIdCard(ctx context.Context, idCard string) ([]uint64, error)
+	// ReencryptUsers 个人信息用当前主密钥重新加密，返回本批最后的 id 和更新行数，id 为 0 表示已处理完
+	ReencryptUsers(ctx context.Context, afterId uint64, limit int) (uint64, int, error)
+	ReencryptCardTwos(ctx context.Context, afterId uint64, limit int) (uint64, int, error)
 }
 
 type UserUseCase struct {
-	repo UserRepo
-	tx   Transaction
-	log  *log.Helper
-}
-
-func NewUserUseCase(repo UserRepo, tx Transaction, logger log.Logger) *UserUseCase {
+	repo            UserRepo
+	cardRepo        CardRepo
+	balanceRepo     BalanceRepo
+	feeRepo         FeeRepo
+	idempotencyRepo IdempotencyRepo
+	auditRepo       AuditRepo
+	notifier        *Notifier
+	eventRepo       EventRepo
+	totpRepo        TotpRepo
+	addressRepo     WithdrawAddressRepo
+	riskRepo        RiskRepo
+	screeningRepo   ScreeningRepo
+	privacyRepo     PrivacyRepo
+	interlace       *interlace.Client
+	watchlists      *watchlistCache
+	locker          Locker
+	tx              Transaction
+	log             *log.Helper
+}
+
+func NewUserUseCase(repo UserRepo, cardRepo CardRepo, balanceRepo BalanceRepo, feeRepo FeeRepo, idempotencyRepo IdempotencyRepo, auditRepo AuditRepo, notifier *Notifier, eventRepo EventRepo, totpRepo TotpRepo, addressRepo WithdrawAddressRepo, riskRepo RiskRepo, screeningRepo ScreeningRepo, privacyRepo PrivacyRepo, ic *interlace.Client, locker Locker, tx Transaction, logger log.Logger) *UserUseCase {
 	return &UserUseCase{
-		repo: repo,
-		tx:   tx,
-		log:  log.NewHelper(logger),
+		repo:            repo,
+		cardRepo:        cardRepo,
+		balanceRepo:     balanceRepo,
+		feeRepo:         feeRepo,
+		idempotencyRepo: idempotencyRepo,
+		auditRepo:       auditRepo,
+		notifier:        notifier,
+		eventRepo:       eventRepo,
+		totpRepo:        totpRepo,
+		addressRepo:     addressRepo,
+		riskRepo:        riskRepo,
+		screeningRepo:   screeningRepo,
+		privacyRepo:     privacyRepo,
+		interlace:       ic,
+		watchlists:      &watchlistCache{},
+		locker:          locker,
+		tx:              tx,
+		log:             log.NewHelper(logger),
 	}
 }
 
@@ -189,31 +226,16 @@ func (uuc *UserUseCase) GetUserById(ctx context.Context, userId uint64) (*pb.Get
 		cardTwo                string
 	)
 
-	var (
-		configs []*Config
-	)
-
-	// 配置
-	configs, err = uuc.repo.GetConfigByKeys("withdraw_rate")
-	if nil != configs {
-		for _, vConfig := range configs {
-			if "withdraw_rate" == vConfig.KeyName {
-				withdrawRate, _ = strconv.ParseFloat(vConfig.Value, 10)
-			}
-			if "amount_to_rate" == vConfig.KeyName {
-				amountToRate, _ = strconv.ParseFloat(vConfig.Value, 10)
-			}
-			if "card_two" == vConfig.KeyName {
-				cardTwo = vConfig.Value
-			}
-		}
-	}
-
 	user, err = uuc.repo.GetUserById(userId)
 	if nil == user || nil != err {
 		return &pb.GetUserReply{Status: "-1"}, nil
 	}
 
+	// 手续费
+	withdrawRate = uuc.getFeeSchedule(ctx, FeeOpWithdraw, "0", user.Vip).Rate
+	amountToRate = uuc.getFeeSchedule(ctx, FeeOpAmountToCard, "0", user.Vip).Rate
+	cardTwo = fmt.Sprintf("%.2f", uuc.getFeeSchedule(ctx, FeeOpOpenCardTwo, "0", user.Vip).Calc(0))
+
 	// 推荐
 	userRecommend, err = uuc.repo.GetUserRecommendByUserId(userId)
 	if nil == userRecommend {
@@ -236,50 +258,37 @@ func (uuc *UserUseCase) GetUserById(ctx context.Context, userId uint64) (*pb.Get
 		}
 	}
 
-	cardStatus := uint64(0)
 	var (
+		cardStatus    uint64
+		cardStatusTwo uint64
 		cardAmount    string
 		cardAmountTwo string
+		cards         []*Card
+		cardsReply    = make([]*pb.Card, 0)
 	)
-	if "no" == user.CardOrderId {
-		cardStatus = 0
-	} else {
-		if "no" == user.CardNumber {
-			cardStatus = 1
-		} else {
-			cardStatus = 2
-			//// 查询状态。成功分红
-			//var (
-			//	resCard *CardInfoResponse
-			//)
-			//resCard, err = GetCardInfoRequestWithSign(user.Card)
-			//if nil == resCard || 200 != resCard.Code || err != nil {
-			//
-			//} else {
-			//	if "ACTIVE" == resCard.Data.CardStatus {
-			//		cardAmount = resCard.Data.Balance
-			//	}
-			//}
-		}
+	cards, err = uuc.getUserCards(ctx, userId)
+	if nil == err {
+		cardsReply = uuc.cardsReply(ctx, cards)
 	}
 
-	if 10 < len(user.CardNumber) {
-		var (
-			res *InterlaceCardSummaryResp
-		)
-		res, _ = InterlaceGetCardSummary(ctx, interlaceAccountId, user.CardNumber)
-		if nil != res {
-			cardAmount = res.Data.Balance.Available
+	// 旧客户端只认识两张卡，取每种类型的第一张：0未提交 1已提交 2已开通
+	for k, v := range cards {
+		if CardStatusClosed == v.Status {
+			continue
+		}
+
+		tmpStatus := uint64(1)
+		if CardStatusPending != v.Status && CardStatusReview != v.Status {
+			tmpStatus = 2
 		}
-	}
 
-	if 10 < len(user.CardTwoNumber) {
-		var (
-			res *InterlaceCardSummaryResp
-		)
-		res, _ = InterlaceGetCardSummary(ctx, interlaceAccountId, user.CardTwoNumber)
-		if nil != res {
-			cardAmountTwo = res.Data.Balance.Available
+		if CardTypeVirtual == v.CardType && 0 == cardStatus {
+			cardStatus = tmpStatus
+			cardAmount = cardsReply[k].Amount
+		}
+		if CardTypePhysical == v.CardType && 0 == cardStatusTwo {
+			cardStatusTwo = tmpStatus
+			cardAmountTwo = cardsReply[k].Amount
 		}
 	}
 
@@ -294,7 +303,7 @@ func (uuc *UserUseCase) GetUserById(ctx context.Context, userId uint64) (*pb.Get
 		CardAmount:       cardAmount,
 		RecommendAddress: myUserRecommendAddress,
 		WithdrawRate:     withdrawRate,
-		CardStatusTwo:    user.CardTwo,
+		CardStatusTwo:    cardStatusTwo,
 		CanVip:           user.CanVip,
 		VipThree:         user.VipThree,
 		CardTwo:          cardTwo,
@@ -302,6 +311,8 @@ func (uuc *UserUseCase) GetUserById(ctx context.Context, userId uint64) (*pb.Get
 		PicTwo:           "/images/" + user.PicTwo,
 		Pic:              "/images/" + user.Pic,
 		AmountToRate:     amountToRate,
+		Cards:            cardsReply,
+		Balances:         uuc.balancesReply(ctx, userId),
 	}, nil
 }
 
@@ -422,117 +433,69 @@ func (uuc *UserUseCase) OrderList(ctx context.Context, req *pb.OrderListRequest,
 	res := make([]*pb.OrderListReply_List, 0)
 
 	var (
-		user  *User
-		err   error
-		total uint64
+		card     *Card
+		err      error
+		total    uint64
+		cardType = CardTypeVirtual
 	)
 
-	user, err = uuc.repo.GetUserById(userId)
-	if nil == user || nil != err {
+	if 1 == req.CardType {
+		cardType = CardTypePhysical
+	}
+
+	card, err = uuc.getUserCard(ctx, userId, req.CardId, cardType)
+	if nil != err {
 		return &pb.OrderListReply{Status: "查询错误", Count: 0,
 			List: res,
 		}, nil
 	}
 
-	if 1 == req.CardType {
-		if 10 > len(user.CardTwoNumber) {
-			return &pb.OrderListReply{Status: "ok", Count: 0,
-				List: res,
-			}, nil
-		}
-
-		txs, totalTmp, errTwo := InterlaceListTransactions(ctx, &InterlaceTxnListReq{
-			AccountId: interlaceAccountId,
-			CardId:    user.CardTwoNumber,
-			Limit:     20,
-			Page:      int(req.Page),
-			// StartTime: "1735689600000",
-			// EndTime:   "1738272000000",
-		})
-		if errTwo != nil {
-			return &pb.OrderListReply{Status: "ok", Count: 0,
-				List: res,
-			}, nil
-		}
-
-		total, _ = strconv.ParseUint(totalTmp, 10, 64)
+	if nil == card || 10 > len(card.IssuerCardId) {
+		return &pb.OrderListReply{Status: "ok", Count: 0,
+			List: res,
+		}, nil
+	}
 
-		if nil != txs {
-			for _, v := range txs {
-				tmpStatus := "SUCCESS"
-				if "CLOSED" == v.Status {
+	txs, totalTmp, errTwo := uuc.interlace.ListTransactions(ctx, &interlace.TransactionListRequest{
+		CardId: card.IssuerCardId,
+		Limit:  20,
+		Page:   int(req.Page),
+		// StartTime: "1735689600000",
+		// EndTime:   "1738272000000",
+	})
+	if errTwo != nil {
+		return &pb.OrderListReply{Status: "ok", Count: 0,
+			List: res,
+		}, nil
+	}
 
-				} else if "FAIL" == v.Status {
-					tmpStatus = "FAILED"
-				} else if "PENDING" == v.Status {
-					tmpStatus = "PROCESSING"
-				}
+	total, _ = strconv.ParseUint(totalTmp, 10, 64)
 
-				if 3 == v.Type {
-					continue
-				}
+	if nil != txs {
+		for _, v := range txs {
+			tmpStatus := "SUCCESS"
+			if "CLOSED" == v.Status {
 
-				res = append(res, &pb.OrderListReply_List{
-					Timestamp:               v.CreateTime,
-					Status:                  tmpStatus,
-					TradeAmount:             v.Amount,
-					ActualTransactionAmount: v.TransactionAmount,
-					ServiceFee:              v.Fee,
-					TradeDescription:        v.TransactionAmount,
-					CurrentBalance:          "暂未获取",
-					TraderNum:               v.Detail,
-				})
+			} else if "FAIL" == v.Status {
+				tmpStatus = "FAILED"
+			} else if "PENDING" == v.Status {
+				tmpStatus = "PROCESSING"
 			}
-		}
-	} else {
-		if 10 > len(user.CardNumber) {
-			return &pb.OrderListReply{Status: "ok", Count: 0,
-				List: res,
-			}, nil
-		}
-
-		txs, totalTmp, errTwo := InterlaceListTransactions(ctx, &InterlaceTxnListReq{
-			AccountId: interlaceAccountId,
-			CardId:    user.CardNumber,
-			Limit:     20,
-			Page:      int(req.Page),
-			// StartTime: "1735689600000",
-			// EndTime:   "1738272000000",
-		})
-		if errTwo != nil {
-			return &pb.OrderListReply{Status: "ok", Count: 0,
-				List: res,
-			}, nil
-		}
-
-		total, _ = strconv.ParseUint(totalTmp, 10, 64)
-
-		if nil != txs {
-			for _, v := range txs {
-				tmpStatus := "SUCCESS"
-				if "CLOSED" == v.Status {
 
-				} else if "FAIL" == v.Status {
-					tmpStatus = "FAILED"
-				} else if "PENDING" == v.Status {
-					tmpStatus = "PROCESSING"
-				}
-
-				if 3 == v.Type {
-					continue
-				}
-
-				res = append(res, &pb.OrderListReply_List{
-					Timestamp:               v.CreateTime,
-					Status:                  tmpStatus,
-					TradeAmount:             v.Amount,
-					ActualTransactionAmount: v.TransactionAmount,
-					ServiceFee:              v.Fee,
-					TradeDescription:        v.TransactionAmount,
-					CurrentBalance:          "暂未获取",
-					TraderNum:               v.Detail,
-				})
+			if 3 == v.Type {
+				continue
 			}
+
+			res = append(res, &pb.OrderListReply_List{
+				Timestamp:               v.CreateTime,
+				Status:                  tmpStatus,
+				TradeAmount:             v.Amount,
+				ActualTransactionAmount: v.TransactionAmount,
+				ServiceFee:              v.Fee,
+				TradeDescription:        v.TransactionAmount,
+				CurrentBalance:          "暂未获取",
+				TraderNum:               v.Detail,
+			})
 		}
 	}
 
@@ -547,89 +510,55 @@ func (uuc *UserUseCase) OrderListTwo(ctx context.Context, req *pb.OrderListTwoRe
 	res := make([]*pb.OrderListTwoReply_List, 0)
 
 	var (
-		user  *User
-		err   error
-		total uint64
+		card     *Card
+		err      error
+		total    uint64
+		cardType = CardTypeVirtual
 	)
 
-	user, err = uuc.repo.GetUserById(userId)
-	if nil == user || nil != err {
+	if 1 == req.CardType {
+		cardType = CardTypePhysical
+	}
+
+	card, err = uuc.getUserCard(ctx, userId, req.CardId, cardType)
+	if nil != err {
 		return &pb.OrderListTwoReply{Status: "查询错误", Count: 0,
 			List: res,
 		}, nil
 	}
 
-	if 1 == req.CardType {
-		if 10 > len(user.CardTwoNumber) {
-			return &pb.OrderListTwoReply{Status: "ok", Count: 0,
-				List: res,
-			}, nil
-		}
-
-		txs, totalTmp, errTwo := InterlaceListTransactions(ctx, &InterlaceTxnListReq{
-			AccountId: interlaceAccountId,
-			CardId:    user.CardTwoNumber,
-			Limit:     20,
-			Page:      int(req.Page),
-			Type:      "1",
-			// StartTime: "1735689600000",
-			// EndTime:   "1738272000000",
-		})
-		if errTwo != nil {
-			return &pb.OrderListTwoReply{Status: "ok", Count: 0,
-				List: res,
-			}, nil
-		}
-
-		total, _ = strconv.ParseUint(totalTmp, 10, 64)
-
-		if nil != txs {
-			for _, v := range txs {
-				res = append(res, &pb.OrderListTwoReply_List{
-					Timestamp:   v.CreateTime,
-					Status:      v.Status,
-					TradeAmount: v.TransactionAmount,
-					Remark:      v.Remark,
-					Detail:      v.Detail,
-					ServiceFee:  v.Fee,
-				})
-			}
-		}
-	} else {
-		if 10 > len(user.CardNumber) {
-			return &pb.OrderListTwoReply{Status: "ok", Count: 0,
-				List: res,
-			}, nil
-		}
-
-		txs, totalTmp, errTwo := InterlaceListTransactions(ctx, &InterlaceTxnListReq{
-			AccountId: interlaceAccountId,
-			CardId:    user.CardNumber,
-			Limit:     20,
-			Page:      int(req.Page),
-			Type:      "1",
-			// StartTime: "1735689600000",
-			// EndTime:   "1738272000000",
-		})
-		if errTwo != nil {
-			return &pb.OrderListTwoReply{Status: "ok", Count: 0,
-				List: res,
-			}, nil
-		}
-
-		total, _ = strconv.ParseUint(totalTmp, 10, 64)
-
-		if nil != txs {
-			for _, v := range txs {
-				res = append(res, &pb.OrderListTwoReply_List{
-					Timestamp:   v.CreateTime,
-					Status:      v.Status,
-					TradeAmount: v.TransactionAmount,
-					Remark:      v.Remark,
-					Detail:      v.Detail,
-					ServiceFee:  v.Fee,
-				})
-			}
+	if nil == card || 10 > len(card.IssuerCardId) {
+		return &pb.OrderListTwoReply{Status: "ok", Count: 0,
+			List: res,
+		}, nil
+	}
+
+	txs, totalTmp, errTwo := uuc.interlace.ListTransactions(ctx, &interlace.TransactionListRequest{
+		CardId: card.IssuerCardId,
+		Limit:  20,
+		Page:   int(req.Page),
+		Type:   "1",
+		// StartTime: "1735689600000",
+		// EndTime:   "1738272000000",
+	})
+	if errTwo != nil {
+		return &pb.OrderListTwoReply{Status: "ok", Count: 0,
+			List: res,
+		}, nil
+	}
+
+	total, _ = strconv.ParseUint(totalTmp, 10, 64)
+
+	if nil != txs {
+		for _, v := range txs {
+			res = append(res, &pb.OrderListTwoReply_List{
+				Timestamp:   v.CreateTime,
+				Status:      v.Status,
+				TradeAmount: v.TransactionAmount,
+				Remark:      v.Remark,
+				Detail:      v.Detail,
+				ServiceFee:  v.Fee,
+			})
 		}
 	}
 
@@ -684,7 +613,7 @@ func (uuc *UserUseCase) RewardList(ctx context.Context, req *pb.RewardListReques
 		err         error
 	)
 
-	if 1 > req.ReqType || 11 < req.ReqType {
+	if (1 > req.ReqType || 11 < req.ReqType) && 15 != req.ReqType {
 		return &pb.RewardListReply{
 			Status: "参数错误",
 			Count:  0,
@@ -705,10 +634,16 @@ func (uuc *UserUseCase) RewardList(ctx context.Context, req *pb.RewardListReques
 	}
 
 	for _, vUserReward := range userRewards {
+		currency := vUserReward.Currency
+		if "" == currency {
+			currency = CurrencyUSDT
+		}
+
 		res = append(res, &pb.RewardListReply_List{
 			CreatedAt: vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
 			Amount:    fmt.Sprintf("%.4f", vUserReward.Amount),
 			Address:   vUserReward.Address,
+			Currency:  currency,
 		})
 	}
 
@@ -793,13 +728,13 @@ func (uuc *UserUseCase) GetExistUserByAddressOrCreate(ctx context.Context, u *Us
 
 // 有锁的
 
-var lockCreateNonce sync.Mutex
-
 func (uuc *UserUseCase) CreateNonce(ctx context.Context, req *pb.CreateNonceRequest) (*pb.CreateNonceReply, error) {
-	lockCreateNonce.Lock()
-	defer lockCreateNonce.Unlock()
-
-	nonce, err := uuc.repo.SetNonceByAddress(ctx, req.SendBody.Address)
+	var nonce int64
+	err := uuc.withLock(ctx, nonceLockKey(req.SendBody.Address), func() error {
+		var errTwo error
+		nonce, errTwo = uuc.repo.SetNonceByAddress(ctx, req.SendBody.Address)
+		return errTwo
+	})
 	if nil != err {
 		return &pb.CreateNonceReply{Nonce: "-1", Status: "生成错误"}, err
 	}
@@ -808,37 +743,56 @@ func (uuc *UserUseCase) CreateNonce(ctx context.Context, req *pb.CreateNonceRequ
 }
 
 // 凡是操作的都涉及到这个锁
-var lockNonce sync.Mutex
-
 func (uuc *UserUseCase) GetAddressNonce(ctx context.Context, address string) (string, error) {
-	lockNonce.Lock()
-	defer lockNonce.Unlock()
+	var nonce string
+	err := uuc.withLock(ctx, nonceLockKey(address), func() error {
+		var errTwo error
+		nonce, errTwo = uuc.repo.GetAndDeleteWalletTimestamp(ctx, address)
+		return errTwo
+	})
 
-	return uuc.repo.GetAndDeleteWalletTimestamp(ctx, address)
+	return nonce, err
 }
 
-var lockVip sync.Mutex
-
 func (uuc *UserUseCase) SetVip(ctx context.Context, req *pb.SetVipRequest, userId uint64) (*pb.SetVipReply, error) {
-	lockVip.Lock()
-	defer lockVip.Unlock()
-
 	var (
 		user   *User
 		toUser *User
 		err    error
 	)
 
-	user, err = uuc.repo.GetUserById(userId)
-	if nil == user || nil != err {
-		return &pb.SetVipReply{Status: "用户不存在"}, nil
-	}
-
+	// 同一个团队的等级设置互相约束，按团队顶点加锁
 	toUser, err = uuc.repo.GetUserByAddress(req.SendBody.Address)
 	if nil == toUser || nil != err {
 		return &pb.SetVipReply{Status: "目标用户不存在"}, nil
 	}
 
+	var (
+		lock       *Lock
+		teamRootId = toUser.ID
+	)
+	if tmpRecommend, _ := uuc.repo.GetUserRecommendByUserId(toUser.ID); nil != tmpRecommend {
+		for _, v := range strings.Split(tmpRecommend.RecommendCode, "D") {
+			if tmp, _ := strconv.ParseUint(v, 10, 64); 0 < tmp {
+				teamRootId = tmp
+				break
+			}
+		}
+	}
+
+	lock, err = uuc.locker.Lock(ctx, "vip:"+strconv.FormatUint(teamRootId, 10), lockTTL, lockWait)
+	if nil != err {
+		return &pb.SetVipReply{Status: "操作繁忙，请稍后再试"}, nil
+	}
+	defer func() {
+		_ = uuc.locker.Unlock(context.Background(), lock)
+	}()
+
+	user, err = uuc.repo.GetUserById(userId)
+	if nil == user || nil != err {
+		return &pb.SetVipReply{Status: "用户不存在"}, nil
+	}
+
 	if 0 > req.SendBody.Vip || 14 < req.SendBody.Vip {
 		return &pb.SetVipReply{Status: "vip等级必须在0-14之间"}, nil
 	}
@@ -941,12 +895,16 @@ func (uuc *UserUseCase) SetVip(ctx context.Context, req *pb.SetVipRequest, userI
 
 		return nil
 	}); nil != err {
-		fmt.Println(err, "设置vip写入mysql错误", user)
+		uuc.log.Errorw("msg", "设置vip写入mysql错误", "err", err, "user_id", userId, "to_user_id", toUser.ID)
 		return &pb.SetVipReply{
 			Status: "设置vip错误，联系管理员",
 		}, nil
 	}
 
+	uuc.notifier.Notify(ctx, toUser.ID, NotifyEventVipChange, map[string]interface{}{
+		"Vip": req.SendBody.Vip,
+	})
+
 	return &pb.SetVipReply{
 		Status: "ok",
 	}, nil
@@ -968,18 +926,33 @@ func (uuc *UserUseCase) OpenCard(ctx context.Context, req *pb.OpenCardRequest, u
 		return &pb.OpenCardReply{Status: "提交已经5次。联系管理员"}, nil
 	}
 
-	if "no" != user.CardOrderId {
-		return &pb.OpenCardReply{Status: "已经提交开卡信息"}, nil
+	var (
+		cards        []*Card
+		virtualCount uint64
+	)
+	cards, err = uuc.getUserCards(ctx, userId)
+	if nil != err {
+		return &pb.OpenCardReply{Status: "查询卡片错误"}, nil
+	}
+
+	for _, v := range cards {
+		if CardTypeVirtual == v.CardType && CardStatusClosed != v.Status {
+			virtualCount++
+		}
+	}
+
+	if virtualCount >= uuc.getVirtualCardMax() {
+		return &pb.OpenCardReply{Status: "虚拟卡数量已达上限"}, nil
 	}
 
 	//if "no" != user.CardNumber {
 	//	return &pb.OpenCardReply{Status: "已经开卡"}, nil
 	//}
 
-	if 15 > uint64(user.Amount) {
-		return &pb.OpenCardReply{Status: "账号余额不足15u"}, nil
+	cardAmount = uuc.getFeeSchedule(ctx, FeeOpOpenCard, user.ProductId, user.Vip).Calc(0)
+	if cardAmount > user.Amount {
+		return &pb.OpenCardReply{Status: fmt.Sprintf("账号余额不足%.2fu", cardAmount)}, nil
 	}
-	cardAmount = 15
 
 	if 1 > len(req.SendBody.Email) || len(req.SendBody.Email) > 99 {
 		return &pb.OpenCardReply{Status: "邮箱错误"}, nil
@@ -1054,7 +1027,7 @@ func (uuc *UserUseCase) OpenCard(ctx context.Context, req *pb.OpenCardRequest, u
 	//
 	//	return nil
 	//}); nil != err {
-	//	fmt.Println(err, "开卡写入mysql错误", user)
+	//	uuc.log.Errorw("msg", "开卡写入mysql错误", "err", err, "user_id", userId)
 	//	return &pb.OpenCardReply{
 	//		Status: "开卡错误，联系管理员",
 	//	}, nil
@@ -1143,7 +1116,12 @@ func (uuc *UserUseCase) OpenCard(ctx context.Context, req *pb.OpenCardRequest, u
 	//maxCardQuotaTwo = maxCardQuota
 	//productIdUseTwo = productIdUse
 
-	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+	var (
+		card   *Card
+		status string
+	)
+	if err = uuc.lockedTx(ctx, []string{balanceLockKey(userId)}, func(ctx context.Context) error { // 事务
+		// 先扣费锁住用户行，再在事务内重新检查数量，避免并发请求都通过上面的检查
 		err = uuc.repo.CreateCard(ctx, userId, &User{
 			Amount: cardAmount,
 		})
@@ -1151,75 +1129,100 @@ func (uuc *UserUseCase) OpenCard(ctx context.Context, req *pb.OpenCardRequest, u
 			return err
 		}
 
+		count, errTwo := uuc.cardRepo.CountOpenCards(ctx, userId, CardTypeVirtual)
+		if nil != errTwo {
+			return errTwo
+		}
+
+		if uint64(count) >= uuc.getVirtualCardMax() {
+			status = "虚拟卡数量已达上限"
+			return errOpenCardRejected
+		}
+
+		card, err = uuc.cardRepo.CreateCard(ctx, &Card{
+			UserId:    userId,
+			CardType:  CardTypeVirtual,
+			ProductId: user.ProductId,
+			Status:    CardStatusPending,
+		})
+		if nil != err {
+			return err
+		}
+
+		queued, errTwo := uuc.cardRepo.QueueLegacyIssue(ctx, userId, CardTypeVirtual)
+		if nil != errTwo {
+			return errTwo
+		}
+
+		if !queued {
+			status = "开卡申请处理中，请稍后再试"
+			return errOpenCardRejected
+		}
+
 		return nil
 	}); nil != err {
-		fmt.Println(err, "开卡写入mysql错误", user)
+		if errors.Is(err, errOpenCardRejected) {
+			return &pb.OpenCardReply{Status: status}, nil
+		}
+
+		uuc.log.Errorw("msg", "开卡写入mysql错误", "err", err, "user_id", userId)
 		return &pb.OpenCardReply{
 			Status: "开卡错误，联系管理员",
 		}, nil
 	}
 	//}
+	uuc.publishBalance(ctx, userId)
 
 	return &pb.OpenCardReply{
 		Status: "ok",
+		CardId: card.ID,
 	}, nil
 }
 
 func (uuc *UserUseCase) CheckCard(ctx context.Context, req *pb.CheckCardRequest, userId uint64) (*pb.CheckCardReply, error) {
 	var (
-		user *User
-		err  error
+		card     *Card
+		err      error
+		cardType = CardTypePhysical
 	)
 
-	user, err = uuc.repo.GetUserById(userId)
-	if nil == user || nil != err {
-		return &pb.CheckCardReply{Status: "用户不存在"}, nil
-	}
-
 	if 2 == req.SendBody.CheckType {
-		if 5 >= len(user.CardNumber) {
-			return &pb.CheckCardReply{Status: "未提交虚拟卡开卡信息"}, nil
-		}
+		cardType = CardTypeVirtual
+	}
 
-		if 16 != len(req.SendBody.Num) {
-			return &pb.CheckCardReply{Status: "卡号格式错误"}, nil
-		}
+	card, err = uuc.getUserCard(ctx, userId, req.SendBody.CardId, cardType)
+	if nil != err {
+		return &pb.CheckCardReply{Status: "查询卡片错误"}, nil
+	}
 
-		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-			err = uuc.repo.UpdateCardCardNumberRel(ctx, userId, req.SendBody.Num)
-			if nil != err {
-				return err
-			}
+	if nil == card {
+		return &pb.CheckCardReply{Status: "未提交开卡信息"}, nil
+	}
 
-			return nil
-		}); nil != err {
-			fmt.Println(err, "开卡写入mysql错误", user)
-			return &pb.CheckCardReply{
-				Status: "开卡错误，联系管理员",
-			}, nil
-		}
-	} else {
-		if 16 != len(req.SendBody.Num) {
-			return &pb.CheckCardReply{Status: "卡号格式错误"}, nil
-		}
+	if CardTypeVirtual == card.CardType && 5 >= len(card.IssuerCardId) {
+		return &pb.CheckCardReply{Status: "未提交虚拟卡开卡信息"}, nil
+	}
 
-		if 2 == user.CardTwo {
-			return &pb.CheckCardReply{Status: "已经激活卡片"}, nil
-		}
+	if 16 != len(req.SendBody.Num) {
+		return &pb.CheckCardReply{Status: "卡号格式错误"}, nil
+	}
 
-		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-			err = uuc.repo.UpdateCardCardNumberRelTwo(ctx, userId, req.SendBody.Num)
-			if nil != err {
-				return err
-			}
+	if CardTypePhysical == card.CardType && CardStatusActive == card.Status {
+		return &pb.CheckCardReply{Status: "已经激活卡片"}, nil
+	}
 
-			return nil
-		}); nil != err {
-			fmt.Println(err, "开卡写入mysql错误", user)
-			return &pb.CheckCardReply{
-				Status: "开卡错误，联系管理员",
-			}, nil
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		err = uuc.cardRepo.UpdateCardMaskedPan(ctx, card.ID, MaskCard8_6_4(req.SendBody.Num))
+		if nil != err {
+			return err
 		}
+
+		return nil
+	}); nil != err {
+		uuc.log.Errorw("msg", "开卡写入mysql错误", "err", err, "user_id", userId, "card_id", card.ID)
+		return &pb.CheckCardReply{
+			Status: "开卡错误，联系管理员",
+		}, nil
 	}
 
 	return &pb.CheckCardReply{
@@ -1233,25 +1236,16 @@ func (uuc *UserUseCase) OpenCardTwo(ctx context.Context, req *pb.OpenCardRequest
 		err  error
 	)
 	var (
-		configs    []*Config
-		cardAmount = float64(150)
+		cardAmount float64
 	)
 
-	// 配置
-	configs, err = uuc.repo.GetConfigByKeys("card_two")
-	if nil != configs {
-		for _, vConfig := range configs {
-			if "card_two" == vConfig.KeyName {
-				cardAmount, _ = strconv.ParseFloat(vConfig.Value, 10)
-			}
-		}
-	}
-
 	user, err = uuc.repo.GetUserById(userId)
 	if nil == user || nil != err {
 		return &pb.OpenCardReply{Status: "用户不存在"}, nil
 	}
 
+	cardAmount = uuc.getFeeSchedule(ctx, FeeOpOpenCardTwo, "0", user.Vip).Calc(0)
+
 	//if 4 >= len(user.Pic) || 4 >= len(user.PicTwo) {
 	//	return &pb.OpenCardReply{Status: "先上传证件照片"}, nil
 	//}
@@ -1260,12 +1254,22 @@ func (uuc *UserUseCase) OpenCardTwo(ctx context.Context, req *pb.OpenCardRequest
 		return &pb.OpenCardReply{Status: "提交已经5次。联系管理员"}, nil
 	}
 
-	if 0 < user.CardTwo {
-		return &pb.OpenCardReply{Status: "已提交"}, nil
+	var (
+		cards []*Card
+	)
+	cards, err = uuc.getUserCards(ctx, userId)
+	if nil != err {
+		return &pb.OpenCardReply{Status: "查询卡片错误"}, nil
+	}
+
+	for _, v := range cards {
+		if CardTypePhysical == v.CardType && CardStatusClosed != v.Status {
+			return &pb.OpenCardReply{Status: "已提交"}, nil
+		}
 	}
 
-	if uint64(cardAmount) > uint64(user.Amount) {
-		return &pb.OpenCardReply{Status: "账号余额不足199u"}, nil
+	if cardAmount > user.Amount {
+		return &pb.OpenCardReply{Status: fmt.Sprintf("账号余额不足%.2fu", cardAmount)}, nil
 	}
 
 	if 1 > len(req.SendBody.Email) || len(req.SendBody.Email) > 99 {
@@ -1312,7 +1316,49 @@ func (uuc *UserUseCase) OpenCardTwo(ctx context.Context, req *pb.OpenCardRequest
 		return &pb.OpenCardReply{Status: "身份证号码错误"}, nil
 	}
 
-	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+	var (
+		idCardUserIds []uint64
+	)
+	idCardUserIds, err = uuc.repo.GetCardTwoUserIdsByIdCard(ctx, req.SendBody.IdCard)
+	if nil != err {
+		return &pb.OpenCardReply{Status: "查询证件错误"}, nil
+	}
+
+	for _, v := range idCardUserIds {
+		if userId != v {
+			uuc.log.Warnw("msg", "证件号码重复", "user_id", userId, "other_user_id", v)
+			return &pb.OpenCardReply{Status: "证件已被其他账户使用，联系管理员"}, nil
+		}
+	}
+
+	var (
+		screening *Screening
+	)
+	screening, err = uuc.screenApplicant(ctx, userId, req.SendBody.FirstName+" "+req.SendBody.LastName, req.SendBody.Country)
+	if nil != err {
+		uuc.log.Errorw("msg", "名单筛查错误", "err", err, "user_id", userId)
+		return &pb.OpenCardReply{Status: "审核服务异常，请稍后再试"}, nil
+	}
+
+	if ScreeningBlocked == screening.Result {
+		if _, err = uuc.screeningRepo.CreateScreening(ctx, screening); nil != err {
+			uuc.log.Errorw("msg", "筛查记录写入错误", "err", err, "user_id", userId)
+		}
+		return &pb.OpenCardReply{Status: "申请未通过审核，请联系客服"}, nil
+	}
+
+	// 疑似命中的申请照常扣款建卡，合规审核通过后才进入发卡，拒绝时退回开卡费
+	cardStatus := CardStatusPending
+	if ScreeningReview == screening.Result {
+		cardStatus = CardStatusReview
+	}
+
+	var (
+		card   *Card
+		status string
+	)
+	if err = uuc.lockedTx(ctx, []string{balanceLockKey(userId)}, func(ctx context.Context) error { // 事务
+		// 扣费同时锁住用户行，之后在事务内重新检查是否已有实体卡
 		err = uuc.repo.CreateCardTwo(ctx, userId, &User{
 			Amount:           cardAmount,
 			FirstName:        req.SendBody.FirstName,
@@ -1333,24 +1379,73 @@ func (uuc *UserUseCase) OpenCardTwo(ctx context.Context, req *pb.OpenCardRequest
 			return err
 		}
 
+		count, errTwo := uuc.cardRepo.CountOpenCards(ctx, userId, CardTypePhysical)
+		if nil != errTwo {
+			return errTwo
+		}
+
+		if 0 < count {
+			status = "已提交"
+			return errOpenCardRejected
+		}
+
+		card, err = uuc.cardRepo.CreateCard(ctx, &Card{
+			UserId:   userId,
+			CardType: CardTypePhysical,
+			Status:   cardStatus,
+		})
+		if nil != err {
+			return err
+		}
+
+		// 待审核的申请在审核通过后由开卡任务提交发卡
+		if CardStatusPending == cardStatus {
+			queued, errThree := uuc.cardRepo.QueueLegacyIssue(ctx, userId, CardTypePhysical)
+			if nil != errThree {
+				return errThree
+			}
+
+			if !queued {
+				status = "开卡申请处理中，请稍后再试"
+				return errOpenCardRejected
+			}
+		}
+
+		err = uuc.screeningRepo.UpdateCardTwoScreening(ctx, userId, screening.Result, screening.ListVersion)
+		if nil != err {
+			return err
+		}
+
+		screening.CardId = card.ID
+		screening.Fee = cardAmount
+		_, err = uuc.screeningRepo.CreateScreening(ctx, screening)
+		if nil != err {
+			return err
+		}
+
 		return nil
 	}); nil != err {
-		fmt.Println(err, "开卡2写入mysql错误", user)
+		if errors.Is(err, errOpenCardRejected) {
+			return &pb.OpenCardReply{Status: status}, nil
+		}
+
+		uuc.log.Errorw("msg", "开卡2写入mysql错误", "err", err, "user_id", userId)
 		return &pb.OpenCardReply{
 			Status: "开卡错误，联系管理员",
 		}, nil
 	}
+	uuc.publishBalance(ctx, userId)
 
 	return &pb.OpenCardReply{
 		Status: "ok",
+		CardId: card.ID,
 	}, nil
 }
 
 func (uuc *UserUseCase) AmountToCard(ctx context.Context, req *pb.AmountToCardRequest, userId uint64) (*pb.AmountToCardReply, error) {
 	var (
-		user             *User
-		err              error
-		lockAmountToCard string
+		user *User
+		err  error
 	)
 	user, err = uuc.repo.GetUserById(userId)
 	if nil == user || nil != err {
@@ -1358,134 +1453,116 @@ func (uuc *UserUseCase) AmountToCard(ctx context.Context, req *pb.AmountToCardRe
 	}
 
 	var (
-		configs      []*Config
-		amountToRate float64
+		currency string
+		ok       bool
+		balance  float64
 	)
+	currency, ok = parseCurrency(req.SendBody.Currency)
+	if !ok {
+		return &pb.AmountToCardReply{Status: "币种错误"}, nil
+	}
 
-	// 配置
-	configs, err = uuc.repo.GetConfigByKeys("amount_to_rate")
-	if nil != configs {
-		for _, vConfig := range configs {
-			if "amount_to_rate" == vConfig.KeyName {
-				amountToRate, _ = strconv.ParseFloat(vConfig.Value, 10)
-			}
-		}
+	balance, err = uuc.balanceRepo.GetBalance(ctx, userId, currency)
+	if nil != err {
+		return &pb.AmountToCardReply{Status: "查询余额错误"}, nil
 	}
 
-	lockAmountToCard, err = uuc.repo.GetLockAmountToCardByAddress(ctx, user.Address)
-	if 0 < len(lockAmountToCard) {
-		return &pb.AmountToCardReply{Status: "每分钟划转1笔"}, nil
+	if float64(req.SendBody.Amount) > balance {
+		return &pb.AmountToCardReply{Status: "账号余额不足"}, nil
+	}
+
+	var (
+		card     *Card
+		cardType = CardTypeVirtual
+		one      uint64
+	)
+	if 1 == req.SendBody.ToType {
+		cardType = CardTypePhysical
 	}
 
-	err = uuc.repo.SetLockAmountToCardByAddress(ctx, user.Address)
+	card, err = uuc.getUserCard(ctx, userId, req.SendBody.CardId, cardType)
 	if nil != err {
-		return &pb.AmountToCardReply{Status: "锁定失败"}, nil
+		return &pb.AmountToCardReply{Status: "查询卡片错误"}, nil
 	}
 
-	if req.SendBody.Amount > uint64(user.Amount) {
-		return &pb.AmountToCardReply{Status: "账号余额不足"}, nil
+	if nil == card || !card.Usable() {
+		if CardTypePhysical == cardType {
+			return &pb.AmountToCardReply{Status: "无卡片记录，请先开通实体卡"}, nil
+		}
+
+		return &pb.AmountToCardReply{Status: "无卡片记录，请先开通虚拟卡"}, nil
+	}
+
+	if CardTypePhysical == card.CardType {
+		one = 1
 	}
 
 	//if 100 > req.SendBody.Amount {
 	//	return &pb.AmountToCardReply{Status: "划转最少100u"}, nil
 	//}
 
-	if 20 > req.SendBody.Amount {
-		return &pb.AmountToCardReply{Status: "划转最少20u"}, nil
+	// 卡片以法币入账，最低金额与兑换一样按到账币种比较
+	rates := uuc.getCurrencyRates()
+	fee := uuc.getFeeSchedule(ctx, FeeOpAmountToCard, card.ProductId, user.Vip)
+	if fee.MinAmount > convertCurrency(rates, currency, CurrencyUSD, float64(req.SendBody.Amount)) {
+		return &pb.AmountToCardReply{Status: fmt.Sprintf("划转最少%.2f%s", fee.MinAmount, CurrencyUSD)}, nil
 	}
 
-	amountFloatSubFee := float64(req.SendBody.Amount) - float64(req.SendBody.Amount)*amountToRate
+	amountFloatSubFee := float64(req.SendBody.Amount) - fee.Calc(float64(req.SendBody.Amount))
 	if 0 >= amountFloatSubFee {
 		return &pb.AmountToCardReply{Status: "手续费错误"}, nil
 	}
 
-	if 1 == req.SendBody.ToType {
-		if 2 != user.CardTwo {
-			return &pb.AmountToCardReply{Status: "无卡片记录，请先开通实体卡"}, nil
+	// 卡片以法币入账，按配置价格换算
+	amountCard := convertCurrency(rates, currency, CurrencyUSD, amountFloatSubFee)
+
+	// 发卡方熔断时不扣款，避免扣款后入账失败
+	if errTwo := uuc.interlace.Available(); nil != errTwo {
+		uuc.log.Warnw("msg", "发卡方不可用，拒绝划转", "err", errTwo, "user_id", userId, "card_id", card.ID)
+		return &pb.AmountToCardReply{Status: "卡片服务暂不可用，请稍后再试"}, nil
+	}
+
+	tmpRewardId := uint64(0)
+	tmpOrderId := fmt.Sprintf("in-%d", time.Now().UnixNano())
+	var transfer *CardTransfer
+	if err = uuc.lockedTx(ctx, []string{balanceLockKey(userId)}, func(ctx context.Context) error { // 事务
+		tmpRewardId, err = uuc.repo.AmountToCard(ctx, userId, float64(req.SendBody.Amount), amountFloatSubFee, 0, currency)
+		if nil != err {
+			return err
 		}
 
-		if 10 > len(user.CardTwoNumber) {
-			return &pb.AmountToCardReply{Status: "无卡片记录，请先开通实体卡"}, nil
+		err = uuc.repo.AmountToCardReward(ctx, userId, float64(req.SendBody.Amount), tmpOrderId, tmpRewardId, one, currency)
+		if nil != err {
+			return err
 		}
 
-		tmpRewardId := uint64(0)
-		tmpOrderId := fmt.Sprintf("in-%d", time.Now().UnixNano())
-		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-			tmpRewardId, err = uuc.repo.AmountToCard(ctx, userId, float64(req.SendBody.Amount), amountFloatSubFee, 0)
-			if nil != err {
-				return err
-			}
-
-			err = uuc.repo.AmountToCardReward(ctx, userId, float64(req.SendBody.Amount), tmpOrderId, tmpRewardId, 1)
-			if nil != err {
-				return err
-			}
-
-			return nil
-		}); nil != err {
-			fmt.Println(err, "划转写入mysql错误", user)
-			return &pb.AmountToCardReply{
-				Status: "划转错误，联系管理员",
-			}, nil
-		}
-
-		// 划转
-		data, errTwo := InterlaceCardTransferIn(ctx, &InterlaceCardTransferInReq{
-			AccountId:           interlaceAccountId,
-			CardId:              user.CardTwoNumber,
-			ClientTransactionId: tmpOrderId,
-			Amount:              fmt.Sprintf("%.2f", amountFloatSubFee), // 字符串
+		transfer, err = uuc.cardRepo.CreateCardTransfer(ctx, &CardTransfer{
+			UserId:   userId,
+			CardId:   card.ID,
+			RewardId: tmpRewardId,
+			OrderId:  tmpOrderId,
+			Amount:   amountCard,
+			Status:   CardTransferPending,
 		})
-		if errTwo != nil {
-			fmt.Println("InterlaceCardTransferIn error:", errTwo, data)
-			return &pb.AmountToCardReply{
-				Status: "划转错误，联系管理员，记录失败",
-			}, nil
-		}
-
-	} else {
-		if "success" != user.CardOrderId {
-			return &pb.AmountToCardReply{Status: "无卡片记录，请先开通虚拟卡"}, nil
-		}
-
-		if 10 > len(user.CardNumber) {
-			return &pb.AmountToCardReply{Status: "无卡片记录，请先开通实体卡"}, nil
+		if nil != err {
+			return err
 		}
 
-		tmpRewardId := uint64(0)
-		tmpOrderId := fmt.Sprintf("in-%d", time.Now().UnixNano())
-		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-			tmpRewardId, err = uuc.repo.AmountToCard(ctx, userId, float64(req.SendBody.Amount), amountFloatSubFee, 0)
-			if nil != err {
-				return err
-			}
-
-			err = uuc.repo.AmountToCardReward(ctx, userId, float64(req.SendBody.Amount), tmpOrderId, tmpRewardId, 0)
-			if nil != err {
-				return err
-			}
+		return nil
+	}); nil != err {
+		uuc.log.Errorw("msg", "划转写入mysql错误", "err", err, "user_id", userId)
+		return &pb.AmountToCardReply{
+			Status: "划转错误，联系管理员",
+		}, nil
+	}
+	uuc.publishBalance(ctx, userId)
 
-			return nil
-		}); nil != err {
-			fmt.Println(err, "划转写入mysql错误", user)
-			return &pb.AmountToCardReply{
-				Status: "划转错误，联系管理员",
-			}, nil
-		}
-
-		// 划转
-		data, errTwo := InterlaceCardTransferIn(ctx, &InterlaceCardTransferInReq{
-			AccountId:           interlaceAccountId,
-			CardId:              user.CardNumber,
-			ClientTransactionId: tmpOrderId,
-			Amount:              fmt.Sprintf("%.2f", amountFloatSubFee), // 字符串
-		})
-		if errTwo != nil {
-			fmt.Println("InterlaceCardTransferIn error:", errTwo, data)
-			return &pb.AmountToCardReply{
-				Status: "划转错误，联系管理员，记录失败",
-			}, nil
-		}
+	// 划转
+	if errTwo := uuc.cardTransferIn(ctx, transfer, card); errTwo != nil {
+		uuc.log.Errorw("msg", "cardTransferIn error", "err", errTwo, "user_id", userId, "card_id", card.ID, "order_id", tmpOrderId)
+		return &pb.AmountToCardReply{
+			Status: "划转错误，联系管理员，记录失败",
+		}, nil
 	}
 
 	return &pb.AmountToCardReply{
@@ -1493,146 +1570,134 @@ func (uuc *UserUseCase) AmountToCard(ctx context.Context, req *pb.AmountToCardRe
 	}, nil
 }
 
-func (uuc *UserUseCase) ChangePin(ctx context.Context, req *pb.ChangePinRequest, userId uint64) (*pb.ChangePinReply, error) {
-	var (
-		user *User
-		err  error
-	)
-	user, err = uuc.repo.GetUserById(userId)
-	if nil == user || nil != err {
-		return &pb.ChangePinReply{Status: "用户不存在"}, nil
-	}
+// cardTransferIn 向发卡方发起入账并记录结果，失败的记录可由 cardctl redrive 以同一 clientTransactionId 重新发起
+func (uuc *UserUseCase) cardTransferIn(ctx context.Context, transfer *CardTransfer, card *Card) error {
+	_, err := uuc.interlace.TransferIn(ctx, &interlace.TransferInRequest{
+		CardId:              card.IssuerCardId,
+		ClientTransactionId: transfer.OrderId,
+		Amount:              fmt.Sprintf("%.2f", transfer.Amount), // 字符串
+	})
 
-	// 冻结
-	if 1 == req.SendBody.CardType {
-		res, errTwo := InterlaceSetCardPin(ctx, user.CardTwoNumber, &InterlaceSetCardPinReq{
-			Pin:       req.SendBody.Pin,
-			AccountId: interlaceAccountId,
-		})
-		if !res || errTwo != nil {
-			return &pb.ChangePinReply{Status: "实体卡修改pin失败"}, nil
-		}
+	status, errMsg := CardTransferSuccess, ""
+	if nil != err {
+		status, errMsg = CardTransferFailed, err.Error()
+	}
 
-	} else {
-		res, errTwo := InterlaceSetCardPin(ctx, user.CardNumber, &InterlaceSetCardPinReq{
-			Pin:       req.SendBody.Pin,
-			AccountId: interlaceAccountId,
+	if errTwo := uuc.cardRepo.UpdateCardTransfer(ctx, transfer.ID, transfer.Status, status, errMsg); nil != errTwo {
+		uuc.log.Errorw("msg", "更新划转记录错误", "err", errTwo, "user_id", transfer.UserId, "order_id", transfer.OrderId, "status", status)
+	} else if CardTransferSuccess == status {
+		uuc.notifyCard(ctx, NotifyEventCardTopUp, card, map[string]interface{}{
+			"Amount": fmt.Sprintf("%.2f", transfer.Amount),
 		})
-		if !res || errTwo != nil {
-			return &pb.ChangePinReply{Status: "虚拟卡修改pin失败"}, nil
-		}
+		uuc.publishCardBalance(ctx, card)
 	}
 
-	return &pb.ChangePinReply{Status: "ok"}, nil
+	return err
 }
 
 func (uuc *UserUseCase) LookCardNewTwo(ctx context.Context, req *pb.LookCardRequest, userId uint64) (*pb.LookCardReply, error) {
 	var (
-		user *User
-		err  error
+		card     *Card
+		err      error
+		cardType = CardTypePhysical
 	)
-	user, err = uuc.repo.GetUserById(userId)
-	if nil == user || nil != err {
-		return &pb.LookCardReply{Status: "用户不存在"}, nil
-	}
 
 	if 1 == req.SendBody.CardType {
-		err = uuc.repo.UploadCardChange(ctx, user.ID)
-		if err != nil {
-			return &pb.LookCardReply{Status: "用户不存在"}, nil
-		}
+		cardType = CardTypeVirtual
+	}
 
-	} else {
-		err = uuc.repo.UploadCardChangeTwo(ctx, user.ID)
-		if err != nil {
-			return &pb.LookCardReply{Status: "用户不存在"}, nil
-		}
+	card, err = uuc.getUserCard(ctx, userId, req.SendBody.CardId, cardType)
+	if nil == card || nil != err {
+		return &pb.LookCardReply{Status: "卡片不存在"}, nil
+	}
+
+	// 补卡
+	err = uuc.cardRepo.UpdateCardStatus(ctx, card.ID, CardStatusReplacing)
+	if err != nil {
+		return &pb.LookCardReply{Status: "卡片不存在"}, nil
 	}
+	uuc.publishCardStatus(ctx, card, CardStatusReplacing)
 
 	return &pb.LookCardReply{Status: "ok"}, nil
 }
 
 func (uuc *UserUseCase) LookCardNew(ctx context.Context, req *pb.LookCardRequest, userId uint64) (*pb.LookCardReply, error) {
 	var (
-		user *User
-		err  error
+		card     *Card
+		err      error
+		cardType = CardTypePhysical
 	)
-	user, err = uuc.repo.GetUserById(userId)
-	if nil == user || nil != err {
-		return &pb.LookCardReply{Status: "用户不存在"}, nil
-	}
 
-	// 冻结
 	if 1 == req.SendBody.CardType {
-		err = uuc.repo.UploadCardOneLock(ctx, user.ID)
-		if err != nil {
-			return &pb.LookCardReply{Status: "用户不存在"}, nil
-		}
+		cardType = CardTypeVirtual
+	}
 
-		card, err := InterlaceFreezeCard(ctx, interlaceAccountId, user.CardNumber)
-		if err != nil {
-			fmt.Println("freeze error:", err)
-			return &pb.LookCardReply{Status: "冻结虚拟卡失败"}, nil
-		}
-		fmt.Println("freeze ok, status =", card.Status) // 期望 FROZEN
-	} else {
-		err = uuc.repo.UploadCardTwoLock(ctx, user.ID)
-		if err != nil {
-			return &pb.LookCardReply{Status: "用户不存在"}, nil
-		}
+	card, err = uuc.getUserCard(ctx, userId, req.SendBody.CardId, cardType)
+	if nil == card || nil != err {
+		return &pb.LookCardReply{Status: "卡片不存在"}, nil
+	}
+
+	failed := "冻结虚拟卡失败"
+	if CardTypePhysical == card.CardType {
+		failed = "冻结实体卡失败"
+	}
 
-		card, err := InterlaceFreezeCard(ctx, interlaceAccountId, user.CardTwoNumber)
-		if err != nil {
-			fmt.Println("freeze error:", err)
-			return &pb.LookCardReply{Status: "冻结实体卡失败"}, nil
+	// 冻结，先请求发卡方，成功后再改本地状态，避免本地显示已冻结而卡片实际可用
+	if "" != card.IssuerCardId {
+		res, errFreeze := uuc.interlace.FreezeCard(ctx, card.IssuerCardId)
+		if errFreeze != nil {
+			uuc.log.Errorw("msg", "freeze error", "err", errFreeze, "user_id", userId, "card_id", card.ID)
+			return &pb.LookCardReply{Status: failed}, nil
 		}
-		fmt.Println("freeze ok, status =", card.Status) // 期望 FROZEN
+		uuc.log.Infow("msg", "freeze ok", "user_id", userId, "card_id", card.ID, "status", res.Status) // 期望 FROZEN
 	}
 
+	err = uuc.cardRepo.UpdateCardStatus(ctx, card.ID, CardStatusFrozen)
+	if err != nil {
+		// 发卡方已冻结，重试时会再次冻结后写入本地状态
+		uuc.log.Errorw("msg", "freeze update card error", "err", err, "user_id", userId, "card_id", card.ID)
+		return &pb.LookCardReply{Status: failed}, nil
+	}
+	uuc.publishCardStatus(ctx, card, CardStatusFrozen)
+	uuc.notifyCard(ctx, NotifyEventCardFrozen, card, nil)
+
 	return &pb.LookCardReply{Status: "ok"}, nil
 }
 
 func (uuc *UserUseCase) LookCard(ctx context.Context, req *pb.LookCardRequest, userId uint64) (*pb.LookCardReply, error) {
 	var (
-		user        *User
+		card        *Card
 		err         error
 		accessToken string
+		cardType    uint64
 		//carInfo *CardSensitiveResponse
 	)
-	user, err = uuc.repo.GetUserById(userId)
-	if nil == user || nil != err {
-		return &pb.LookCardReply{Status: "用户不存在"}, nil
-	}
 
 	if 1 == req.SendBody.CardType {
-		if "success" != user.CardOrderId {
-			return &pb.LookCardReply{Status: "未激活虚拟卡"}, nil
-		}
+		cardType = CardTypeVirtual
+	} else if 2 == req.SendBody.CardType {
+		cardType = CardTypePhysical
+	} else if 0 == req.SendBody.CardId {
+		return &pb.LookCardReply{Status: "查询参数错误"}, nil
+	}
 
-		if 10 > len(user.CardNumber) {
-			return &pb.LookCardReply{Status: "未激活虚拟卡"}, nil
-		}
+	card, err = uuc.getUserCard(ctx, userId, req.SendBody.CardId, cardType)
+	if nil != err {
+		return &pb.LookCardReply{Status: "查询错误"}, nil
+	}
 
-		accessToken, err = InterlaceGetCardPrivateAccessToken(ctx, interlaceAccountId, user.CardNumber)
-		if 0 >= len(accessToken) || nil != err {
-			fmt.Println(err)
-			return &pb.LookCardReply{Status: "查询错误"}, nil
-		}
-	} else if 2 == req.SendBody.CardType {
-		if 2 != user.CardTwo {
+	if nil == card || !card.Usable() {
+		if CardTypePhysical == cardType || (nil != card && CardTypePhysical == card.CardType) {
 			return &pb.LookCardReply{Status: "未激活实体卡"}, nil
 		}
 
-		if 10 > len(user.CardTwoNumber) {
-			return &pb.LookCardReply{Status: "未激活实体卡"}, nil
-		}
-		accessToken, err = InterlaceGetCardPrivateAccessToken(ctx, interlaceAccountId, user.CardTwoNumber)
-		if 0 >= len(accessToken) || nil != err {
-			fmt.Println(err)
-			return &pb.LookCardReply{Status: "查询错误"}, nil
-		}
-	} else {
-		return &pb.LookCardReply{Status: "查询参数错误"}, nil
+		return &pb.LookCardReply{Status: "未激活虚拟卡"}, nil
+	}
+
+	accessToken, err = uuc.interlace.CardPrivateAccessToken(ctx, card.IssuerCardId)
+	if 0 >= len(accessToken) || nil != err {
+		uuc.log.Errorw("msg", "获取卡片access token错误", "err", err, "user_id", userId, "card_id", card.ID)
+		return &pb.LookCardReply{Status: "查询错误"}, nil
 	}
 
 	return &pb.LookCardReply{
@@ -1652,7 +1717,22 @@ func (uuc *UserUseCase) AmountTo(ctx context.Context, req *pb.AmountToRequest, u
 		return &pb.AmountToReply{Status: "用户不存在"}, nil
 	}
 
-	if req.SendBody.Amount > uint64(user.Amount) {
+	var (
+		currency string
+		ok       bool
+		balance  float64
+	)
+	currency, ok = parseCurrency(req.SendBody.Currency)
+	if !ok {
+		return &pb.AmountToReply{Status: "币种错误"}, nil
+	}
+
+	balance, err = uuc.balanceRepo.GetBalance(ctx, userId, currency)
+	if nil != err {
+		return &pb.AmountToReply{Status: "查询余额错误"}, nil
+	}
+
+	if float64(req.SendBody.Amount) > balance {
 		return &pb.AmountToReply{Status: "账号余额不足"}, nil
 	}
 
@@ -1665,48 +1745,95 @@ func (uuc *UserUseCase) AmountTo(ctx context.Context, req *pb.AmountToRequest, u
 		return &pb.AmountToReply{Status: "目标用户不存在"}, nil
 	}
 
-	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-		err = uuc.repo.AmountTo(ctx, userId, toUser.ID, toUser.Address, float64(req.SendBody.Amount))
+	// 对方以同一币种到账，最低金额直接比较
+	fee := uuc.getFeeSchedule(ctx, FeeOpAmountTo, "0", user.Vip)
+	if fee.MinAmount > float64(req.SendBody.Amount) {
+		return &pb.AmountToReply{Status: fmt.Sprintf("转账最少%.2f", fee.MinAmount)}, nil
+	}
+
+	amountFloatSubFee := float64(req.SendBody.Amount) - fee.Calc(float64(req.SendBody.Amount))
+	if 0 >= amountFloatSubFee {
+		return &pb.AmountToReply{Status: "手续费错误"}, nil
+	}
+
+	var (
+		decision *RiskDecision
+		review   *RiskRecord
+	)
+	if err = uuc.lockedTx(ctx, []string{balanceLockKey(userId), balanceLockKey(toUser.ID)}, func(ctx context.Context) error { // 事务
+		op := &RiskOperation{
+			Operation: FeeOpAmountTo,
+			User:      user,
+			ToUser:    toUser,
+			Currency:  currency,
+			Amount:    float64(req.SendBody.Amount),
+			AmountRel: amountFloatSubFee,
+			Address:   toUser.Address,
+		}
+
+		decision, err = uuc.evaluateRisk(ctx, op)
+		if nil != err {
+			return err
+		}
+
+		review, err = uuc.applyRiskDecision(ctx, op, decision)
+		if nil != err || RiskAllow != decision.Decision {
+			return err
+		}
+
+		err = uuc.repo.AmountTo(ctx, userId, toUser.ID, toUser.Address, float64(req.SendBody.Amount), amountFloatSubFee, currency)
 		if nil != err {
 			return err
 		}
 
 		return nil
 	}); nil != err {
-		fmt.Println(err, "划转写入mysql错误", user)
+		uuc.log.Errorw("msg", "划转写入mysql错误", "err", err, "user_id", userId)
 		return &pb.AmountToReply{
 			Status: "划转错误，联系管理员",
 		}, nil
 	}
 
+	if RiskDeny == decision.Decision {
+		return &pb.AmountToReply{Status: decision.Status()}, nil
+	}
+
+	uuc.publishBalance(ctx, userId)
+	if nil != review {
+		uuc.notifyRiskHold(ctx, review)
+		return &pb.AmountToReply{Status: "ok", ReviewId: review.ID}, nil
+	}
+	uuc.publishBalance(ctx, toUser.ID)
+
 	return &pb.AmountToReply{
 		Status: "ok",
 	}, nil
 }
 
-func (uuc *UserUseCase) CodeList(ctx context.Context, req *pb.CodeListRequest, userId uint64) (*pb.CodeListReply, error) {
+// CodeList 验证码记录，超过 ttl 的不返回验证码
+func (uuc *UserUseCase) CodeList(ctx context.Context, req *pb.CodeListRequest, userId uint64, ttl time.Duration) (*pb.CodeListReply, error) {
 	res := make([]*pb.CodeListReply_List, 0)
 
 	var (
-		user     *User
+		card     *Card
 		err      error
 		count    int64
 		cardNum  string
 		codeList []*CardOrder
+		cardType = CardTypeVirtual
 	)
-	user, err = uuc.repo.GetUserById(userId)
-	if nil == user || nil != err {
-		return &pb.CodeListReply{Status: "用户不存在"}, nil
-	}
 
 	if 2 == req.Num {
-		if 5 < len(user.CardNumberRelTwo) {
-			cardNum = MaskCard8_6_4(user.CardNumberRelTwo)
-		}
-	} else {
-		if 5 < len(user.CardNumberRel) {
-			cardNum = MaskCard8_6_4(user.CardNumberRel)
-		}
+		cardType = CardTypePhysical
+	}
+
+	card, err = uuc.getUserCard(ctx, userId, req.CardId, cardType)
+	if nil != err {
+		return &pb.CodeListReply{Status: "查询卡片错误"}, nil
+	}
+
+	if nil != card {
+		cardNum = card.MaskedPan
 	}
 
 	if 5 >= len(cardNum) {
@@ -1729,11 +1856,20 @@ func (uuc *UserUseCase) CodeList(ctx context.Context, req *pb.CodeListRequest, u
 		}, err
 	}
 
+	ttl = codeTTL(ttl)
 	for _, v := range codeList {
-		res = append(res, &pb.CodeListReply_List{
+		if nil == v.Time {
+			continue
+		}
+
+		tmp := &pb.CodeListReply_List{
 			CreatedAt: v.Time.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
 			Code:      v.Code,
-		})
+		}
+		if time.Since(*v.Time) > ttl {
+			tmp.Code, tmp.Expired = "", true
+		}
+		res = append(res, tmp)
 	}
 
 	return &pb.CodeListReply{List: res, Count: uint64(count), Status: "ok"}, nil
@@ -1774,38 +1910,81 @@ func onlyDigits(s string) string {
 
 func (uuc *UserUseCase) Withdraw(ctx context.Context, req *pb.WithdrawRequest, userId uint64) (*pb.WithdrawReply, error) {
 	var (
-		user         *User
-		err          error
-		configs      []*Config
-		withdrawRate float64
+		user *User
+		err  error
 	)
 
-	// 配置
-	configs, err = uuc.repo.GetConfigByKeys("withdraw_rate")
-	if nil != configs {
-		for _, vConfig := range configs {
-			if "withdraw_rate" == vConfig.KeyName {
-				withdrawRate, _ = strconv.ParseFloat(vConfig.Value, 10)
-			}
-		}
-	}
-
 	user, err = uuc.repo.GetUserById(userId)
 	if nil == user || nil != err {
 		return &pb.WithdrawReply{Status: "用户不存在"}, nil
 	}
 
-	if req.SendBody.Amount > uint64(user.Amount) {
+	var (
+		currency string
+		ok       bool
+		balance  float64
+	)
+	currency, ok = parseCurrency(req.SendBody.Currency)
+	if !ok {
+		return &pb.WithdrawReply{Status: "币种错误"}, nil
+	}
+
+	// 法币备付金只能划转到卡，不能链上提现
+	if CurrencyUSD == currency {
+		return &pb.WithdrawReply{Status: "该币种不支持提现"}, nil
+	}
+
+	address, chain, status := uuc.withdrawTarget(ctx, user, req.SendBody.AddressId)
+	if "" != status {
+		return &pb.WithdrawReply{Status: status}, nil
+	}
+
+	balance, err = uuc.balanceRepo.GetBalance(ctx, userId, currency)
+	if nil != err {
+		return &pb.WithdrawReply{Status: "查询余额错误"}, nil
+	}
+
+	if float64(req.SendBody.Amount) > balance {
 		return &pb.WithdrawReply{Status: "账号余额不足"}, nil
 	}
 
-	amountFloatSubFee := float64(req.SendBody.Amount) - float64(req.SendBody.Amount)*withdrawRate
+	// 链上以同一币种到账，最低金额直接比较
+	fee := uuc.getFeeSchedule(ctx, FeeOpWithdraw, "0", user.Vip)
+	if fee.MinAmount > float64(req.SendBody.Amount) {
+		return &pb.WithdrawReply{Status: fmt.Sprintf("提现最少%.2f", fee.MinAmount)}, nil
+	}
+
+	amountFloatSubFee := float64(req.SendBody.Amount) - fee.Calc(float64(req.SendBody.Amount))
 	if 0 >= amountFloatSubFee {
 		return &pb.WithdrawReply{Status: "手续费错误"}, nil
 	}
 
-	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-		err = uuc.repo.Withdraw(ctx, userId, float64(req.SendBody.Amount), amountFloatSubFee, user.Address)
+	var (
+		decision *RiskDecision
+		review   *RiskRecord
+	)
+	if err = uuc.lockedTx(ctx, []string{balanceLockKey(userId)}, func(ctx context.Context) error { // 事务
+		op := &RiskOperation{
+			Operation: FeeOpWithdraw,
+			User:      user,
+			Currency:  currency,
+			Amount:    float64(req.SendBody.Amount),
+			AmountRel: amountFloatSubFee,
+			Address:   address,
+			Chain:     chain,
+		}
+
+		decision, err = uuc.evaluateRisk(ctx, op)
+		if nil != err {
+			return err
+		}
+
+		review, err = uuc.applyRiskDecision(ctx, op, decision)
+		if nil != err || RiskAllow != decision.Decision {
+			return err
+		}
+
+		err = uuc.repo.Withdraw(ctx, userId, float64(req.SendBody.Amount), amountFloatSubFee, address, chain, currency)
 		if nil != err {
 			return err
 		}
@@ -1817,11 +1996,44 @@ func (uuc *UserUseCase) Withdraw(ctx context.Context, req *pb.WithdrawRequest, u
 		}, nil
 	}
 
+	if RiskDeny == decision.Decision {
+		return &pb.WithdrawReply{Status: decision.Status()}, nil
+	}
+
+	uuc.publishBalance(ctx, userId)
+	if nil != review {
+		uuc.notifyRiskHold(ctx, review)
+		return &pb.WithdrawReply{Status: "ok", ReviewId: review.ID}, nil
+	}
+	uuc.notifier.Notify(ctx, userId, NotifyEventWithdraw, map[string]interface{}{
+		"Amount":    strconv.FormatUint(req.SendBody.Amount, 10),
+		"AmountRel": strconv.FormatFloat(amountFloatSubFee, 'f', 2, 64),
+		"Currency":  currency,
+		"Address":   address,
+	})
+
 	return &pb.WithdrawReply{
 		Status: "ok",
 	}, nil
 }
 
+// UploadAudited 上传证件照片并写审计日志，操作人为签名地址对应的用户，请求记录照片序号
+func (uuc *UserUseCase) UploadAudited(ctx transporthttp.Context, entry *AuditLog, address string) error {
+	if user, err := uuc.repo.GetUserByAddress(address); nil == err && nil != user {
+		entry.ActorId = user.ID
+	}
+
+	_, err := Audited(ctx, uuc, entry, "", wrapperspb.String(ctx.Request().FormValue("num")), func() (*wrapperspb.StringValue, error) {
+		if err := uuc.Upload(ctx); nil != err {
+			return nil, err
+		}
+
+		return wrapperspb.String("ok"), nil
+	})
+
+	return err
+}
+
 func (uuc *UserUseCase) Upload(ctx transporthttp.Context) (err error) {
 
 	return nil
@@ -1997,7 +2209,7 @@ func CreateCardRequestWithSign() (*CreateCardResponse, error) {
 
 	//fmt.Println("请求报文:", string(jsonData))
 
-	client := &http.Client{}
+	client := &http.Client{Transport: issuerTransport}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -2014,7 +2226,7 @@ func CreateCardRequestWithSign() (*CreateCardResponse, error) {
 		return nil, err
 	}
 
-	fmt.Println("响应报文:", string(body)) // ← 打印响应内容
+	issuerLog.Debugw("msg", "响应报文", "body", string(body))
 
 	var result *CreateCardResponse
 	if err = json.Unmarshal(body, result); err != nil {
@@ -2090,7 +2302,7 @@ func CreateCardholderRequest(productId uint64, user *User) (*CreateCardholderRes
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Content-Language", "zh_CN")
 
-	client := &http.Client{}
+	client := &http.Client{Transport: issuerTransport}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("http do error: %v", err)
@@ -2103,7 +2315,7 @@ func CreateCardholderRequest(productId uint64, user *User) (*CreateCardholderRes
 	}(resp.Body)
 
 	body, _ := io.ReadAll(resp.Body)
-	fmt.Println("响应报文:", string(body))
+	issuerLog.Debugw("msg", "响应报文", "body", string(body))
 
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("http status not ok: %v", resp.StatusCode)
@@ -2156,7 +2368,7 @@ func UpdateCardholderRequest(productId uint64, user *User) (*CreateCardholderRes
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Content-Language", "zh_CN")
 
-	client := &http.Client{}
+	client := &http.Client{Transport: issuerTransport}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("http do error: %v", err)
@@ -2169,7 +2381,7 @@ func UpdateCardholderRequest(productId uint64, user *User) (*CreateCardholderRes
 	}(resp.Body)
 
 	body, _ := io.ReadAll(resp.Body)
-	fmt.Println("响应报文:", string(body))
+	issuerLog.Debugw("msg", "响应报文", "body", string(body))
 
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("http status not ok: %v", resp.StatusCode)
@@ -2233,7 +2445,8 @@ func GetCardProducts() (*CardProductListResponse, error) {
 	req.Header.Set("Content-Language", "zh_CN")
 
 	client := &http.Client{
-		Timeout: 10 * time.Second,
+		Transport: issuerTransport,
+		Timeout:   10 * time.Second,
 	}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -2250,12 +2463,12 @@ func GetCardProducts() (*CardProductListResponse, error) {
 	if err != nil {
 		return nil, err
 	}
-	//fmt.Println("响应报文:", string(body))
+	//issuerLog.Debugw("msg", "响应报文", "body", string(body))
 
 	var result CardProductListResponse
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		fmt.Println("JSON 解析失败:", err)
+		issuerLog.Errorw("msg", "JSON 解析失败", "err", err)
 		return nil, err
 	}
 
@@ -2292,7 +2505,7 @@ func GetCardSensitiveInfo(cardId string) (*CardSensitiveResponse, error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Content-Language", "zh_CN")
 
-	client := &http.Client{}
+	client := &http.Client{Transport: issuerTransport}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -2309,11 +2522,11 @@ func GetCardSensitiveInfo(cardId string) (*CardSensitiveResponse, error) {
 		return nil, fmt.Errorf("HTTP请求失败: %s", string(body))
 	}
 
-	//fmt.Println("响应报文:", string(body))
+	//issuerLog.Debugw("msg", "响应报文", "body", string(body))
 
 	var result CardSensitiveResponse
 	if err = json.Unmarshal(body, &result); err != nil {
-		fmt.Println("敏感信息 JSON 解析失败:", err)
+		issuerLog.Errorw("msg", "敏感信息 JSON 解析失败", "err", err)
 		return nil, err
 	}
 
@@ -2352,7 +2565,7 @@ func RechargeCard(cardId string, rechargeAmount uint64) (*CardRechargeResponse,
 	req, _ := http.NewRequest("POST", baseUrl, bytes.NewBuffer(jsonData))
 	req.Header.Set("Content-Type", "application/json")
 
-	client := &http.Client{}
+	client := &http.Client{Transport: issuerTransport}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -2364,11 +2577,11 @@ func RechargeCard(cardId string, rechargeAmount uint64) (*CardRechargeResponse,
 		return nil, fmt.Errorf("HTTP请求失败: %s", string(body))
 	}
 
-	fmt.Println("响应报文:", string(body))
+	issuerLog.Debugw("msg", "响应报文", "body", string(body))
 
 	var result CardRechargeResponse
 	if err = json.Unmarshal(body, &result); err != nil {
-		fmt.Println("充值响应解析失败:", err)
+		issuerLog.Errorw("msg", "充值响应解析失败", "err", err)
 		return nil, err
 	}
 
@@ -2432,7 +2645,7 @@ func GetCardTransactionList(cardId, pageNum, pageSize uint64) (*CardTransactionL
 	}
 	req.Header.Set("Content-Language", "zh_CN")
 
-	client := &http.Client{}
+	client := &http.Client{Transport: issuerTransport}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -2450,12 +2663,12 @@ func GetCardTransactionList(cardId, pageNum, pageSize uint64) (*CardTransactionL
 		return nil, err
 	}
 
-	//fmt.Println("响应报文:", string(body))
+	//issuerLog.Debugw("msg", "响应报文", "body", string(body))
 
 	// 5. 解析响应
 	var result CardTransactionListResponse
 	if err = json.Unmarshal(body, &result); err != nil {
-		fmt.Println("JSON 解析失败:", err)
+		issuerLog.Errorw("msg", "JSON 解析失败", "err", err)
 		return nil, err
 	}
 
@@ -2493,7 +2706,7 @@ func GetCardInfoRequestWithSign(cardId string) (*CardInfoResponse, error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Content-Language", "zh_CN")
 
-	client := &http.Client{}
+	client := &http.Client{Transport: issuerTransport}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -2510,829 +2723,26 @@ func GetCardInfoRequestWithSign(cardId string) (*CardInfoResponse, error) {
 		return nil, fmt.Errorf("request failed: %s", string(body))
 	}
 
-	//fmt.Println("响应报文:", string(body))
+	//issuerLog.Debugw("msg", "响应报文", "body", string(body))
 
 	var result CardInfoResponse
 	if err = json.Unmarshal(body, &result); err != nil {
-		fmt.Println("卡信息 JSON 解析失败:", err)
+		issuerLog.Errorw("msg", "卡信息 JSON 解析失败", "err", err)
 		return nil, err
 	}
 
 	return &result, nil
 }
 
-// ================= Interlace 授权配置 & 缓存 =================
-
-const (
-	interlaceBaseURL      = "https://api-sandbox.interlace.money/open-api/v3"
-	interlaceClientID     = "interlacedc0330757f216112"
-	interlaceClientSecret = "c0d8019217ad4903bf09336320a4ddd9" // v3 的接口目前用不到 secret，但建议以后放到配置/环境变量
-	interlaceAccountId    = "571795"
-)
-
-// 缓存在当前进程里，如果你将来多实例部署/重启频繁，可以再扩展成 Redis 存储
-type interlaceAuthCache struct {
-	AccessToken  string
-	RefreshToken string
-	ExpireAt     int64 // unix 秒，提前留一点余量
-}
-
-var (
-	interlaceAuth    = &interlaceAuthCache{}
-	interlaceAuthMux sync.Mutex
-)
-
-// GetInterlaceAccessToken 获取一个当前可用的 accessToken
-// 1. 如果缓存里有且没过期，直接返回
-// 2. 否则调用 GetCode + Generate Access Token 重新获取
-func GetInterlaceAccessToken(ctx context.Context) (string, error) {
-	interlaceAuthMux.Lock()
-	defer interlaceAuthMux.Unlock()
-
-	now := time.Now().Unix()
-	// 缓存未过期，直接用（提前 60 秒过期，避免边界）
-	if 0 >= len(interlaceAuth.AccessToken) && now < interlaceAuth.ExpireAt-60 {
-		return interlaceAuth.AccessToken, nil
-	}
-
-	// 这里可以先尝试用 refreshToken 刷新（如果你想用 refresh-token 接口）
-	// 为了简单稳定，这里直接重新 Get Code + Access Token
-	code, err := interlaceGetCode(ctx)
-	if err != nil {
-		return "", fmt.Errorf("get interlace code failed: %w", err)
-	}
-
-	accessToken, refreshToken, expiresIn, t, err := interlaceGenerateAccessToken(ctx, code)
-	if err != nil {
-		return "", fmt.Errorf("generate interlace access token failed: %w", err)
-	}
-
-	if 0 >= len(accessToken) {
-		return "", nil
-	}
-
-	interlaceAuth.AccessToken = accessToken
-	interlaceAuth.RefreshToken = refreshToken
-	interlaceAuth.ExpireAt = t + expiresIn
-
-	return accessToken, nil
-}
-
-// Get a code 响应结构
-type interlaceGetCodeResp struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-	Data    struct {
-		Timestamp int64  `json:"timestamp"`
-		Code      string `json:"code"`
-	} `json:"data"`
-}
-
-func interlaceGetCode(ctx context.Context) (string, error) {
-	urlStr := fmt.Sprintf("%s/oauth/authorize?clientId=%s", interlaceBaseURL, interlaceClientID)
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
-	if err != nil {
-		return "", err
-	}
-	req.Header.Set("Accept", "application/json")
-
-	client := &http.Client{Timeout: 10 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", err
-	}
-	defer func(Body io.ReadCloser) {
-		_ = Body.Close()
-	}(resp.Body)
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return "", err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("interlace get code http %d: %s", resp.StatusCode, string(body))
-	}
-
-	var result interlaceGetCodeResp
-	if err := json.Unmarshal(body, &result); err != nil {
-		return "", fmt.Errorf("interlace get code unmarshal: %w", err)
-	}
-
-	if result.Code != "000000" {
-		return "", fmt.Errorf("interlace get code failed: code=%s msg=%s", result.Code, result.Message)
-	}
-	if result.Data.Code == "" {
-		return "", fmt.Errorf("interlace get code success but orderId empty")
-	}
-
-	return result.Data.Code, nil
-}
-
-// Generate an access token 响应结构
-type interlaceAccessTokenResp struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-	Data    struct {
-		AccessToken  string `json:"accessToken"`
-		RefreshToken string `json:"refreshToken"`
-		ExpiresIn    int64  `json:"expiresIn"` // 有效期秒数，比如 86400
-		Timestamp    int64  `json:"timestamp"`
-	} `json:"data"`
-}
-
-func interlaceGenerateAccessToken(ctx context.Context, code string) (accessToken, refreshToken string, expiresIn, t int64, err error) {
-	urlStr := fmt.Sprintf("%s/oauth/access-token", interlaceBaseURL)
-
-	reqBody := map[string]interface{}{
-		"clientId": interlaceClientID,
-		"code":     code,
-	}
-	jsonData, _ := json.Marshal(reqBody)
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, urlStr, bytes.NewReader(jsonData))
-	if err != nil {
-		return "", "", 0, 0, err
-	}
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-
-	client := &http.Client{Timeout: 10 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", "", 0, 0, err
-	}
-	defer func(Body io.ReadCloser) {
-		_ = Body.Close()
-	}(resp.Body)
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return "", "", 0, 0, err
-	}
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return "", "", 0, 0, fmt.Errorf("interlace access-token http %d: %s", resp.StatusCode, string(body))
-	}
-
-	var result interlaceAccessTokenResp
-	if err := json.Unmarshal(body, &result); err != nil {
-		return "", "", 0, 0, fmt.Errorf("interlace access-token unmarshal: %w", err)
-	}
-
-	if result.Code != "000000" {
-		return "", "", 0, 0, fmt.Errorf("interlace access-token failed: code=%s msg=%s", result.Code, result.Message)
-	}
-	if result.Data.AccessToken == "" {
-		return "", "", 0, 0, fmt.Errorf("interlace access-token success but accessToken empty")
-	}
-
-	return result.Data.AccessToken, result.Data.RefreshToken, result.Data.ExpiresIn, result.Data.Timestamp, nil
-}
-
-type InterlaceCardPrivateTokenResp struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-	Data    struct {
-		AccessToken string `json:"accessToken"`
-	} `json:"data"`
-}
-
-// InterlaceGetCardPrivateAccessToken 获取某张卡的 iframe 用一次性 accessToken
-func InterlaceGetCardPrivateAccessToken(ctx context.Context, accountId, cardId string) (string, error) {
-	if accountId == "" {
-		return "", fmt.Errorf("accountId is required")
-	}
-	if cardId == "" {
-		return "", fmt.Errorf("cardId is required")
-	}
-
-	accessToken, err := GetInterlaceAccessToken(ctx)
-	if err != nil || accessToken == "" {
-		fmt.Println("获取access token错误")
-		return "", err
-	}
-
-	//fmt.Println(accessToken)
-	base := interlaceBaseURL + "/cards/" + cardId + "/private-info/access-token"
-
-	//fmt.Println(base, accessToken)
-
-	q := url.Values{}
-	q.Set("accountId", accountId)
-	urlStr := base + "?" + q.Encode()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
-	if err != nil {
-		return "", err
-	}
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("x-access-token", accessToken)
-
-	client := &http.Client{Timeout: 10 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", err
-	}
-	defer resp.Body.Close()
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return "", err
-	}
-
-	//fmt.Println(string(body))
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		fmt.Println("at", interlaceAuth, time.Now().Unix())
-		return "", fmt.Errorf("interlace card private token http %d: %s", resp.StatusCode, string(body))
-	}
-
-	var outer InterlaceCardPrivateTokenResp
-	if err := json.Unmarshal(body, &outer); err != nil {
-		return "", fmt.Errorf("card private token unmarshal: %w", err)
-	}
-	if outer.Code != "000000" {
-		return "", fmt.Errorf("card private token failed: code=%s msg=%s", outer.Code, outer.Message)
-	}
-
-	return outer.Data.AccessToken, nil
-}
-
-type InterlaceFeeDetail struct {
-	Amount   string `json:"amount"`
-	Currency string `json:"currency"`
-	FeeType  string `json:"feeType"`
-}
-
-type InterlaceCardTransferData struct {
-	ID                       string               `json:"id"`
-	AccountId                string               `json:"accountId"`
-	CardId                   string               `json:"cardId"`
-	CardholderId             string               `json:"cardholderId"`
-	CardTransactionId        string               `json:"cardTransactionId"`
-	Currency                 string               `json:"currency"`
-	Amount                   string               `json:"amount"`
-	Fee                      string               `json:"fee"`
-	FeeDetails               []InterlaceFeeDetail `json:"feeDetails"`
-	ClientTransactionId      string               `json:"clientTransactionId"`
-	RelatedCardTransactionId string               `json:"relatedCardTransactionId"`
-	TransactionDisplayId     string               `json:"transactionDisplayId"`
-	Type                     int32                `json:"type"`
-	Status                   string               `json:"status"`
-
-	MerchantName    string `json:"merchantName"`
-	Mcc             string `json:"mcc"`
-	MccCategory     string `json:"mccCategory"`
-	MerchantCity    string `json:"merchantCity"`
-	MerchantCountry string `json:"merchantCountry"`
-	MerchantState   string `json:"merchantState"`
-	MerchantZipcode string `json:"merchantZipcode"`
-	MerchantMid     string `json:"merchantMid"`
-
-	TransactionTime     string `json:"transactionTime"`
-	TransactionCurrency string `json:"transactionCurrency"`
-	TransactionAmount   string `json:"transactionAmount"`
-	CreateTime          string `json:"createTime"`
-	Remark              string `json:"remark"`
-	Detail              string `json:"detail"`
-}
-
-type InterlaceCardTransferInReq struct {
-	AccountId           string `json:"accountId"`           // 账户 UUID
-	CardId              string `json:"cardId"`              // 卡 UUID
-	ClientTransactionId string `json:"clientTransactionId"` // 自定义交易 ID
-	Amount              string `json:"amount"`              // 划转金额（字符串）
-}
-
-// 外层响应
-type InterlaceCardTransferInResp struct {
-	Code    string                    `json:"code"`
-	Message string                    `json:"message"`
-	Data    InterlaceCardTransferData `json:"data"`
-}
-
-// InterlaceCardTransferIn 预付卡划转入（从 Quantum 账户到卡）
-func InterlaceCardTransferIn(ctx context.Context, in *InterlaceCardTransferInReq) (*InterlaceCardTransferData, error) {
-	if in == nil {
-		return nil, fmt.Errorf("transfer in req is nil")
-	}
-	if in.AccountId == "" {
-		return nil, fmt.Errorf("accountId is required")
-	}
-	if in.CardId == "" {
-		return nil, fmt.Errorf("cardId is required")
-	}
-	if in.ClientTransactionId == "" {
-		return nil, fmt.Errorf("clientTransactionId is required")
-	}
-	if in.Amount == "" {
-		return nil, fmt.Errorf("amount is required")
-	}
-
-	accessToken, err := GetInterlaceAccessToken(ctx)
-	if err != nil || accessToken == "" {
-		fmt.Println("获取access token错误")
-		return nil, err
-	}
-
-	// interlaceBaseURL 建议: https://api-sandbox.interlace.money/open-api/v3
-	base := interlaceBaseURL + "/cards/transfer-in"
-
-	bodyBytes, err := json.Marshal(in)
-	if err != nil {
-		return nil, fmt.Errorf("marshal transfer in body: %w", err)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, base, bytes.NewReader(bodyBytes))
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("x-access-token", accessToken)
-
-	client := &http.Client{Timeout: 10 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	fmt.Println("transfer-in resp:", string(respBody))
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		fmt.Println("at", interlaceAuth, time.Now().Unix())
-		return nil, fmt.Errorf("interlace transfer in http %d: %s", resp.StatusCode, string(respBody))
-	}
-
-	var outer InterlaceCardTransferInResp
-	if err := json.Unmarshal(respBody, &outer); err != nil {
-		return nil, fmt.Errorf("transfer in unmarshal: %w", err)
-	}
-	if outer.Code != "000000" {
-		return nil, fmt.Errorf("transfer in failed: code=%s msg=%s", outer.Code, outer.Message)
-	}
-
-	return &outer.Data, nil
-}
-
-// /cards/{id}/card-summary 返回体
-type InterlaceCardSummaryResp struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-	Data    struct {
-		CardId    string `json:"cardId"`
-		AccountId string `json:"accountId"`
-
-		Balance struct {
-			ID        string `json:"id"`
-			Available string `json:"available"`
-			Currency  string `json:"currency"`
-		} `json:"balance"`
-
-		Statistics struct {
-			Consumption    string `json:"consumption"`
-			Reversal       string `json:"reversal"`
-			ReversalFee    string `json:"reversalFee"`
-			Refund         string `json:"refund"`
-			RefundFee      string `json:"refundFee"`
-			NetConsumption string `json:"netConsumption"`
-			Currency       string `json:"currency"`
-		} `json:"statistics"`
-
-		VelocityControl struct {
-			Type      string `json:"type"` // DAY/WEEK/MONTH/.../NA
-			Limit     string `json:"limit"`
-			Available string `json:"available"`
-		} `json:"velocityControl"`
-	} `json:"data"`
-}
-
-// InterlaceGetCardSummary 获取卡片 summary（余额/统计/限额）
-func InterlaceGetCardSummary(ctx context.Context, accountId, cardId string) (*InterlaceCardSummaryResp, error) {
-	if accountId == "" {
-		return nil, fmt.Errorf("accountId is required")
-	}
-	if cardId == "" {
-		return nil, fmt.Errorf("cardId is required")
-	}
-
-	accessToken, err := GetInterlaceAccessToken(ctx)
-	if err != nil || accessToken == "" {
-		fmt.Println("获取access token错误")
-		return nil, err
-	}
-
-	// interlaceBaseURL 建议: https://api-sandbox.interlace.money/open-api/v3
-	base := interlaceBaseURL + "/cards/" + cardId + "/card-summary"
-
-	q := url.Values{}
-	q.Set("accountId", accountId)
-	urlStr := base + "?" + q.Encode()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("x-access-token", accessToken)
-
-	client := &http.Client{Timeout: 10 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	// 方便你调试
-	// fmt.Println("card-summary resp:", string(body))
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-
-		fmt.Println("at", interlaceAuth, time.Now().Unix())
-		return nil, fmt.Errorf("interlace card summary http %d: %s", resp.StatusCode, string(body))
-	}
-
-	var outer InterlaceCardSummaryResp
-	if err := json.Unmarshal(body, &outer); err != nil {
-		return nil, fmt.Errorf("card summary unmarshal: %w", err)
-	}
-	if outer.Code != "000000" {
-		return nil, fmt.Errorf("card summary failed: code=%s msg=%s", outer.Code, outer.Message)
-	}
-
-	return &outer, nil
-}
-
-type InterlaceTxnListReq struct {
-	AccountId string // 必填
-
-	ID                  string // transaction id
-	ClientTransactionId string
-	CardId              string
-	Type                string // "0".."14"
-	Status              string // CLOSED/PENDING/FAIL
-
-	StartTime string // timestamp（按文档写 string，直接透传）
-	EndTime   string // timestamp
-
-	Limit int // 1-100 默认 10
-	Page  int // >=1 默认 1
-}
-
-type InterlaceTransaction struct {
-	ID                string `json:"id"`
-	AccountId         string `json:"accountId"`
-	CardId            string `json:"cardId"`
-	CardholderId      string `json:"cardholderId"`
-	CardTransactionId string `json:"cardTransactionId"`
-
-	Currency string `json:"currency"`
-	Amount   string `json:"amount"`
-	Fee      string `json:"fee"`
-
-	FeeDetails []InterlaceFeeDetail `json:"feeDetails"`
-
-	ClientTransactionId      string `json:"clientTransactionId"`
-	RelatedCardTransactionId string `json:"relatedCardTransactionId"`
-	TransactionDisplayId     string `json:"transactionDisplayId"`
-
-	Type   int32  `json:"type"`
-	Status string `json:"status"`
-
-	MerchantName    string `json:"merchantName"`
-	Mcc             string `json:"mcc"`
-	MccCategory     string `json:"mccCategory"`
-	MerchantCity    string `json:"merchantCity"`
-	MerchantCountry string `json:"merchantCountry"`
-	MerchantState   string `json:"merchantState"`
-	MerchantZipcode string `json:"merchantZipcode"`
-	MerchantMid     string `json:"merchantMid"`
-
-	TransactionTime     string `json:"transactionTime"`
-	TransactionCurrency string `json:"transactionCurrency"`
-	TransactionAmount   string `json:"transactionAmount"`
-	CreateTime          string `json:"createTime"`
-
-	Remark string `json:"remark"`
-	Detail string `json:"detail"`
-}
-
-type InterlaceTxnListResp struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-	Data    struct {
-		List  []InterlaceTransaction `json:"list"`
-		Total string                 `json:"total"`
-	} `json:"data"`
-}
-
-// InterlaceListTransactions 拉交易流水
-func InterlaceListTransactions(ctx context.Context, in *InterlaceTxnListReq) ([]*InterlaceTransaction, string, error) {
-	if in == nil {
-		return nil, "", fmt.Errorf("txn list req is nil")
-	}
-	if in.AccountId == "" {
-		return nil, "", fmt.Errorf("accountId is required")
-	}
-
-	accessToken, err := GetInterlaceAccessToken(ctx)
-	if err != nil || accessToken == "" {
-		fmt.Println("获取access token错误")
-		return nil, "", err
-	}
-
-	// interlaceBaseURL 建议: https://api-sandbox.interlace.money/open-api/v3
-	base := interlaceBaseURL + "/cards/transaction-list"
-
-	q := url.Values{}
-	q.Set("accountId", in.AccountId)
-
-	if in.ID != "" {
-		q.Set("id", in.ID)
-	}
-	if in.ClientTransactionId != "" {
-		q.Set("clientTransactionId", in.ClientTransactionId)
-	}
-	if in.CardId != "" {
-		q.Set("cardId", in.CardId)
-	}
-	if in.Type != "" {
-		q.Set("type", in.Type)
-	}
-	if in.Status != "" {
-		q.Set("status", in.Status)
-	}
-	if in.StartTime != "" {
-		q.Set("startTime", in.StartTime)
-	}
-	if in.EndTime != "" {
-		q.Set("endTime", in.EndTime)
-	}
-
-	limit := in.Limit
-	if limit <= 0 {
-		limit = 10
-	}
-	page := in.Page
-	if page <= 0 {
-		page = 1
-	}
-	q.Set("limit", fmt.Sprintf("%d", limit))
-	q.Set("page", fmt.Sprintf("%d", page))
-
-	urlStr := base + "?" + q.Encode()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
-	if err != nil {
-		return nil, "", err
-	}
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("x-access-token", accessToken)
-
-	client := &http.Client{Timeout: 10 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, "", err
-	}
-	defer resp.Body.Close()
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, "", err
-	}
-
-	// fmt.Println("txn-list resp:", string(body))
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		fmt.Println("at", interlaceAuth, time.Now().Unix())
-		return nil, "", fmt.Errorf("interlace txn list http %d: %s", resp.StatusCode, string(body))
-	}
-
-	var outer InterlaceTxnListResp
-	if err := json.Unmarshal(body, &outer); err != nil {
-		return nil, "", fmt.Errorf("txn list unmarshal: %w", err)
-	}
-	if outer.Code != "000000" {
-		return nil, "", fmt.Errorf("txn list failed: code=%s msg=%s", outer.Code, outer.Message)
-	}
-
-	res := make([]*InterlaceTransaction, 0, len(outer.Data.List))
-	for i := range outer.Data.List {
-		t := outer.Data.List[i]
-		res = append(res, &t)
-	}
-
-	return res, outer.Data.Total, nil
-}
-
-type InterlaceFreezeCardReq struct {
-	AccountId string `json:"accountId"`
-}
-
-type InterlaceFreezeCardResp struct {
-	Code    string        `json:"code"`
-	Message string        `json:"message"`
-	Data    InterlaceCard `json:"data"` // 你之前那个卡结构体，含 status 等字段
-}
-
-// 单张卡片信息（输出）
-type InterlaceCard struct {
-	ID        string `json:"id"`
-	AccountID string `json:"accountId"`
-	Status    string `json:"status"`   // INACTIVE, CONTROL, ACTIVE, PENDING, FROZEN
-	Currency  string `json:"currency"` // 货币代码
-	Bin       string `json:"bin"`
-
-	UserName     string `json:"userName"`
-	CreateTime   string `json:"createTime"`
-	CardLastFour string `json:"cardLastFour"`
-
-	BillingAddress *InterlaceBillingAddress `json:"billingAddress"`
-
-	Label        string `json:"label"`
-	BalanceID    string `json:"balanceId"`
-	BudgetID     string `json:"budgetId"`
-	CardholderID string `json:"cardholderId"`
-	ReferenceID  string `json:"referenceId"`
-
-	CardMode string `json:"cardMode"` // PHYSICAL_CARD / VIRTUAL_CARD
-
-	TransactionLimits []InterlaceTransactionLimit `json:"transactionLimits"`
-}
-
-// 账单地址
-type InterlaceBillingAddress struct {
-	AddressLine1 string `json:"addressLine1,omitempty"`
-	AddressLine2 string `json:"addressLine2,omitempty"`
-	City         string `json:"city,omitempty"`
-	State        string `json:"state,omitempty"`
-	PostalCode   string `json:"postalCode,omitempty"`
-	Country      string `json:"country,omitempty"`
-}
-
-// 单个额度限制
-type InterlaceTransactionLimit struct {
-	Type     string `json:"type"`     // DAY/WEEK/MONTH/QUARTER/YEAR/LIFETIME/TRANSACTION/NA
-	Value    string `json:"value"`    // 金额（字符串）
-	Currency string `json:"currency"` // 货币
-}
-
-// InterlaceFreezeCard 冻结卡片（返回卡详情，status 应该变成 FROZEN）
-func InterlaceFreezeCard(ctx context.Context, accountId, cardId string) (*InterlaceCard, error) {
-	if accountId == "" {
-		return nil, fmt.Errorf("accountId is required")
-	}
-	if cardId == "" {
-		return nil, fmt.Errorf("cardId is required")
-	}
-
-	accessToken, err := GetInterlaceAccessToken(ctx)
-	if err != nil || accessToken == "" {
-		fmt.Println("获取access token错误")
-		return nil, err
-	}
-
-	// interlaceBaseURL 建议为: https://api-sandbox.interlace.money/open-api/v3
-	urlStr := interlaceBaseURL + "/cards/" + cardId + "/freeze"
-
-	// body: { "accountId": "..." }
-	reqBody := &InterlaceFreezeCardReq{AccountId: accountId}
-	bs, err := json.Marshal(reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("freeze card marshal: %w", err)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, urlStr, bytes.NewReader(bs))
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("x-access-token", accessToken)
-
-	client := &http.Client{Timeout: 10 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	// fmt.Println("freeze resp:", string(body))
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		fmt.Println("at", interlaceAuth, time.Now().Unix())
-		return nil, fmt.Errorf("interlace freeze card http %d: %s", resp.StatusCode, string(body))
-	}
-
-	var outer InterlaceFreezeCardResp
-	if err := json.Unmarshal(body, &outer); err != nil {
-		return nil, fmt.Errorf("freeze card unmarshal: %w", err)
-	}
-	if outer.Code != "000000" {
-		return nil, fmt.Errorf("freeze card failed: code=%s msg=%s", outer.Code, outer.Message)
-	}
-
-	return &outer.Data, nil
-}
-
-type InterlaceSetCardPinReq struct {
-	Pin       string `json:"pin"`       // 6位数字字符串
-	AccountId string `json:"accountId"` // 账户UUID
-}
-
-type InterlaceSetCardPinResp struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-	Data    struct {
-		Success bool `json:"success"`
-	} `json:"data"`
-}
-
-// InterlaceSetCardPin 设置卡片 PIN（交易PIN/ATM PIN）
-func InterlaceSetCardPin(ctx context.Context, cardId string, in *InterlaceSetCardPinReq) (bool, error) {
-	if cardId == "" {
-		return false, fmt.Errorf("cardId is required")
-	}
-	if in == nil {
-		return false, fmt.Errorf("set pin req is nil")
-	}
-	if in.AccountId == "" {
-		return false, fmt.Errorf("accountId is required")
-	}
-	if in.Pin == "" {
-		return false, fmt.Errorf("pin is required")
-	}
-	// 你如果想严格一点，可以只校验长度，不校验数字字符（按你风格）
-	if len(in.Pin) != 6 {
-		return false, fmt.Errorf("pin length must be 6")
-	}
-
-	accessToken, err := GetInterlaceAccessToken(ctx)
-	if err != nil || accessToken == "" {
-		fmt.Println("获取access token错误")
-		return false, err
-	}
-
-	// interlaceBaseURL 建议: https://api-sandbox.interlace.money/open-api/v3
-	base := interlaceBaseURL + "/cards/" + cardId + "/pin"
-
-	bodyBytes, err := json.Marshal(in)
-	if err != nil {
-		return false, fmt.Errorf("marshal set pin body: %w", err)
-	}
+// 发卡方接口调用的链路追踪和耗时统计
+var issuerTransport = telemetry.Transport(http.DefaultTransport, "ispay")
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, base, bytes.NewReader(bodyBytes))
-	if err != nil {
-		return false, err
-	}
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("x-access-token", accessToken)
-
-	client := &http.Client{Timeout: 10 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		return false, err
-	}
-	defer resp.Body.Close()
+// 发卡方接口没有挂在 UserUseCase 上，使用 main 中 log.SetLogger 设置的全局 logger
+var issuerLog = log.NewHelper(log.With(globalLogger{}, "module", "biz/issuer"))
 
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return false, err
-	}
-
-	fmt.Println("set-pin resp:", string(respBody))
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		fmt.Println("at", interlaceAuth, time.Now().Unix())
-		return false, fmt.Errorf("interlace set pin http %d: %s", resp.StatusCode, string(respBody))
-	}
-
-	var outer InterlaceSetCardPinResp
-	if err := json.Unmarshal(respBody, &outer); err != nil {
-		return false, fmt.Errorf("set pin unmarshal: %w", err)
-	}
-	if outer.Code != "000000" {
-		return false, fmt.Errorf("set pin failed: code=%s msg=%s", outer.Code, outer.Message)
-	}
+// globalLogger 每次输出时读取全局 logger，包初始化早于 log.SetLogger
+type globalLogger struct{}
 
-	return outer.Data.Success, nil
+func (globalLogger) Log(level log.Level, keyvals ...interface{}) error {
+	return log.GetLogger().Log(level, keyvals...)
 }
@@ -0,0 +1,109 @@
+package data
+
+import (
+	"cardbinance/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type WithdrawAddress struct {
+	ID          uint64    `gorm:"primarykey;type:int"`
+	UserId      uint64    `gorm:"type:int;not null"`
+	Chain       string    `gorm:"type:varchar(45);not null"`
+	Address     string    `gorm:"type:varchar(100);not null"`
+	Label       string    `gorm:"type:varchar(100);not null;default:''"`
+	AvailableAt time.Time `gorm:"type:datetime;not null"`
+	CreatedAt   time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
+}
+
+type WithdrawAddressRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewWithdrawAddressRepo(data *Data, logger log.Logger) biz.WithdrawAddressRepo {
+	return &WithdrawAddressRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func withdrawAddressToBiz(wa *WithdrawAddress) *biz.WithdrawAddress {
+	return &biz.WithdrawAddress{
+		ID:          wa.ID,
+		UserId:      wa.UserId,
+		Chain:       wa.Chain,
+		Address:     wa.Address,
+		Label:       wa.Label,
+		AvailableAt: wa.AvailableAt,
+		CreatedAt:   wa.CreatedAt,
+	}
+}
+
+// GetWithdrawAddresses .
+func (w *WithdrawAddressRepo) GetWithdrawAddresses(ctx context.Context, userId uint64) ([]*biz.WithdrawAddress, error) {
+	var addresses []*WithdrawAddress
+	res := make([]*biz.WithdrawAddress, 0)
+	if err := w.data.DB(ctx).Table("withdraw_address").Where("user_id=?", userId).Order("id desc").Find(&addresses).Error; err != nil {
+		return res, errors.New(500, "WITHDRAW_ADDRESS_ERROR", err.Error())
+	}
+
+	for _, v := range addresses {
+		res = append(res, withdrawAddressToBiz(v))
+	}
+
+	return res, nil
+}
+
+// GetWithdrawAddressById 不存在时返回 nil
+func (w *WithdrawAddressRepo) GetWithdrawAddressById(ctx context.Context, userId, id uint64) (*biz.WithdrawAddress, error) {
+	var wa WithdrawAddress
+	if err := w.data.DB(ctx).Table("withdraw_address").Where("user_id=? and id=?", userId, id).First(&wa).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "WITHDRAW_ADDRESS_ERROR", err.Error())
+	}
+
+	return withdrawAddressToBiz(&wa), nil
+}
+
+// CreateWithdrawAddress .
+func (w *WithdrawAddressRepo) CreateWithdrawAddress(ctx context.Context, bwa *biz.WithdrawAddress) (*biz.WithdrawAddress, bool, error) {
+	wa := &WithdrawAddress{
+		UserId:      bwa.UserId,
+		Chain:       bwa.Chain,
+		Address:     bwa.Address,
+		Label:       bwa.Label,
+		AvailableAt: bwa.AvailableAt,
+		CreatedAt:   bwa.CreatedAt,
+		UpdatedAt:   bwa.CreatedAt,
+	}
+
+	res := w.data.DB(ctx).Table("withdraw_address").Clauses(clause.OnConflict{DoNothing: true}).Create(wa)
+	if res.Error != nil {
+		return nil, false, errors.New(500, "CREATE_WITHDRAW_ADDRESS_ERROR", "提现地址创建失败")
+	}
+
+	if 0 >= res.RowsAffected {
+		return nil, false, nil
+	}
+
+	return withdrawAddressToBiz(wa), true, nil
+}
+
+// DeleteWithdrawAddress .
+func (w *WithdrawAddressRepo) DeleteWithdrawAddress(ctx context.Context, userId, id uint64) error {
+	res := w.data.DB(ctx).Table("withdraw_address").Where("user_id=? and id=?", userId, id).Delete(&WithdrawAddress{})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "DELETE_WITHDRAW_ADDRESS_ERROR", "提现地址删除失败")
+	}
+
+	return nil
+}
@@ -0,0 +1,342 @@
+package data
+
+import (
+	"cardbinance/internal/biz"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strings"
+	"time"
+)
+
+// 用户审计密钥加密的值的前缀，密钥删除后显示为 auditErased
+const (
+	auditSealedPrefix = "ak1:"
+	auditErased       = "erased"
+)
+
+// AuditLog 只插入，不提供修改和删除
+type AuditLog struct {
+	ID        uint64    `gorm:"primarykey;type:int"`
+	ActorId   uint64    `gorm:"type:int;not null;index"`
+	TargetId  uint64    `gorm:"type:int;not null;index"`
+	Actor     string    `gorm:"type:varchar(100);not null;default:''"`
+	Operation string    `gorm:"type:varchar(45);not null;index"`
+	Ip        string    `gorm:"type:varchar(512);not null"`
+	UserAgent string    `gorm:"type:varchar(512);not null"`
+	Request   string    `gorm:"type:text"`
+	Before    string    `gorm:"type:text"`
+	After     string    `gorm:"type:text"`
+	Result    string    `gorm:"type:varchar(255);not null"`
+	PrevHash  string    `gorm:"type:varchar(64);not null"`
+	Hash      string    `gorm:"type:varchar(64);not null;uniqueIndex"`
+	CreatedAt time.Time `gorm:"type:datetime;not null;index"`
+}
+
+// AuditChain 哈希链的链头
+type AuditChain struct {
+	ID        uint64    `gorm:"primarykey;type:int"`
+	LastHash  string    `gorm:"type:varchar(64);not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+// AuditKey 用户的审计密钥，配置了字段加密时用主密钥加密保存；用户注销时删除
+type AuditKey struct {
+	ID        uint64    `gorm:"primarykey;type:int"`
+	UserId    uint64    `gorm:"type:int;not null;uniqueIndex"`
+	Secret    string    `gorm:"type:varchar(512);not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+var auditKeyColumns = []string{"secret"}
+
+type AuditRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAuditRepo(data *Data, logger log.Logger) biz.AuditRepo {
+	return &AuditRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func auditLogToBiz(al *AuditLog) *biz.AuditLog {
+	return &biz.AuditLog{
+		ID:        al.ID,
+		ActorId:   al.ActorId,
+		TargetId:  al.TargetId,
+		Actor:     al.Actor,
+		Operation: al.Operation,
+		Ip:        al.Ip,
+		UserAgent: al.UserAgent,
+		Request:   al.Request,
+		Before:    al.Before,
+		After:     al.After,
+		Result:    al.Result,
+		PrevHash:  al.PrevHash,
+		Hash:      al.Hash,
+		CreatedAt: al.CreatedAt,
+	}
+}
+
+// LockAuditChain 链头只有 id=1 一行，由 0016 写入
+func (a *AuditRepo) LockAuditChain(ctx context.Context) (string, error) {
+	var chain AuditChain
+	if err := a.data.DB(ctx).Table("audit_chain").Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", 1).First(&chain).Error; err != nil {
+		return "", errors.New(500, "AUDIT ERROR", err.Error())
+	}
+
+	return chain.LastHash, nil
+}
+
+// CreateAuditLog .
+func (a *AuditRepo) CreateAuditLog(ctx context.Context, bal *biz.AuditLog) error {
+	al := &AuditLog{
+		ActorId:   bal.ActorId,
+		TargetId:  bal.TargetId,
+		Actor:     bal.Actor,
+		Operation: bal.Operation,
+		Ip:        bal.Ip,
+		UserAgent: bal.UserAgent,
+		Request:   bal.Request,
+		Before:    bal.Before,
+		After:     bal.After,
+		Result:    bal.Result,
+		PrevHash:  bal.PrevHash,
+		Hash:      bal.Hash,
+		CreatedAt: bal.CreatedAt,
+	}
+
+	res := a.data.DB(ctx).Table("audit_log").Create(al)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "CREATE_AUDIT_LOG_ERROR", "审计日志创建失败")
+	}
+
+	res = a.data.DB(ctx).Table("audit_chain").Where("id=?", 1).
+		Updates(map[string]interface{}{"last_hash": al.Hash, "updated_at": time.Now().Format("2006-01-02 15:04:05")})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_AUDIT_CHAIN_ERROR", "审计链头更新失败")
+	}
+
+	bal.ID = al.ID
+	return nil
+}
+
+// GetAuditLogsPage .
+func (a *AuditRepo) GetAuditLogsPage(ctx context.Context, b *biz.Pagination, filter *biz.AuditFilter) ([]*biz.AuditLog, error, int64) {
+	var (
+		count int64
+		logs  []*AuditLog
+	)
+
+	res := make([]*biz.AuditLog, 0)
+
+	instance := a.data.db.Table("audit_log")
+	if 0 < filter.ActorId {
+		instance = instance.Where("actor_id=?", filter.ActorId)
+	}
+	if 0 < filter.TargetId {
+		instance = instance.Where("target_id=?", filter.TargetId)
+	}
+	if "" != filter.Operation {
+		instance = instance.Where("operation=?", filter.Operation)
+	}
+	if nil != filter.StartTime {
+		instance = instance.Where("created_at>=?", *filter.StartTime)
+	}
+	if nil != filter.EndTime {
+		instance = instance.Where("created_at<=?", *filter.EndTime)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Order("id desc").Scopes(Paginate(b.PageNum, b.PageSize)).Find(&logs).Error; err != nil {
+		return nil, errors.New(500, "AUDIT ERROR", err.Error()), 0
+	}
+
+	for _, v := range logs {
+		res = append(res, auditLogToBiz(v))
+	}
+
+	return res, nil, count
+}
+
+// GetAuditLogsAfterId .
+func (a *AuditRepo) GetAuditLogsAfterId(ctx context.Context, id uint64, limit int) ([]*biz.AuditLog, error) {
+	var logs []*AuditLog
+	if err := a.data.db.Table("audit_log").Where("id>?", id).Order("id asc").Limit(limit).Find(&logs).Error; err != nil {
+		return nil, errors.New(500, "AUDIT ERROR", err.Error())
+	}
+
+	res := make([]*biz.AuditLog, 0, len(logs))
+	for _, v := range logs {
+		res = append(res, auditLogToBiz(v))
+	}
+
+	return res, nil
+}
+
+// auditKey 用户的审计密钥，不存在且 create 为 false 时返回 nil
+func (a *AuditRepo) auditKey(ctx context.Context, userId uint64, create bool) (cipher.AEAD, error) {
+	var ak AuditKey
+	err := a.data.db.Table("audit_key").Where("user_id=?", userId).First(&ak).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		if !create {
+			return nil, nil
+		}
+
+		key := make([]byte, 32)
+		if _, err = rand.Read(key); err != nil {
+			return nil, err
+		}
+
+		secret := base64.StdEncoding.EncodeToString(key)
+		if err = a.data.sealFields(ctx, "audit_key", userId, auditKeyColumns, []*string{&secret}); err != nil {
+			return nil, err
+		}
+
+		// 并发生成时以先写入的为准
+		if err = a.data.db.Table("audit_key").Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&AuditKey{UserId: userId, Secret: secret, CreatedAt: time.Now(), UpdatedAt: time.Now()}).Error; err != nil {
+			return nil, errors.New(500, "CREATE_AUDIT_KEY_ERROR", "审计密钥创建失败")
+		}
+
+		err = a.data.db.Table("audit_key").Where("user_id=?", userId).First(&ak).Error
+	}
+	if err != nil {
+		return nil, errors.New(500, "AUDIT_KEY_ERROR", err.Error())
+	}
+
+	if err = a.data.openFields(ctx, "audit_key", userId, auditKeyColumns, []*string{&ak.Secret}); err != nil {
+		return nil, err
+	}
+
+	key, err := base64.StdEncoding.DecodeString(ak.Secret)
+	if err != nil {
+		return nil, errors.New(500, "AUDIT_KEY_ERROR", "审计密钥格式错误")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.New(500, "AUDIT_KEY_ERROR", err.Error())
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func auditAdditionalData(userId uint64) []byte {
+	return []byte(fmt.Sprintf("audit_log.%d", userId))
+}
+
+// SealAuditFields 已注销的用户不再生成密钥，直接记为 erased
+func (a *AuditRepo) SealAuditFields(ctx context.Context, userId uint64, fields []*string) error {
+	var user User
+	if err := a.data.db.Table("user").Select("id", "is_delete").Where("id=?", userId).First(&user).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New(500, "USER ERROR", err.Error())
+		}
+	}
+
+	if 1 == user.IsDelete {
+		for _, v := range fields {
+			if "" != *v {
+				*v = auditErased
+			}
+		}
+
+		return nil
+	}
+
+	aead, err := a.auditKey(ctx, userId, true)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range fields {
+		if "" == *v {
+			continue
+		}
+
+		nonce := make([]byte, aead.NonceSize())
+		if _, err = rand.Read(nonce); err != nil {
+			return err
+		}
+
+		*v = auditSealedPrefix + base64.RawURLEncoding.EncodeToString(aead.Seal(nonce, nonce, []byte(*v), auditAdditionalData(userId)))
+	}
+
+	return nil
+}
+
+// OpenAuditFields 密钥已删除时替换为 erased
+func (a *AuditRepo) OpenAuditFields(ctx context.Context, userId uint64, fields []*string) error {
+	var aead cipher.AEAD
+	for _, v := range fields {
+		if !strings.HasPrefix(*v, auditSealedPrefix) {
+			continue
+		}
+
+		if nil == aead {
+			var err error
+			if aead, err = a.auditKey(ctx, userId, false); err != nil {
+				return err
+			}
+		}
+
+		if nil == aead {
+			*v = auditErased
+			continue
+		}
+
+		sealed, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(*v, auditSealedPrefix))
+		if err != nil || len(sealed) < aead.NonceSize() {
+			return errors.New(500, "AUDIT_DECRYPT_ERROR", "审计日志格式错误")
+		}
+
+		plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], auditAdditionalData(userId))
+		if err != nil {
+			return errors.New(500, "AUDIT_DECRYPT_ERROR", err.Error())
+		}
+		*v = string(plaintext)
+	}
+
+	return nil
+}
+
+// ReencryptAuditKeys 同 ReencryptUsers
+func (a *AuditRepo) ReencryptAuditKeys(ctx context.Context, afterId uint64, limit int) (uint64, int, error) {
+	if nil == a.data.fc {
+		return 0, 0, nil
+	}
+
+	var keys []*AuditKey
+	if err := a.data.db.Table("audit_key").Where("id>?", afterId).Order("id asc").Limit(limit).Find(&keys).Error; err != nil {
+		return 0, 0, errors.New(500, "AUDIT_KEY_ERROR", err.Error())
+	}
+
+	var (
+		lastId  uint64
+		updated int
+	)
+	for _, v := range keys {
+		lastId = v.ID
+		ok, err := a.data.reencryptRow(ctx, "audit_key", v.ID, v.UserId, auditKeyColumns, []*string{&v.Secret}, nil)
+		if err != nil {
+			return lastId, updated, err
+		}
+		if ok {
+			updated++
+		}
+	}
+
+	return lastId, updated, nil
+}
@@ -0,0 +1,201 @@
+package data
+
+import (
+	"cardbinance/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+// UserBalance USDT以外币种的余额，USDT仍在 user.amount
+type UserBalance struct {
+	ID        uint64    `gorm:"primarykey;type:int"`
+	UserId    uint64    `gorm:"type:int;not null;uniqueIndex:idx_user_currency"`
+	Currency  string    `gorm:"type:varchar(45);not null;uniqueIndex:idx_user_currency"`
+	Amount    float64   `gorm:"type:decimal(65,20);not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type BalanceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewBalanceRepo(data *Data, logger log.Logger) biz.BalanceRepo {
+	return &BalanceRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// subBalance 扣减余额，余额不足时失败
+func subBalance(db *gorm.DB, userId uint64, currency string, amount float64) error {
+	if "" == currency || biz.CurrencyUSDT == currency {
+		res := db.Table("user").Where("id=?", userId).Where("amount>=?", amount).
+			Updates(map[string]interface{}{
+				"amount":     gorm.Expr("amount - ?", amount),
+				"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+			})
+		if res.Error != nil || 0 >= res.RowsAffected {
+			return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+		}
+
+		return nil
+	}
+
+	res := db.Table("user_balance").Where("user_id=?", userId).Where("currency=?", currency).Where("amount>=?", amount).
+		Updates(map[string]interface{}{
+			"amount":     gorm.Expr("amount - ?", amount),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_BALANCE_ERROR", "余额修改失败")
+	}
+
+	return nil
+}
+
+// addBalance 增加余额，币种余额记录不存在时创建
+func addBalance(db *gorm.DB, userId uint64, currency string, amount float64) error {
+	if "" == currency || biz.CurrencyUSDT == currency {
+		res := db.Table("user").Where("id=?", userId).
+			Updates(map[string]interface{}{
+				"amount":     gorm.Expr("amount + ?", amount),
+				"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+			})
+		if res.Error != nil || 0 >= res.RowsAffected {
+			return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+		}
+
+		return nil
+	}
+
+	res := db.Table("user_balance").Where("user_id=?", userId).Where("currency=?", currency).
+		Updates(map[string]interface{}{
+			"amount":     gorm.Expr("amount + ?", amount),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_BALANCE_ERROR", "余额修改失败")
+	}
+	if 0 < res.RowsAffected {
+		return nil
+	}
+
+	balance := &UserBalance{
+		UserId:   userId,
+		Currency: currency,
+		Amount:   amount,
+	}
+	resInsert := db.Table("user_balance").Create(balance)
+	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
+		return errors.New(500, "CREATE_BALANCE_ERROR", "余额创建失败")
+	}
+
+	return nil
+}
+
+// GetBalancesByUserId 全部币种余额，包含 user.amount 上的USDT
+func (b *BalanceRepo) GetBalancesByUserId(ctx context.Context, userId uint64) ([]*biz.Balance, error) {
+	var user User
+	if err := b.data.DB(ctx).Table("user").Where("id=?", userId).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("USER_NOT_FOUND", "user not found")
+		}
+
+		return nil, errors.New(500, "USER ERROR", err.Error())
+	}
+
+	res := make([]*biz.Balance, 0)
+	res = append(res, &biz.Balance{
+		UserId:   userId,
+		Currency: biz.CurrencyUSDT,
+		Amount:   user.Amount,
+	})
+
+	var balances []*UserBalance
+	if err := b.data.DB(ctx).Table("user_balance").Where("user_id=?", userId).Order("id asc").Find(&balances).Error; err != nil {
+		return nil, errors.New(500, "BALANCE ERROR", err.Error())
+	}
+
+	for _, balance := range balances {
+		res = append(res, &biz.Balance{
+			UserId:   balance.UserId,
+			Currency: balance.Currency,
+			Amount:   balance.Amount,
+		})
+	}
+
+	return res, nil
+}
+
+// GetBalance .
+func (b *BalanceRepo) GetBalance(ctx context.Context, userId uint64, currency string) (float64, error) {
+	if "" == currency || biz.CurrencyUSDT == currency {
+		var user User
+		if err := b.data.DB(ctx).Table("user").Where("id=?", userId).First(&user).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return 0, errors.NotFound("USER_NOT_FOUND", "user not found")
+			}
+
+			return 0, errors.New(500, "USER ERROR", err.Error())
+		}
+
+		return user.Amount, nil
+	}
+
+	var balance UserBalance
+	if err := b.data.DB(ctx).Table("user_balance").Where("user_id=?", userId).Where("currency=?", currency).First(&balance).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+
+		return 0, errors.New(500, "BALANCE ERROR", err.Error())
+	}
+
+	return balance.Amount, nil
+}
+
+// Exchange .
+func (b *BalanceRepo) Exchange(ctx context.Context, userId uint64, from, to string, amount, amountTo float64) error {
+	if err := subBalance(b.data.DB(ctx), userId, from, amount); nil != err {
+		return err
+	}
+
+	if err := addBalance(b.data.DB(ctx), userId, to, amountTo); nil != err {
+		return err
+	}
+
+	var (
+		reward Reward
+	)
+
+	reward.UserId = userId
+	reward.Amount = amount
+	reward.Reason = 15 // 兑换
+	reward.Currency = from
+	reward.Address = to
+	resInsert := b.data.DB(ctx).Table("reward").Create(&reward)
+	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
+		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
+	}
+
+	var (
+		rewardTo Reward
+	)
+
+	rewardTo.UserId = userId
+	rewardTo.Amount = amountTo
+	rewardTo.Reason = 16 // 兑换到账
+	rewardTo.Currency = to
+	rewardTo.Address = from
+	resInsertTwo := b.data.DB(ctx).Table("reward").Create(&rewardTo)
+	if resInsertTwo.Error != nil || 0 >= resInsertTwo.RowsAffected {
+		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
+	}
+
+	return nil
+}
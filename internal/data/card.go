@@ -0,0 +1,600 @@
+package data
+
+import (
+	"cardbinance/internal/biz"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type Card struct {
+	ID           uint64    `gorm:"primarykey;type:int"`
+	UserId       uint64    `gorm:"type:int;not null"`
+	CardType     uint64    `gorm:"type:int;not null"`
+	ProductId    string    `gorm:"type:varchar(45);not null;default:'0'"`
+	IssuerCardId string    `gorm:"type:varchar(100);not null;default:''"`
+	Status       string    `gorm:"type:varchar(45);not null"`
+	MaskedPan    string    `gorm:"type:varchar(45);not null;default:''"`
+	CreatedAt    time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt    time.Time `gorm:"type:datetime;not null"`
+}
+
+type CardTransfer struct {
+	ID        uint64    `gorm:"primarykey;type:int"`
+	UserId    uint64    `gorm:"type:int;not null"`
+	CardId    uint64    `gorm:"type:int;not null"`
+	RewardId  uint64    `gorm:"type:int;not null"`
+	OrderId   string    `gorm:"type:varchar(100);not null;uniqueIndex"`
+	Amount    float64   `gorm:"type:decimal(65,20);not null"`
+	Status    string    `gorm:"type:varchar(45);not null"`
+	Error     string    `gorm:"type:varchar(500);not null;default:''"`
+	Attempts  uint64    `gorm:"type:int;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type CardTransaction struct {
+	ID                  uint64    `gorm:"primarykey;type:int"`
+	TxnId               string    `gorm:"type:varchar(100);not null;uniqueIndex"`
+	UserId              uint64    `gorm:"type:int;not null"`
+	CardId              uint64    `gorm:"type:int;not null"`
+	IssuerCardId        string    `gorm:"type:varchar(100);not null"`
+	ClientTransactionId string    `gorm:"type:varchar(100);not null;default:''"`
+	Type                int32     `gorm:"type:int;not null"`
+	Status              string    `gorm:"type:varchar(45);not null"`
+	Currency            string    `gorm:"type:varchar(45);not null"`
+	Amount              float64   `gorm:"type:decimal(65,20);not null"`
+	Fee                 float64   `gorm:"type:decimal(65,20);not null"`
+	TransactionCurrency string    `gorm:"type:varchar(45);not null;default:''"`
+	TransactionAmount   float64   `gorm:"type:decimal(65,20);not null"`
+	MerchantName        string    `gorm:"type:varchar(255);not null;default:''"`
+	Mcc                 string    `gorm:"type:varchar(45);not null;default:''"`
+	TransactionTime     time.Time `gorm:"type:datetime;not null"`
+	CreatedAt           time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt           time.Time `gorm:"type:datetime;not null"`
+}
+
+type CardRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewCardRepo(data *Data, logger log.Logger) biz.CardRepo {
+	return &CardRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func cardToBiz(card *Card) *biz.Card {
+	return &biz.Card{
+		ID:           card.ID,
+		UserId:       card.UserId,
+		CardType:     card.CardType,
+		ProductId:    card.ProductId,
+		IssuerCardId: card.IssuerCardId,
+		Status:       card.Status,
+		MaskedPan:    card.MaskedPan,
+		CreatedAt:    card.CreatedAt,
+		UpdatedAt:    card.UpdatedAt,
+	}
+}
+
+// GetCardsByUserId .
+func (c *CardRepo) GetCardsByUserId(ctx context.Context, userId uint64) ([]*biz.Card, error) {
+	var cards []*Card
+	res := make([]*biz.Card, 0)
+	if err := c.data.DB(ctx).Table("card").Where("user_id=?", userId).Order("id asc").Find(&cards).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "CARD ERROR", err.Error())
+	}
+
+	for _, card := range cards {
+		res = append(res, cardToBiz(card))
+	}
+
+	return res, nil
+}
+
+// GetCardByUserIdAndId .
+func (c *CardRepo) GetCardByUserIdAndId(ctx context.Context, userId, cardId uint64) (*biz.Card, error) {
+	var card Card
+	if err := c.data.DB(ctx).Table("card").Where("id=?", cardId).Where("user_id=?", userId).First(&card).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "CARD ERROR", err.Error())
+	}
+
+	return cardToBiz(&card), nil
+}
+
+// GetCardById .
+func (c *CardRepo) GetCardById(ctx context.Context, cardId uint64) (*biz.Card, error) {
+	var card Card
+	if err := c.data.DB(ctx).Table("card").Where("id=?", cardId).First(&card).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "CARD ERROR", err.Error())
+	}
+
+	return cardToBiz(&card), nil
+}
+
+// GetCardByIssuerCardId .
+func (c *CardRepo) GetCardByIssuerCardId(ctx context.Context, issuerCardId string) (*biz.Card, error) {
+	var card Card
+	if err := c.data.DB(ctx).Table("card").Where("issuer_card_id=?", issuerCardId).First(&card).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "CARD ERROR", err.Error())
+	}
+
+	return cardToBiz(&card), nil
+}
+
+// GetCardsByStatus .
+func (c *CardRepo) GetCardsByStatus(ctx context.Context, status string) ([]*biz.Card, error) {
+	var cards []*Card
+	res := make([]*biz.Card, 0)
+	if err := c.data.DB(ctx).Table("card").Where("status=?", status).Order("id asc").Find(&cards).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "CARD ERROR", err.Error())
+	}
+
+	for _, card := range cards {
+		res = append(res, cardToBiz(card))
+	}
+
+	return res, nil
+}
+
+// CreateCard .
+func (c *CardRepo) CreateCard(ctx context.Context, bc *biz.Card) (*biz.Card, error) {
+	var card Card
+	card.UserId = bc.UserId
+	card.CardType = bc.CardType
+	card.ProductId = bc.ProductId
+	card.IssuerCardId = bc.IssuerCardId
+	card.Status = bc.Status
+	card.MaskedPan = bc.MaskedPan
+	if "" == card.ProductId {
+		card.ProductId = "0"
+	}
+
+	res := c.data.DB(ctx).Table("card").Create(&card)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return nil, errors.New(500, "CREATE_CARD_ERROR", "卡片创建失败")
+	}
+
+	return cardToBiz(&card), nil
+}
+
+// UpdateCardStatus .
+func (c *CardRepo) UpdateCardStatus(ctx context.Context, cardId uint64, status string) error {
+	res := c.data.DB(ctx).Table("card").Where("id=?", cardId).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_CARD_ERROR", "卡片信息修改失败")
+	}
+
+	return nil
+}
+
+// UpdateCardMaskedPan .
+func (c *CardRepo) UpdateCardMaskedPan(ctx context.Context, cardId uint64, maskedPan string) error {
+	res := c.data.DB(ctx).Table("card").Where("id=?", cardId).
+		Updates(map[string]interface{}{
+			"masked_pan": maskedPan,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_CARD_ERROR", "卡片信息修改失败")
+	}
+
+	return nil
+}
+
+// IssueCard 发卡成功，写入发卡方卡片id
+func (c *CardRepo) IssueCard(ctx context.Context, cardId uint64, issuerCardId string) error {
+	res := c.data.DB(ctx).Table("card").Where("id=?", cardId).Where("status=?", biz.CardStatusPending).
+		Updates(map[string]interface{}{
+			"issuer_card_id": issuerCardId,
+			"status":         biz.CardStatusActive,
+			"updated_at":     time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_CARD_ERROR", "卡片信息修改失败")
+	}
+
+	return nil
+}
+
+// CountOpenCards 加锁读取，需要在事务中调用
+func (c *CardRepo) CountOpenCards(ctx context.Context, userId, cardType uint64) (int64, error) {
+	var count int64
+	if err := c.data.DB(ctx).Table("card").Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id=? AND card_type=? AND status<>?", userId, cardType, biz.CardStatusClosed).Count(&count).Error; err != nil {
+		return 0, errors.New(500, "CARD ERROR", err.Error())
+	}
+
+	return count, nil
+}
+
+// QueueLegacyIssue 外部发卡流程仍读取 user 表 card_order_id=do、card_two=1，发卡后回写卡号
+// 同一类型同时只能有一个待发卡申请，上一张已回写（success、2）或未申请时才能写入
+func (c *CardRepo) QueueLegacyIssue(ctx context.Context, userId, cardType uint64) (bool, error) {
+	q := c.data.DB(ctx).Table("user").Where("id=?", userId)
+	updates := map[string]interface{}{"updated_at": time.Now().Format("2006-01-02 15:04:05")}
+	if biz.CardTypePhysical == cardType {
+		q = q.Where("card_two IN (?)", []uint64{0, 2})
+		updates["card_two"] = 1
+	} else {
+		q = q.Where("card_order_id IN (?)", []string{"no", "success"})
+		updates["card_order_id"] = "do"
+	}
+
+	res := q.Updates(updates)
+	if res.Error != nil {
+		return false, errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+	}
+
+	return 0 < res.RowsAffected, nil
+}
+
+// MigrateLegacyCards 把 user 表上两组卡片字段迁移到 card 表，已有卡片记录的用户跳过；
+// 先锁住用户行再检查，并发迁移同一用户时后到的会看到已写入的卡片，需要在事务中调用
+func (c *CardRepo) MigrateLegacyCards(ctx context.Context, userId uint64) ([]*biz.Card, error) {
+	var user User
+	if err := c.data.DB(ctx).Table("user").Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", userId).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return make([]*biz.Card, 0), nil
+		}
+
+		return nil, errors.New(500, "USER ERROR", err.Error())
+	}
+
+	var count int64
+	if err := c.data.DB(ctx).Table("card").Where("user_id=?", userId).Count(&count).Error; err != nil {
+		return nil, errors.New(500, "CARD ERROR", err.Error())
+	}
+	if 0 < count {
+		return c.GetCardsByUserId(ctx, userId)
+	}
+
+	cards := make([]*Card, 0)
+	if "no" != user.CardOrderId {
+		card := &Card{
+			UserId:    user.ID,
+			CardType:  biz.CardTypeVirtual,
+			ProductId: user.ProductId,
+			Status:    biz.CardStatusPending,
+		}
+		if "success" == user.CardOrderId && 10 <= len(user.CardNumber) {
+			card.IssuerCardId = user.CardNumber
+			card.Status = biz.CardStatusActive
+		}
+		if 1 == user.LockCard {
+			card.Status = biz.CardStatusFrozen
+		}
+		if 5 < len(user.CardNumberRel) {
+			card.MaskedPan = biz.MaskCard8_6_4(user.CardNumberRel)
+		}
+		cards = append(cards, card)
+	}
+
+	if 0 < user.CardTwo {
+		card := &Card{
+			UserId:    user.ID,
+			CardType:  biz.CardTypePhysical,
+			ProductId: "0",
+			Status:    biz.CardStatusPending,
+		}
+		if 2 == user.CardTwo && 10 <= len(user.CardTwoNumber) {
+			card.IssuerCardId = user.CardTwoNumber
+			card.Status = biz.CardStatusActive
+		}
+		if 1 == user.LockCardTwo {
+			card.Status = biz.CardStatusFrozen
+		}
+		if 5 < len(user.CardNumberRelTwo) {
+			card.MaskedPan = biz.MaskCard8_6_4(user.CardNumberRelTwo)
+		}
+		cards = append(cards, card)
+	}
+
+	res := make([]*biz.Card, 0)
+	for _, card := range cards {
+		if "" == card.ProductId {
+			card.ProductId = "0"
+		}
+		resInsert := c.data.DB(ctx).Table("card").Create(card)
+		if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
+			return nil, errors.New(500, "CREATE_CARD_ERROR", "卡片迁移失败")
+		}
+
+		res = append(res, cardToBiz(card))
+	}
+
+	return res, nil
+}
+
+// GetUserIdsWithLegacyCards 仍在旧字段上、尚未迁移到 card 表的用户
+func (c *CardRepo) GetUserIdsWithLegacyCards(ctx context.Context) ([]uint64, error) {
+	userIds := make([]uint64, 0)
+	if err := c.data.DB(ctx).Table("user").
+		Where("card_order_id<>? OR card_two>?", "no", 0).
+		Where("id NOT IN (?)", c.data.DB(ctx).Table("card").Select("user_id")).
+		Order("id asc").Pluck("id", &userIds).Error; err != nil {
+		return nil, errors.New(500, "USER ERROR", err.Error())
+	}
+
+	return userIds, nil
+}
+
+func cardTransferToBiz(ct *CardTransfer) *biz.CardTransfer {
+	return &biz.CardTransfer{
+		ID:        ct.ID,
+		UserId:    ct.UserId,
+		CardId:    ct.CardId,
+		RewardId:  ct.RewardId,
+		OrderId:   ct.OrderId,
+		Amount:    ct.Amount,
+		Status:    ct.Status,
+		Error:     ct.Error,
+		Attempts:  ct.Attempts,
+		CreatedAt: ct.CreatedAt,
+		UpdatedAt: ct.UpdatedAt,
+	}
+}
+
+// CreateCardTransfer .
+func (c *CardRepo) CreateCardTransfer(ctx context.Context, bct *biz.CardTransfer) (*biz.CardTransfer, error) {
+	ct := &CardTransfer{
+		UserId:   bct.UserId,
+		CardId:   bct.CardId,
+		RewardId: bct.RewardId,
+		OrderId:  bct.OrderId,
+		Amount:   bct.Amount,
+		Status:   bct.Status,
+	}
+
+	res := c.data.DB(ctx).Table("card_transfer").Create(ct)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return nil, errors.New(500, "CREATE_CARD_TRANSFER_ERROR", "划转记录创建失败")
+	}
+
+	return cardTransferToBiz(ct), nil
+}
+
+// GetCardTransferByOrderId .
+func (c *CardRepo) GetCardTransferByOrderId(ctx context.Context, orderId string) (*biz.CardTransfer, error) {
+	var ct CardTransfer
+	if err := c.data.DB(ctx).Table("card_transfer").Where("order_id=?", orderId).First(&ct).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "CARD TRANSFER ERROR", err.Error())
+	}
+
+	return cardTransferToBiz(&ct), nil
+}
+
+// UpdateCardTransfer .
+func (c *CardRepo) UpdateCardTransfer(ctx context.Context, id uint64, fromStatus, status, errMsg string) error {
+	if 500 < len(errMsg) {
+		errMsg = errMsg[:500]
+	}
+
+	res := c.data.DB(ctx).Table("card_transfer").Where("id=?", id).Where("status=?", fromStatus).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"error":      errMsg,
+			"attempts":   gorm.Expr("attempts + 1"),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_CARD_TRANSFER_ERROR", "划转记录修改失败")
+	}
+
+	return nil
+}
+
+// ExpireCardTransfers .
+func (c *CardRepo) ExpireCardTransfers(ctx context.Context, before time.Time) (int64, error) {
+	res := c.data.DB(ctx).Table("card_transfer").
+		Where("status=?", biz.CardTransferPending).
+		Where("updated_at<?", before.Format("2006-01-02 15:04:05")).
+		Updates(map[string]interface{}{
+			"status":     biz.CardTransferFailed,
+			"error":      "timeout",
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil {
+		return 0, errors.New(500, "UPDATE_CARD_TRANSFER_ERROR", "划转记录修改失败")
+	}
+
+	return res.RowsAffected, nil
+}
+
+// SaveCardTransaction .
+func (c *CardRepo) SaveCardTransaction(ctx context.Context, bct *biz.CardTransaction) (bool, bool, error) {
+	ct := &CardTransaction{
+		TxnId:               bct.TxnId,
+		UserId:              bct.UserId,
+		CardId:              bct.CardId,
+		IssuerCardId:        bct.IssuerCardId,
+		ClientTransactionId: bct.ClientTransactionId,
+		Type:                bct.Type,
+		Status:              bct.Status,
+		Currency:            bct.Currency,
+		Amount:              bct.Amount,
+		Fee:                 bct.Fee,
+		TransactionCurrency: bct.TransactionCurrency,
+		TransactionAmount:   bct.TransactionAmount,
+		MerchantName:        bct.MerchantName,
+		Mcc:                 bct.Mcc,
+		TransactionTime:     bct.TransactionTime,
+	}
+
+	// 发卡方交易状态会从 PENDING 变为 CLOSED/FAIL，重复同步时只更新状态和金额
+	// updated_at 放在最前，只在其他字段有变化时更新，没有变化时影响行数为0
+	res := c.data.DB(ctx).Table("card_transaction").Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "txn_id"}},
+		DoUpdates: append(clause.Set{{
+			Column: clause.Column{Name: "updated_at"},
+			Value:  gorm.Expr("IF(status<>VALUES(status) OR amount<>VALUES(amount) OR fee<>VALUES(fee) OR transaction_amount<>VALUES(transaction_amount), VALUES(updated_at), updated_at)"),
+		}}, clause.AssignmentColumns([]string{"status", "amount", "fee", "transaction_amount"})...),
+	}).Create(ct)
+	if res.Error != nil {
+		return false, false, errors.New(500, "CREATE_CARD_TRANSACTION_ERROR", "交易记录保存失败")
+	}
+
+	// MySQL 新增时影响行数为1，更新为2，没有变化为0
+	return 1 == res.RowsAffected, 0 < res.RowsAffected, nil
+}
+
+// GetLastCardTransactionTime .
+func (c *CardRepo) GetLastCardTransactionTime(ctx context.Context) (time.Time, error) {
+	var ct CardTransaction
+	if err := c.data.DB(ctx).Table("card_transaction").Order("transaction_time desc").First(&ct).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return time.Time{}, nil
+		}
+
+		return time.Time{}, errors.New(500, "CARD TRANSACTION ERROR", err.Error())
+	}
+
+	return ct.TransactionTime, nil
+}
+
+// GetNonFinalCardTransactions .
+func (c *CardRepo) GetNonFinalCardTransactions(ctx context.Context, afterId uint64, limit int) ([]*biz.CardTransaction, error) {
+	var cts []*CardTransaction
+	res := make([]*biz.CardTransaction, 0)
+	if err := c.data.DB(ctx).Table("card_transaction").
+		Where("status NOT IN (?)", []string{"CLOSED", "FAIL"}).
+		Where("id>?", afterId).
+		Order("id asc").Limit(limit).Find(&cts).Error; err != nil {
+		return nil, errors.New(500, "CARD TRANSACTION ERROR", err.Error())
+	}
+
+	for _, ct := range cts {
+		res = append(res, &biz.CardTransaction{
+			ID:                  ct.ID,
+			TxnId:               ct.TxnId,
+			UserId:              ct.UserId,
+			CardId:              ct.CardId,
+			IssuerCardId:        ct.IssuerCardId,
+			ClientTransactionId: ct.ClientTransactionId,
+			Type:                ct.Type,
+			Status:              ct.Status,
+			Currency:            ct.Currency,
+			Amount:              ct.Amount,
+			Fee:                 ct.Fee,
+			TransactionCurrency: ct.TransactionCurrency,
+			TransactionAmount:   ct.TransactionAmount,
+			MerchantName:        ct.MerchantName,
+			Mcc:                 ct.Mcc,
+			TransactionTime:     ct.TransactionTime,
+			CreatedAt:           ct.CreatedAt,
+			UpdatedAt:           ct.UpdatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// CreateCardRecord .
+func (c *CardRepo) CreateCardRecord(ctx context.Context, r *biz.CardRecord) error {
+	now := time.Now()
+	res := c.data.DB(ctx).Table("card_record").Create(&CardRecord{
+		UserId:     r.UserId,
+		RecordType: r.RecordType,
+		Remark:     r.Remark,
+		Code:       r.Code,
+		Opt:        r.Opt,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "CREATE_CARD_RECORD_ERROR", "卡片记录创建失败")
+	}
+
+	return nil
+}
+
+// SaveCardBalance .
+func (c *CardRepo) SaveCardBalance(ctx context.Context, cardId uint64, balance *biz.CardBalance) error {
+	b, err := json.Marshal(balance)
+	if err != nil {
+		return err
+	}
+
+	return c.data.rdb.Set(ctx, cardBalanceKey(cardId), b, cardBalanceTTL).Err()
+}
+
+// GetCardBalance .
+func (c *CardRepo) GetCardBalance(ctx context.Context, cardId uint64) (*biz.CardBalance, error) {
+	b, err := c.data.rdb.Get(ctx, cardBalanceKey(cardId)).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var balance biz.CardBalance
+	if err = json.Unmarshal(b, &balance); err != nil {
+		return nil, err
+	}
+
+	return &balance, nil
+}
+
+// 发卡方长时间不可用时缓存的余额已无参考意义
+const cardBalanceTTL = 7 * 24 * time.Hour
+
+func cardBalanceKey(cardId uint64) string {
+	return fmt.Sprintf("card:balance:%d", cardId)
+}
+
+func pinAttemptKey(cardId uint64) string {
+	return fmt.Sprintf("card:pin_attempts:%d", cardId)
+}
+
+// IncrPinAttempts 第一次修改时设置过期时间
+func (c *CardRepo) IncrPinAttempts(ctx context.Context, cardId uint64, window time.Duration) (int64, error) {
+	return c.data.incrWindow(ctx, pinAttemptKey(cardId), window)
+}
+
+// GetPinAttempts .
+func (c *CardRepo) GetPinAttempts(ctx context.Context, cardId uint64) (int64, error) {
+	count, err := c.data.rdb.Get(ctx, pinAttemptKey(cardId)).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+
+	return count, err
+}
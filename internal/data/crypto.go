@@ -0,0 +1,250 @@
+package data
+
+import (
+	"cardbinance/internal/conf"
+	"cardbinance/internal/pkg/envelope"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strings"
+	"time"
+)
+
+// 加密的个人信息字段，顺序与 userPersonalFields、cardTwoPersonalFields、userTotpFields 一致
+var (
+	userPersonalColumns    = []string{"first_name", "last_name", "email", "phone", "street", "birth_date"}
+	cardTwoPersonalColumns = []string{"first_name", "last_name", "email", "phone", "street", "birth_date", "id_card"}
+	userTotpColumns        = []string{"secret"}
+)
+
+func userPersonalFields(u *User) []*string {
+	return []*string{&u.FirstName, &u.LastName, &u.Email, &u.Phone, &u.Street, &u.BirthDate}
+}
+
+func cardTwoPersonalFields(c *CardTwo) []*string {
+	return []*string{&c.FirstName, &c.LastName, &c.Email, &c.Phone, &c.Street, &c.BirthDate, &c.IdCard}
+}
+
+func userTotpFields(t *UserTotp) []*string {
+	return []*string{&t.Secret}
+}
+
+// NewFieldCipher 目前只支持本地密钥文件，接入 KMS 时实现 envelope.KeyProvider 替换这里的 provider
+func NewFieldCipher(c *conf.Data_Crypto) (*envelope.Cipher, error) {
+	if nil == c || "" == c.Keyfile {
+		return nil, nil
+	}
+
+	provider, err := envelope.NewKeyfileProvider(c.Keyfile)
+	if err != nil {
+		return nil, err
+	}
+
+	indexKey, err := base64.StdEncoding.DecodeString(c.IndexKey)
+	if err != nil {
+		return nil, fmt.Errorf("data.crypto.index_key: %w", err)
+	}
+
+	return envelope.New(provider, indexKey)
+}
+
+// personalPlain 空值和列默认值 no 不加密
+func personalPlain(v string) bool {
+	return "" == v || "no" == v
+}
+
+// fieldBind 密文绑定的表、列和行，密文被复制到其他位置时解密失败
+func fieldBind(table, column string, id uint64) string {
+	return fmt.Sprintf("%s.%s.%d", table, column, id)
+}
+
+// sealFields 原地加密，未配置密钥时不处理；columns 与 fields 一一对应
+func (d *Data) sealFields(ctx context.Context, table string, id uint64, columns []string, fields []*string) error {
+	if nil == d.fc {
+		return nil
+	}
+
+	for k, v := range fields {
+		if personalPlain(*v) || envelope.IsEncrypted(*v) {
+			continue
+		}
+
+		tmp, err := d.fc.Encrypt(ctx, *v, fieldBind(table, columns[k], id))
+		if err != nil {
+			return errors.New(500, "ENCRYPT_ERROR", err.Error())
+		}
+		*v = tmp
+	}
+
+	return nil
+}
+
+// openFields 原地解密，旧的明文数据原样保留
+func (d *Data) openFields(ctx context.Context, table string, id uint64, columns []string, fields []*string) error {
+	for k, v := range fields {
+		if !envelope.IsEncrypted(*v) {
+			continue
+		}
+
+		if nil == d.fc {
+			return errors.New(500, "DECRYPT_ERROR", "data.crypto is not configured")
+		}
+
+		tmp, err := d.fc.Decrypt(ctx, *v, fieldBind(table, columns[k], id))
+		if err != nil {
+			return errors.New(500, "DECRYPT_ERROR", err.Error())
+		}
+		*v = tmp
+	}
+
+	return nil
+}
+
+// idCardIndex 证件号码盲索引，忽略大小写和空格
+func (d *Data) idCardIndex(idCard string) string {
+	if nil == d.fc || personalPlain(idCard) {
+		return ""
+	}
+
+	return d.fc.BlindIndex(strings.ToUpper(strings.Join(strings.Fields(idCard), "")))
+}
+
+// reencryptRow 有字段为明文或旧密钥密文时用当前密钥重新加密；按旧值条件更新，期间被修改的行留给下一轮。
+// bindId 为密文绑定的行，一般与 id 相同
+func (d *Data) reencryptRow(ctx context.Context, table string, id, bindId uint64, columns []string, fields []*string, extra map[string]interface{}) (bool, error) {
+	stale := false
+	for _, v := range fields {
+		if !personalPlain(*v) && d.fc.Stale(*v) {
+			stale = true
+			break
+		}
+	}
+
+	if !stale && 0 == len(extra) {
+		return false, nil
+	}
+
+	old := make([]string, len(fields))
+	for k, v := range fields {
+		old[k] = *v
+	}
+
+	if err := d.openFields(ctx, table, bindId, columns, fields); err != nil {
+		return false, err
+	}
+
+	updates := make(map[string]interface{}, len(columns)+len(extra)+1)
+	for k, v := range fields {
+		if personalPlain(*v) {
+			continue
+		}
+
+		tmp, err := d.fc.Encrypt(ctx, *v, fieldBind(table, columns[k], bindId))
+		if err != nil {
+			return false, errors.New(500, "ENCRYPT_ERROR", err.Error())
+		}
+		updates[columns[k]] = tmp
+	}
+	for k, v := range extra {
+		updates[k] = v
+	}
+	updates["updated_at"] = time.Now().Format("2006-01-02 15:04:05")
+
+	db := d.DB(ctx).Table(table).Where("id=?", id)
+	for k, v := range old {
+		db = db.Where(columns[k]+"=?", v)
+	}
+
+	res := db.Updates(updates)
+	if res.Error != nil {
+		return false, errors.New(500, "REENCRYPT_ERROR", res.Error.Error())
+	}
+
+	return 0 < res.RowsAffected, nil
+}
+
+// ReencryptUsers 返回本批最后一行的 id 和重新加密的行数，id 为 0 表示已处理完
+func (u *UserRepo) ReencryptUsers(ctx context.Context, afterId uint64, limit int) (uint64, int, error) {
+	if nil == u.data.fc {
+		return 0, 0, nil
+	}
+
+	var users []*User
+	if err := u.data.DB(ctx).Table("user").Where("id>?", afterId).Order("id asc").Limit(limit).Find(&users).Error; err != nil {
+		return 0, 0, errors.New(500, "USER ERROR", err.Error())
+	}
+
+	var (
+		lastId  uint64
+		updated int
+	)
+	for _, v := range users {
+		lastId = v.ID
+		ok, err := u.data.reencryptRow(ctx, "user", v.ID, v.ID, userPersonalColumns, userPersonalFields(v), nil)
+		if err != nil {
+			return lastId, updated, err
+		}
+		if ok {
+			updated++
+		}
+	}
+
+	return lastId, updated, nil
+}
+
+// ReencryptCardTwos 同 ReencryptUsers，同时补上缺失的证件号码盲索引
+func (u *UserRepo) ReencryptCardTwos(ctx context.Context, afterId uint64, limit int) (uint64, int, error) {
+	if nil == u.data.fc {
+		return 0, 0, nil
+	}
+
+	var cardTwos []*CardTwo
+	if err := u.data.DB(ctx).Table("card_two").Where("id>?", afterId).Order("id asc").Limit(limit).Find(&cardTwos).Error; err != nil {
+		return 0, 0, errors.New(500, "CARD_TWO ERROR", err.Error())
+	}
+
+	var (
+		lastId  uint64
+		updated int
+	)
+	for _, v := range cardTwos {
+		lastId = v.ID
+
+		var extra map[string]interface{}
+		if "" == v.IdCardBidx && !personalPlain(v.IdCard) {
+			idCard := v.IdCard
+			if err := u.data.openFields(ctx, "card_two", v.ID, []string{"id_card"}, []*string{&idCard}); err != nil {
+				return lastId, updated, err
+			}
+			extra = map[string]interface{}{"id_card_bidx": u.data.idCardIndex(idCard)}
+		}
+
+		ok, err := u.data.reencryptRow(ctx, "card_two", v.ID, v.ID, cardTwoPersonalColumns, cardTwoPersonalFields(v), extra)
+		if err != nil {
+			return lastId, updated, err
+		}
+		if ok {
+			updated++
+		}
+	}
+
+	return lastId, updated, nil
+}
+
+// GetCardTwoUserIdsByIdCard 提交过该证件号码的用户，同时匹配盲索引和尚未加密的旧数据
+func (u *UserRepo) GetCardTwoUserIdsByIdCard(ctx context.Context, idCard string) ([]uint64, error) {
+	db := u.data.DB(ctx).Table("card_two").Distinct("user_id")
+	if bidx := u.data.idCardIndex(idCard); "" != bidx {
+		db = db.Where("id_card_bidx=? or id_card=?", bidx, idCard)
+	} else {
+		db = db.Where("id_card=?", idCard)
+	}
+
+	var userIds []uint64
+	if err := db.Pluck("user_id", &userIds).Error; err != nil {
+		return nil, errors.New(500, "CARD_TWO ERROR", err.Error())
+	}
+
+	return userIds, nil
+}
@@ -3,6 +3,9 @@ package data
 import (
 	"cardbinance/internal/biz"
 	"cardbinance/internal/conf"
+	"cardbinance/internal/pkg/envelope"
+	"cardbinance/internal/pkg/redact"
+	"cardbinance/internal/pkg/telemetry"
 	"context"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
@@ -11,17 +14,19 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 	"gorm.io/gorm/schema"
+	"io"
 	slog "log"
 	"os"
 	"time"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewDB, NewRedis, NewTransaction, NewUserRepo)
+var ProviderSet = wire.NewSet(NewData, NewDB, NewRedis, NewTransaction, NewUserRepo, NewCardRepo, NewBalanceRepo, NewFeeRepo, NewIdempotencyRepo, NewLockRepo, NewAuditRepo, NewHealthRepo, NewSchedulerRepo, NewNotifyRepo, NewNotifySenders, NewEventRepo, NewTotpRepo, NewWithdrawAddressRepo, NewRiskRepo, NewScreeningRepo, NewPrivacyRepo, NewInterlaceClient)
 
 type Data struct {
 	db  *gorm.DB
 	rdb *redis.Client
+	fc  *envelope.Cipher // 个人信息字段加密，未配置时为 nil
 }
 
 // 用来承载事务的上下文
@@ -29,10 +34,18 @@ type contextTxKey struct{}
 
 // NewData .
 func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, rdb *redis.Client) (*Data, func(), error) {
+	fc, err := NewFieldCipher(c.Crypto)
+	if err != nil {
+		return nil, nil, err
+	}
+	if nil == fc {
+		log.NewHelper(logger).Warn("data.crypto.keyfile is empty, personal data is stored in plaintext")
+	}
+
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
 	}
-	return &Data{db: db, rdb: rdb}, cleanup, nil
+	return &Data{db: db, rdb: rdb, fc: fc}, cleanup, nil
 }
 
 // NewTransaction .
@@ -42,9 +55,11 @@ func NewTransaction(d *Data) biz.Transaction {
 
 // ExecTx gorm Transaction
 func (d *Data) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
-	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		ctx = context.WithValue(ctx, contextTxKey{}, tx)
-		return fn(ctx)
+	return telemetry.Tx(ctx, func(ctx context.Context) error {
+		return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			ctx = context.WithValue(ctx, contextTxKey{}, tx)
+			return fn(ctx)
+		})
 	})
 }
 
@@ -57,22 +72,60 @@ func (d *Data) DB(ctx context.Context) *gorm.DB {
 	return d.db
 }
 
+// 计数加一，没有过期时间时设置为窗口长度；同一脚本内执行，INCR 之后进程退出也不会留下永不过期的计数
+var incrWindowScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if redis.call("PTTL", KEYS[1]) < 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return count
+`)
+
+// incrWindow 窗口从第一次计数开始计算
+func (d *Data) incrWindow(ctx context.Context, key string, window time.Duration) (int64, error) {
+	return incrWindowScript.Run(ctx, d.rdb, []string{key}, window.Milliseconds()).Int64()
+}
+
 // NewDB .
 func NewDB(c *conf.Data) *gorm.DB {
-	f, err := os.OpenFile("../../log/sql.log", os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
-	if err != nil {
-		log.Errorf("failed opening sql.log", err)
-		panic("failed opening sql.log")
+	var (
+		w         io.Writer = os.Stdout
+		colorful            = true
+		slow                = time.Second
+		sqlLogLvl           = logger.Error
+	)
+
+	if "" != c.Database.LogPath {
+		f, err := os.OpenFile(c.Database.LogPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+		if err != nil {
+			log.Errorf("failed opening sql log %s: %v", c.Database.LogPath, err)
+			panic("failed opening sql log")
+		}
+		w = f
+		colorful = false
+	}
+
+	if nil != c.Database.SlowThreshold {
+		slow = c.Database.SlowThreshold.AsDuration()
+	}
+
+	switch c.Database.LogLevel {
+	case "silent":
+		sqlLogLvl = logger.Silent
+	case "warn":
+		sqlLogLvl = logger.Warn
+	case "info":
+		sqlLogLvl = logger.Info
 	}
 
-	// 终端打印输入 sql 执行记录
+	// sql 执行记录，参数中可能有用户信息，写入前脱敏
 	newLogger := logger.New(
-		slog.New(f, "\r\n", slog.LstdFlags), // io writer
+		slog.New(redact.NewWriter(w), "\r\n", slog.LstdFlags), // io writer
 		logger.Config{
-			SlowThreshold: time.Second, // 慢查询 SQL 阈值
-			Colorful:      true,        // 禁用彩色打印
+			SlowThreshold: slow,     // 慢查询 SQL 阈值
+			Colorful:      colorful, // 写文件时禁用彩色打印
 			//IgnoreRecordNotFoundError: false,
-			LogLevel: logger.Error, // Log lever
+			LogLevel: sqlLogLvl, // Log lever
 		},
 	)
 
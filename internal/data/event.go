@@ -0,0 +1,115 @@
+package data
+
+import (
+	"cardbinance/internal/biz"
+	"context"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"time"
+)
+
+const accountEventChannel = "account_events"
+
+func streamTicketKey(ticket string) string {
+	return "stream_ticket:" + ticket
+}
+
+type EventRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewEventRepo(data *Data, logger log.Logger) biz.EventRepo {
+	return &EventRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// PublishAccountEvent .
+func (e *EventRepo) PublishAccountEvent(ctx context.Context, event *biz.AccountEvent) error {
+	b, err := json.Marshal(event)
+	if nil != err {
+		return err
+	}
+
+	if err = e.data.rdb.Publish(ctx, accountEventChannel, b).Err(); nil != err {
+		return errors.New(500, "PUBLISH_EVENT_ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// SubscribeAccountEvents 断线后由 go-redis 自动重新订阅，期间的事件会丢失
+func (e *EventRepo) SubscribeAccountEvents(ctx context.Context) (<-chan *biz.AccountEvent, error) {
+	pubsub := e.data.rdb.Subscribe(ctx, accountEventChannel)
+	if _, err := pubsub.Receive(ctx); nil != err {
+		_ = pubsub.Close()
+		return nil, errors.New(500, "SUBSCRIBE_EVENT_ERROR", err.Error())
+	}
+
+	res := make(chan *biz.AccountEvent, 256)
+	go func() {
+		defer close(res)
+		defer pubsub.Close()
+
+		msgs := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-msgs:
+				if !ok {
+					return
+				}
+
+				var event biz.AccountEvent
+				if err := json.Unmarshal([]byte(msg.Payload), &event); nil != err {
+					e.log.Errorw("msg", "账户事件解析失败", "err", err)
+					continue
+				}
+
+				select {
+				case res <- &event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return res, nil
+}
+
+// CreateStreamTicket .
+func (e *EventRepo) CreateStreamTicket(ctx context.Context, ticket string, st *biz.StreamTicket, ttl time.Duration) error {
+	b, err := json.Marshal(st)
+	if nil != err {
+		return err
+	}
+
+	if err = e.data.rdb.Set(ctx, streamTicketKey(ticket), b, ttl).Err(); nil != err {
+		return errors.New(500, "STREAM_TICKET_ERROR", "保存推送票据失败")
+	}
+
+	return nil
+}
+
+// TakeStreamTicket GETDEL 保证票据只能使用一次
+func (e *EventRepo) TakeStreamTicket(ctx context.Context, ticket string) (*biz.StreamTicket, error) {
+	b, err := e.data.rdb.GetDel(ctx, streamTicketKey(ticket)).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	} else if nil != err {
+		return nil, errors.New(500, "STREAM_TICKET_ERROR", "查询推送票据失败")
+	}
+
+	var st biz.StreamTicket
+	if err = json.Unmarshal(b, &st); nil != err {
+		return nil, nil
+	}
+
+	return &st, nil
+}
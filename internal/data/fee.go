@@ -0,0 +1,65 @@
+package data
+
+import (
+	"cardbinance/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type FeeSchedule struct {
+	ID        uint64    `gorm:"primarykey;type:int"`
+	Operation string    `gorm:"type:varchar(45);not null"`
+	ProductId string    `gorm:"type:varchar(45);not null;default:'0'"`
+	Vip       uint64    `gorm:"type:int;not null"`
+	Fixed     float64   `gorm:"type:decimal(65,20);not null"`
+	Rate      float64   `gorm:"type:decimal(65,20);not null"`
+	Min       float64   `gorm:"type:decimal(65,20);not null"`
+	Max       float64   `gorm:"type:decimal(65,20);not null"`
+	MinAmount float64   `gorm:"type:decimal(65,20);not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type FeeRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewFeeRepo(data *Data, logger log.Logger) biz.FeeRepo {
+	return &FeeRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetFeeSchedulesByOperation .
+func (f *FeeRepo) GetFeeSchedulesByOperation(ctx context.Context, operation string) ([]*biz.FeeSchedule, error) {
+	var schedules []*FeeSchedule
+	res := make([]*biz.FeeSchedule, 0)
+	if err := f.data.DB(ctx).Table("fee_schedule").Where("operation=?", operation).Order("id asc").Find(&schedules).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "FEE_SCHEDULE ERROR", err.Error())
+	}
+
+	for _, schedule := range schedules {
+		res = append(res, &biz.FeeSchedule{
+			ID:        schedule.ID,
+			Operation: schedule.Operation,
+			ProductId: schedule.ProductId,
+			Vip:       schedule.Vip,
+			Fixed:     schedule.Fixed,
+			Rate:      schedule.Rate,
+			Min:       schedule.Min,
+			Max:       schedule.Max,
+			MinAmount: schedule.MinAmount,
+		})
+	}
+
+	return res, nil
+}
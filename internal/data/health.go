@@ -0,0 +1,34 @@
+package data
+
+import (
+	"cardbinance/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type HealthRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewHealthRepo(data *Data, logger log.Logger) biz.HealthRepo {
+	return &HealthRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// PingDB .
+func (h *HealthRepo) PingDB(ctx context.Context) error {
+	db, err := h.data.db.DB()
+	if err != nil {
+		return err
+	}
+
+	return db.PingContext(ctx)
+}
+
+// PingRedis .
+func (h *HealthRepo) PingRedis(ctx context.Context) error {
+	return h.data.rdb.Ping(ctx).Err()
+}
@@ -0,0 +1,127 @@
+package data
+
+import (
+	"cardbinance/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type IdempotencyKey struct {
+	ID          uint64    `gorm:"primarykey;type:int"`
+	UserId      uint64    `gorm:"type:int;not null;uniqueIndex:idx_user_key"`
+	IdemKey     string    `gorm:"type:varchar(100);not null;uniqueIndex:idx_user_key"`
+	Operation   string    `gorm:"type:varchar(45);not null"`
+	Fingerprint string    `gorm:"type:varchar(64);not null"`
+	Status      string    `gorm:"type:varchar(45);not null"`
+	Reply       string    `gorm:"type:text"`
+	CreatedAt   time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
+}
+
+type IdempotencyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewIdempotencyRepo(data *Data, logger log.Logger) biz.IdempotencyRepo {
+	return &IdempotencyRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func idempotencyKeyToBiz(ik *IdempotencyKey) *biz.IdempotencyKey {
+	return &biz.IdempotencyKey{
+		ID:          ik.ID,
+		UserId:      ik.UserId,
+		IdemKey:     ik.IdemKey,
+		Operation:   ik.Operation,
+		Fingerprint: ik.Fingerprint,
+		Status:      ik.Status,
+		Reply:       ik.Reply,
+		CreatedAt:   ik.CreatedAt,
+		UpdatedAt:   ik.UpdatedAt,
+	}
+}
+
+// CreateIdempotencyKey 依赖 user_id+idem_key 唯一索引，插入失败时读取已有记录
+func (i *IdempotencyRepo) CreateIdempotencyKey(ctx context.Context, bik *biz.IdempotencyKey) (*biz.IdempotencyKey, bool, error) {
+	ik := &IdempotencyKey{
+		UserId:      bik.UserId,
+		IdemKey:     bik.IdemKey,
+		Operation:   bik.Operation,
+		Fingerprint: bik.Fingerprint,
+		Status:      bik.Status,
+	}
+
+	resInsert := i.data.DB(ctx).Table("idempotency_key").Create(ik)
+	if resInsert.Error == nil && 0 < resInsert.RowsAffected {
+		return idempotencyKeyToBiz(ik), true, nil
+	}
+
+	var exist IdempotencyKey
+	if err := i.data.DB(ctx).Table("idempotency_key").Where("user_id=?", bik.UserId).Where("idem_key=?", bik.IdemKey).First(&exist).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, errors.New(500, "CREATE_IDEMPOTENCY_ERROR", "幂等记录创建失败")
+		}
+
+		return nil, false, errors.New(500, "IDEMPOTENCY ERROR", err.Error())
+	}
+
+	return idempotencyKeyToBiz(&exist), false, nil
+}
+
+// GetIdempotencyKey .
+func (i *IdempotencyRepo) GetIdempotencyKey(ctx context.Context, userId uint64, key string) (*biz.IdempotencyKey, error) {
+	var ik IdempotencyKey
+	if err := i.data.DB(ctx).Table("idempotency_key").Where("user_id=?", userId).Where("idem_key=?", key).First(&ik).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "IDEMPOTENCY ERROR", err.Error())
+	}
+
+	return idempotencyKeyToBiz(&ik), nil
+}
+
+// FinishIdempotencyKey .
+func (i *IdempotencyRepo) FinishIdempotencyKey(ctx context.Context, id uint64, reply string) error {
+	res := i.data.DB(ctx).Table("idempotency_key").Where("id=?", id).
+		Updates(map[string]interface{}{
+			"status":     biz.IdempotencyStatusDone,
+			"reply":      reply,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_IDEMPOTENCY_ERROR", "幂等记录修改失败")
+	}
+
+	return nil
+}
+
+// DeleteIdempotencyKey .
+func (i *IdempotencyRepo) DeleteIdempotencyKey(ctx context.Context, id uint64) error {
+	res := i.data.DB(ctx).Table("idempotency_key").Where("id=?", id).Delete(&IdempotencyKey{})
+	if res.Error != nil {
+		return errors.New(500, "DELETE_IDEMPOTENCY_ERROR", "幂等记录删除失败")
+	}
+
+	return nil
+}
+
+// DeleteStaleIdempotencyKeys .
+func (i *IdempotencyRepo) DeleteStaleIdempotencyKeys(ctx context.Context, before time.Time) (int64, error) {
+	res := i.data.DB(ctx).Table("idempotency_key").
+		Where("status=?", biz.IdempotencyStatusProcessing).
+		Where("updated_at<?", before.Format("2006-01-02 15:04:05")).
+		Delete(&IdempotencyKey{})
+	if res.Error != nil {
+		return 0, errors.New(500, "DELETE_IDEMPOTENCY_ERROR", "幂等记录删除失败")
+	}
+
+	return res.RowsAffected, nil
+}
@@ -0,0 +1,23 @@
+package data
+
+import (
+	"cardbinance/internal/conf"
+	"cardbinance/internal/pkg/interlace"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewInterlaceClient 未配置时返回的客户端调用时报 interlace.ErrNotConfigured
+func NewInterlaceClient(c *conf.Interlace, logger log.Logger) *interlace.Client {
+	cfg := interlace.Config{}
+	if nil != c {
+		cfg.BaseURL = c.BaseUrl
+		cfg.ClientId = c.ClientId
+		cfg.AccountId = c.AccountId
+		cfg.Timeout = c.Timeout.AsDuration()
+		cfg.MaxRetries = int(c.MaxRetries)
+		cfg.BreakerThreshold = int(c.BreakerThreshold)
+		cfg.BreakerCooldown = c.BreakerCooldown.AsDuration()
+	}
+
+	return interlace.New(cfg, logger)
+}
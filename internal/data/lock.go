@@ -0,0 +1,136 @@
+package data
+
+import (
+	"cardbinance/internal/biz"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"strconv"
+	"time"
+)
+
+const (
+	lockKeyPrefix  = "lock:"
+	fenceKeyPrefix = "lock:fence:"
+	lockRetry      = 50 * time.Millisecond
+)
+
+// 加锁成功后签发 fencing token
+var lockScript = redis.NewScript(`
+if redis.call("SET", KEYS[1], ARGV[1], "NX", "PX", ARGV[2]) then
+	return redis.call("INCR", KEYS[2])
+end
+return 0
+`)
+
+// 只释放自己持有的锁
+var unlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// 锁仍由自己持有且 token 是最新签发的
+var validateScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) ~= ARGV[1] then
+	return 0
+end
+if redis.call("GET", KEYS[2]) ~= ARGV[2] then
+	return 0
+end
+return 1
+`)
+
+// LockFence 每个锁 key 已写入数据库的最大 fencing token
+type LockFence struct {
+	LockKey   string    `gorm:"primarykey;type:varchar(100)"`
+	Token     int64     `gorm:"type:bigint;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type LockRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewLockRepo(data *Data, logger log.Logger) biz.Locker {
+	return &LockRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Lock .
+func (l *LockRepo) Lock(ctx context.Context, key string, ttl, wait time.Duration) (*biz.Lock, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, errors.New(500, "LOCK ERROR", err.Error())
+	}
+	owner := hex.EncodeToString(b)
+
+	deadline := time.Now().Add(wait)
+	for {
+		token, err := lockScript.Run(ctx, l.data.rdb, []string{lockKeyPrefix + key, fenceKeyPrefix + key}, owner, ttl.Milliseconds()).Int64()
+		if err != nil {
+			return nil, errors.New(500, "LOCK ERROR", err.Error())
+		}
+		if 0 < token {
+			return &biz.Lock{Key: key, Owner: owner, Token: token}, nil
+		}
+
+		if time.Now().After(deadline) {
+			return nil, biz.ErrLockNotAcquired
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, biz.ErrLockNotAcquired
+		case <-time.After(lockRetry):
+		}
+	}
+}
+
+// Unlock .
+func (l *LockRepo) Unlock(ctx context.Context, lock *biz.Lock) error {
+	if err := unlockScript.Run(ctx, l.data.rdb, []string{lockKeyPrefix + lock.Key}, lock.Owner).Err(); err != nil {
+		return errors.New(500, "UNLOCK ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// Validate .
+func (l *LockRepo) Validate(ctx context.Context, lock *biz.Lock) error {
+	res, err := validateScript.Run(ctx, l.data.rdb, []string{lockKeyPrefix + lock.Key, fenceKeyPrefix + lock.Key}, lock.Owner, strconv.FormatInt(lock.Token, 10)).Int64()
+	if err != nil {
+		return errors.New(500, "LOCK ERROR", err.Error())
+	}
+	if 1 != res {
+		return errors.New(409, "LOCK_LOST", "锁已失效")
+	}
+
+	return nil
+}
+
+// Fence token 只增不减，写入后在本事务内读回，读到的不是自己的 token 说明已有新的持有者
+func (l *LockRepo) Fence(ctx context.Context, lock *biz.Lock) error {
+	if err := l.data.DB(ctx).Exec("INSERT INTO lock_fence (lock_key, token, updated_at) VALUES (?, ?, ?) "+
+		"ON DUPLICATE KEY UPDATE updated_at = IF(token < VALUES(token), VALUES(updated_at), updated_at), token = GREATEST(token, VALUES(token))",
+		lock.Key, lock.Token, time.Now().Format("2006-01-02 15:04:05")).Error; err != nil {
+		return errors.New(500, "LOCK ERROR", err.Error())
+	}
+
+	var fence LockFence
+	if err := l.data.DB(ctx).Table("lock_fence").Where("lock_key=?", lock.Key).First(&fence).Error; err != nil {
+		return errors.New(500, "LOCK ERROR", err.Error())
+	}
+	if lock.Token != fence.Token {
+		return errors.New(409, "LOCK_LOST", "锁已失效")
+	}
+
+	return nil
+}
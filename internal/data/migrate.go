@@ -0,0 +1,285 @@
+package data
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 迁移文件命名：0001_name.up.sql / 0001_name.down.sql，版本号只增不改
+//
+//go:embed migrations/*.sql
+var migrationFS embed.FS
+
+var migrationFileRe = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+type MigrationStatus struct {
+	Version   uint64
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+}
+
+type SchemaMigration struct {
+	Version   uint64    `gorm:"primarykey;type:bigint;autoIncrement:false"`
+	Name      string    `gorm:"type:varchar(100);not null"`
+	AppliedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type Migrator struct {
+	db         *gorm.DB
+	migrations []*Migration
+	log        *log.Helper
+}
+
+func NewMigrator(db *gorm.DB, logger log.Logger) (*Migrator, error) {
+	migrations, err := loadMigrations(migrationFS)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+		log:        log.NewHelper(logger),
+	}, nil
+}
+
+func loadMigrations(fsys fs.FS) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[uint64]*Migration, 0)
+	for _, v := range entries {
+		m := migrationFileRe.FindStringSubmatch(v.Name())
+		if nil == m {
+			return nil, fmt.Errorf("invalid migration file name %s", v.Name())
+		}
+
+		version, _ := strconv.ParseUint(m[1], 10, 64)
+		content, err := fs.ReadFile(fsys, "migrations/"+v.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := byVersion[version]; !ok {
+			byVersion[version] = &Migration{Version: version, Name: m[2]}
+		}
+		if byVersion[version].Name != m[2] {
+			return nil, fmt.Errorf("migration %d has different names", version)
+		}
+
+		if "up" == m[3] {
+			byVersion[version].Up = string(content)
+		} else {
+			byVersion[version].Down = string(content)
+		}
+	}
+
+	res := make([]*Migration, 0, len(byVersion))
+	for _, v := range byVersion {
+		if "" == v.Up || "" == v.Down {
+			return nil, fmt.Errorf("migration %d needs both up and down scripts", v.Version)
+		}
+		res = append(res, v)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Version < res[j].Version
+	})
+
+	return res, nil
+}
+
+// splitStatements 按行尾分号拆分，MySQL 连接默认不允许一次执行多条语句
+func splitStatements(script string) []string {
+	res := make([]string, 0)
+	var sb strings.Builder
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if "" == trimmed || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+
+		sb.WriteString(line)
+		sb.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			res = append(res, strings.TrimSpace(sb.String()))
+			sb.Reset()
+		}
+	}
+
+	if tail := strings.TrimSpace(sb.String()); "" != tail {
+		res = append(res, tail)
+	}
+
+	return res
+}
+
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	return m.db.WithContext(ctx).Exec("CREATE TABLE IF NOT EXISTS `schema_migrations` (" +
+		"`version` bigint NOT NULL, " +
+		"`name` varchar(100) NOT NULL, " +
+		"`applied_at` datetime NOT NULL, " +
+		"PRIMARY KEY (`version`)" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4").Error
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[uint64]*SchemaMigration, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, errors.New(500, "MIGRATE ERROR", err.Error())
+	}
+
+	var rows []*SchemaMigration
+	if err := m.db.WithContext(ctx).Table("schema_migrations").Find(&rows).Error; err != nil {
+		return nil, errors.New(500, "MIGRATE ERROR", err.Error())
+	}
+
+	res := make(map[uint64]*SchemaMigration, len(rows))
+	for _, v := range rows {
+		res[v.Version] = v
+	}
+
+	return res, nil
+}
+
+// Status .
+func (m *Migrator) Status(ctx context.Context) ([]*MigrationStatus, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*MigrationStatus, 0, len(m.migrations))
+	for _, v := range m.migrations {
+		s := &MigrationStatus{Version: v.Version, Name: v.Name}
+		if row, ok := applied[v.Version]; ok {
+			s.Applied = true
+			s.AppliedAt = row.AppliedAt
+		}
+		res = append(res, s)
+	}
+
+	return res, nil
+}
+
+// Up 按版本顺序执行未应用的迁移，steps 为0时全部执行。
+// MySQL 的 DDL 不能回滚，中途失败时需要人工处理后用 Force 修正版本
+func (m *Migrator) Up(ctx context.Context, steps int) error {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return err
+	}
+
+	done := 0
+	for _, v := range m.migrations {
+		if _, ok := applied[v.Version]; ok {
+			continue
+		}
+		if 0 < steps && done >= steps {
+			break
+		}
+
+		m.log.Infow("msg", "migrate up", "version", v.Version, "name", v.Name)
+		if err = m.exec(ctx, v.Up); err != nil {
+			return fmt.Errorf("migration %d_%s up: %w", v.Version, v.Name, err)
+		}
+
+		if err = m.db.WithContext(ctx).Table("schema_migrations").Create(&SchemaMigration{
+			Version:   v.Version,
+			Name:      v.Name,
+			AppliedAt: time.Now(),
+		}).Error; err != nil {
+			return errors.New(500, "MIGRATE ERROR", err.Error())
+		}
+		done++
+	}
+
+	return nil
+}
+
+// Down 从最新版本开始回退，steps 为0时按1处理
+func (m *Migrator) Down(ctx context.Context, steps int) error {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return err
+	}
+
+	if 0 >= steps {
+		steps = 1
+	}
+
+	done := 0
+	for i := len(m.migrations) - 1; i >= 0 && done < steps; i-- {
+		v := m.migrations[i]
+		if _, ok := applied[v.Version]; !ok {
+			continue
+		}
+
+		m.log.Infow("msg", "migrate down", "version", v.Version, "name", v.Name)
+		if err = m.exec(ctx, v.Down); err != nil {
+			return fmt.Errorf("migration %d_%s down: %w", v.Version, v.Name, err)
+		}
+
+		if err = m.db.WithContext(ctx).Table("schema_migrations").Where("version=?", v.Version).Delete(&SchemaMigration{}).Error; err != nil {
+			return errors.New(500, "MIGRATE ERROR", err.Error())
+		}
+		done++
+	}
+
+	return nil
+}
+
+// Force 不执行脚本，只把 version 及之前的迁移标记为已应用，之后的标记为未应用。
+// 用于接入手工维护的线上库，或迁移中途失败后修正状态
+func (m *Migrator) Force(ctx context.Context, version uint64) error {
+	if _, err := m.applied(ctx); err != nil {
+		return err
+	}
+
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("schema_migrations").Where("version>?", version).Delete(&SchemaMigration{}).Error; err != nil {
+			return err
+		}
+
+		for _, v := range m.migrations {
+			if v.Version > version {
+				break
+			}
+
+			if err := tx.Exec("INSERT IGNORE INTO `schema_migrations` (`version`, `name`, `applied_at`) VALUES (?, ?, ?)",
+				v.Version, v.Name, time.Now()).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (m *Migrator) exec(ctx context.Context, script string) error {
+	for _, stmt := range splitStatements(script) {
+		if err := m.db.WithContext(ctx).Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS `withdraw`;
+DROP TABLE IF EXISTS `reward`;
+DROP TABLE IF EXISTS `config`;
+DROP TABLE IF EXISTS `user_recommend`;
+DROP TABLE IF EXISTS `card_record`;
+DROP TABLE IF EXISTS `card_two`;
+DROP TABLE IF EXISTS `card_code`;
+DROP TABLE IF EXISTS `user`;
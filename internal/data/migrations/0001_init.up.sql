@@ -0,0 +1,132 @@
+-- 原有线上表结构，已存在的库可用 migrate force 1 标记
+CREATE TABLE IF NOT EXISTS `user` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `address` varchar(100) DEFAULT 'no',
+  `card` varchar(100) NOT NULL DEFAULT 'no',
+  `card_order_id` varchar(100) NOT NULL DEFAULT 'no',
+  `card_number` varchar(100) NOT NULL DEFAULT 'no',
+  `card_amount` decimal(65,20) NOT NULL DEFAULT 0,
+  `amount` decimal(65,20) DEFAULT 0,
+  `is_delete` int DEFAULT 0,
+  `vip` int DEFAULT 0,
+  `my_total_amount` bigint DEFAULT 0,
+  `amount_two` bigint DEFAULT 0,
+  `first_name` varchar(45) NOT NULL DEFAULT 'no',
+  `last_name` varchar(45) NOT NULL DEFAULT 'no',
+  `email` varchar(100) NOT NULL DEFAULT 'no',
+  `country_code` varchar(45) NOT NULL DEFAULT 'no',
+  `phone` varchar(45) NOT NULL DEFAULT 'no',
+  `city` varchar(100) NOT NULL DEFAULT 'no',
+  `country` varchar(100) NOT NULL DEFAULT 'no',
+  `street` varchar(100) NOT NULL DEFAULT 'no',
+  `postal_code` varchar(45) NOT NULL DEFAULT 'no',
+  `birth_date` varchar(45) NOT NULL DEFAULT 'no',
+  `max_card_quota` bigint DEFAULT 0,
+  `product_id` varchar(45) NOT NULL DEFAULT '0',
+  `card_user_id` varchar(45) NOT NULL DEFAULT '0',
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `user_count` int DEFAULT 0,
+  `vip_two` int DEFAULT 0,
+  `card_two` int DEFAULT 0,
+  `can_vip` int DEFAULT 0,
+  `vip_three` int DEFAULT 0,
+  `card_two_number` varchar(100) NOT NULL DEFAULT 'no',
+  `card_number_rel` varchar(100) NOT NULL DEFAULT 'no',
+  `card_number_rel_two` varchar(100) NOT NULL DEFAULT 'no',
+  `pic` varchar(45) NOT NULL DEFAULT 'no',
+  `pic_two` varchar(45) NOT NULL DEFAULT 'no',
+  `lock_card` int DEFAULT 0,
+  `lock_card_two` int DEFAULT 0,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `card_code` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `last` int NOT NULL,
+  `code` varchar(100) NOT NULL DEFAULT 'no',
+  `card` varchar(100) NOT NULL DEFAULT 'no',
+  `time` datetime NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `card_two` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `first_name` varchar(45) NOT NULL DEFAULT 'no',
+  `last_name` varchar(45) NOT NULL DEFAULT 'no',
+  `email` varchar(100) NOT NULL DEFAULT 'no',
+  `country_code` varchar(45) NOT NULL DEFAULT 'no',
+  `phone` varchar(45) NOT NULL DEFAULT 'no',
+  `city` varchar(100) NOT NULL DEFAULT 'no',
+  `country` varchar(100) NOT NULL DEFAULT 'no',
+  `street` varchar(100) NOT NULL DEFAULT 'no',
+  `postal_code` varchar(45) NOT NULL DEFAULT 'no',
+  `birth_date` varchar(45) NOT NULL DEFAULT 'no',
+  `phone_country_code` varchar(45) NOT NULL DEFAULT 'no',
+  `state` varchar(45) NOT NULL DEFAULT 'no',
+  `status` int DEFAULT 0,
+  `card_id` varchar(100) NOT NULL DEFAULT 'no',
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `id_card` varchar(45) NOT NULL DEFAULT 'no',
+  `gender` varchar(45) NOT NULL DEFAULT 'no',
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `card_record` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `record_type` int NOT NULL,
+  `remark` varchar(500) NOT NULL,
+  `code` varchar(100) NOT NULL,
+  `opt` varchar(100) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_recommend` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `recommend_code` varchar(10000) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `config` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `name` varchar(45) NOT NULL,
+  `key_name` varchar(45) NOT NULL,
+  `value` varchar(1000) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `reward` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `amount` decimal(65,20) NOT NULL,
+  `reason` int NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `address` varchar(100) NOT NULL,
+  `one` int NOT NULL DEFAULT 0,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `withdraw` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int DEFAULT NULL,
+  `amount` decimal(65,20) NOT NULL,
+  `rel_amount` decimal(65,20) NOT NULL,
+  `status` varchar(45) NOT NULL,
+  `address` varchar(45) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,6 @@
+ALTER TABLE `withdraw` DROP COLUMN `currency`;
+
+ALTER TABLE `reward` DROP COLUMN `currency`;
+
+DROP TABLE IF EXISTS `user_balance`;
+DROP TABLE IF EXISTS `card`;
@@ -0,0 +1,67 @@
+CREATE TABLE IF NOT EXISTS `card` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `card_type` int NOT NULL,
+  `product_id` varchar(45) NOT NULL DEFAULT '0',
+  `issuer_card_id` varchar(100) NOT NULL DEFAULT '',
+  `status` varchar(45) NOT NULL,
+  `masked_pan` varchar(45) NOT NULL DEFAULT '',
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_card_user_id` (`user_id`),
+  KEY `idx_card_status` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_balance` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `currency` varchar(45) NOT NULL,
+  `amount` decimal(65,20) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_user_currency` (`user_id`, `currency`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+ALTER TABLE `reward` ADD COLUMN `currency` varchar(45) NOT NULL DEFAULT 'USDT';
+
+ALTER TABLE `withdraw` ADD COLUMN `currency` varchar(45) NOT NULL DEFAULT 'USDT';
+
+-- 旧字段上的卡片写入 card 表，规则与 CardRepo.MigrateLegacyCards 一致：
+-- card_order_id 非 'no' 为虚拟卡，'success' 且有卡号为已激活；card_two 大于0为实体卡，2 且有卡号为已激活；lock_card 为冻结
+INSERT INTO `card` (`user_id`, `card_type`, `product_id`, `issuer_card_id`, `status`, `masked_pan`, `created_at`, `updated_at`)
+SELECT `id`, 1, IF(`product_id` = '', '0', `product_id`),
+  IF(`card_order_id` = 'success' AND CHAR_LENGTH(`card_number`) >= 10, `card_number`, ''),
+  CASE
+    WHEN `lock_card` = 1 THEN 'frozen'
+    WHEN `card_order_id` = 'success' AND CHAR_LENGTH(`card_number`) >= 10 THEN 'active'
+    ELSE 'pending'
+  END,
+  CASE
+    WHEN CHAR_LENGTH(REGEXP_REPLACE(`card_number_rel`, '[^0-9]', '')) >= 12 THEN CONCAT(LEFT(REGEXP_REPLACE(`card_number_rel`, '[^0-9]', ''), 8), 'xxxxxx', RIGHT(REGEXP_REPLACE(`card_number_rel`, '[^0-9]', ''), 4))
+    WHEN CHAR_LENGTH(`card_number_rel`) > 5 THEN LEFT(`card_number_rel`, 45)
+    ELSE ''
+  END,
+  NOW(), NOW()
+FROM `user`
+WHERE `card_order_id` <> 'no'
+  AND `id` NOT IN (SELECT `user_id` FROM `card` WHERE `card_type` = 1);
+
+INSERT INTO `card` (`user_id`, `card_type`, `product_id`, `issuer_card_id`, `status`, `masked_pan`, `created_at`, `updated_at`)
+SELECT `id`, 2, '0',
+  IF(`card_two` = 2 AND CHAR_LENGTH(`card_two_number`) >= 10, `card_two_number`, ''),
+  CASE
+    WHEN `lock_card_two` = 1 THEN 'frozen'
+    WHEN `card_two` = 2 AND CHAR_LENGTH(`card_two_number`) >= 10 THEN 'active'
+    ELSE 'pending'
+  END,
+  CASE
+    WHEN CHAR_LENGTH(REGEXP_REPLACE(`card_number_rel_two`, '[^0-9]', '')) >= 12 THEN CONCAT(LEFT(REGEXP_REPLACE(`card_number_rel_two`, '[^0-9]', ''), 8), 'xxxxxx', RIGHT(REGEXP_REPLACE(`card_number_rel_two`, '[^0-9]', ''), 4))
+    WHEN CHAR_LENGTH(`card_number_rel_two`) > 5 THEN LEFT(`card_number_rel_two`, 45)
+    ELSE ''
+  END,
+  NOW(), NOW()
+FROM `user`
+WHERE `card_two` > 0
+  AND `id` NOT IN (SELECT `user_id` FROM `card` WHERE `card_type` = 2);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS `audit_log`;
+DROP TABLE IF EXISTS `idempotency_key`;
+DROP TABLE IF EXISTS `fee_schedule`;
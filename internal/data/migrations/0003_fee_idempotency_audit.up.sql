@@ -0,0 +1,51 @@
+CREATE TABLE IF NOT EXISTS `fee_schedule` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `operation` varchar(45) NOT NULL,
+  `product_id` varchar(45) NOT NULL DEFAULT '0',
+  `vip` int NOT NULL,
+  `fixed` decimal(65,20) NOT NULL,
+  `rate` decimal(65,20) NOT NULL,
+  `min` decimal(65,20) NOT NULL,
+  `max` decimal(65,20) NOT NULL,
+  `min_amount` decimal(65,20) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_fee_schedule_operation` (`operation`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `idempotency_key` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `idem_key` varchar(100) NOT NULL,
+  `operation` varchar(45) NOT NULL,
+  `fingerprint` varchar(64) NOT NULL,
+  `status` varchar(45) NOT NULL,
+  `reply` text,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_user_key` (`user_id`, `idem_key`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `audit_log` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `actor_id` int NOT NULL,
+  `target_id` int NOT NULL,
+  `operation` varchar(45) NOT NULL,
+  `ip` varchar(64) NOT NULL,
+  `user_agent` varchar(255) NOT NULL,
+  `request` text,
+  `before` text,
+  `after` text,
+  `result` varchar(255) NOT NULL,
+  `prev_hash` varchar(64) NOT NULL,
+  `hash` varchar(64) NOT NULL,
+  `created_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_audit_log_hash` (`hash`),
+  KEY `idx_audit_log_actor_id` (`actor_id`),
+  KEY `idx_audit_log_target_id` (`target_id`),
+  KEY `idx_audit_log_operation` (`operation`),
+  KEY `idx_audit_log_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
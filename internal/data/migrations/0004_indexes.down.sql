@@ -0,0 +1,13 @@
+ALTER TABLE `config` DROP KEY `idx_config_key_name`;
+
+ALTER TABLE `card_record` DROP KEY `idx_card_record_user_id`;
+
+ALTER TABLE `withdraw` DROP KEY `idx_withdraw_user_id`;
+
+ALTER TABLE `user_recommend` DROP KEY `idx_user_recommend_user_id`;
+
+ALTER TABLE `card_code` DROP KEY `idx_card_code_card`;
+
+ALTER TABLE `reward` DROP KEY `idx_reward_user_reason`;
+
+ALTER TABLE `user` DROP KEY `idx_user_address`;
@@ -0,0 +1,22 @@
+-- user.address 的默认值为 'no'，加唯一索引前把占位值改为按 id 区分的 'no:<id>'，
+-- 重复的钱包地址保留 id 最小的一条（登录一直取这一条），其余改为 '<address>:dup:<id>'
+UPDATE `user` SET `address` = CONCAT('no:', `id`) WHERE `address` IS NULL OR `address` IN ('no', '');
+
+UPDATE `user` u
+JOIN (SELECT `address`, MIN(`id`) AS `keep_id` FROM `user` GROUP BY `address` HAVING COUNT(*) > 1) d ON u.`address` = d.`address`
+SET u.`address` = CONCAT(u.`address`, ':dup:', u.`id`)
+WHERE u.`id` <> d.`keep_id`;
+
+ALTER TABLE `user` ADD UNIQUE KEY `idx_user_address` (`address`);
+
+ALTER TABLE `reward` ADD KEY `idx_reward_user_reason` (`user_id`, `reason`);
+
+ALTER TABLE `card_code` ADD KEY `idx_card_code_card` (`card`);
+
+ALTER TABLE `user_recommend` ADD KEY `idx_user_recommend_user_id` (`user_id`);
+
+ALTER TABLE `withdraw` ADD KEY `idx_withdraw_user_id` (`user_id`);
+
+ALTER TABLE `card_record` ADD KEY `idx_card_record_user_id` (`user_id`);
+
+ALTER TABLE `config` ADD KEY `idx_config_key_name` (`key_name`);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS `card_transfer` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `card_id` int NOT NULL,
+  `reward_id` int NOT NULL,
+  `order_id` varchar(100) NOT NULL,
+  `amount` decimal(65,20) NOT NULL,
+  `status` varchar(45) NOT NULL,
+  `error` varchar(500) NOT NULL DEFAULT '',
+  `attempts` int NOT NULL DEFAULT 0,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_card_transfer_order_id` (`order_id`),
+  KEY `idx_card_transfer_user_id` (`user_id`),
+  KEY `idx_card_transfer_status` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,3 @@
+ALTER TABLE `card` DROP KEY `idx_card_issuer_card_id`;
+DROP TABLE IF EXISTS `card_transaction`;
+DROP TABLE IF EXISTS `scheduled_job`;
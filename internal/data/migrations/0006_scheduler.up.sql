@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS `scheduled_job` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `name` varchar(100) NOT NULL,
+  `owner` varchar(255) NOT NULL,
+  `last_run_at` datetime NOT NULL,
+  `duration_ms` bigint NOT NULL DEFAULT 0,
+  `error` varchar(500) NOT NULL DEFAULT '',
+  `runs` bigint NOT NULL DEFAULT 0,
+  `failures` bigint NOT NULL DEFAULT 0,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_scheduled_job_name` (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `card_transaction` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `txn_id` varchar(100) NOT NULL,
+  `user_id` int NOT NULL DEFAULT 0,
+  `card_id` int NOT NULL DEFAULT 0,
+  `issuer_card_id` varchar(100) NOT NULL,
+  `client_transaction_id` varchar(100) NOT NULL DEFAULT '',
+  `type` int NOT NULL,
+  `status` varchar(45) NOT NULL,
+  `currency` varchar(45) NOT NULL,
+  `amount` decimal(65,20) NOT NULL,
+  `fee` decimal(65,20) NOT NULL,
+  `transaction_currency` varchar(45) NOT NULL DEFAULT '',
+  `transaction_amount` decimal(65,20) NOT NULL,
+  `merchant_name` varchar(255) NOT NULL DEFAULT '',
+  `mcc` varchar(45) NOT NULL DEFAULT '',
+  `transaction_time` datetime NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_card_transaction_txn_id` (`txn_id`),
+  KEY `idx_card_transaction_user_id` (`user_id`),
+  KEY `idx_card_transaction_time` (`transaction_time`),
+  KEY `idx_card_transaction_status` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+ALTER TABLE `card` ADD KEY `idx_card_issuer_card_id` (`issuer_card_id`);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS `notify_cursor`;
+DROP TABLE IF EXISTS `notify_preference`;
+DROP TABLE IF EXISTS `notify_target`;
+DROP TABLE IF EXISTS `notification`;
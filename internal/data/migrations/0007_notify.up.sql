@@ -0,0 +1,48 @@
+CREATE TABLE IF NOT EXISTS `notification` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `event` varchar(45) NOT NULL,
+  `channel` varchar(45) NOT NULL,
+  `address` varchar(255) NOT NULL DEFAULT '',
+  `title` varchar(255) NOT NULL,
+  `body` text NOT NULL,
+  `status` varchar(45) NOT NULL,
+  `error` varchar(500) NOT NULL DEFAULT '',
+  `attempts` int NOT NULL DEFAULT 0,
+  `is_read` int NOT NULL DEFAULT 0,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_notification_user_channel` (`user_id`, `channel`),
+  KEY `idx_notification_status` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `notify_target` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `channel` varchar(45) NOT NULL,
+  `address` varchar(255) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_notify_target_user_channel` (`user_id`, `channel`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `notify_preference` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `event` varchar(45) NOT NULL,
+  `channel` varchar(45) NOT NULL,
+  `enabled` int NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_notify_preference_user_event_channel` (`user_id`, `event`, `channel`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `notify_cursor` (
+  `name` varchar(45) NOT NULL,
+  `value` bigint NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,2 @@
+ALTER TABLE `withdraw` DROP COLUMN `chain`;
+DROP TABLE IF EXISTS `withdraw_address`;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `withdraw_address` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `chain` varchar(45) NOT NULL,
+  `address` varchar(100) NOT NULL,
+  `label` varchar(100) NOT NULL DEFAULT '',
+  `available_at` datetime NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_withdraw_address_user_chain_address` (`user_id`, `chain`, `address`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+ALTER TABLE `withdraw` ADD COLUMN `chain` varchar(45) NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `risk_record`;
+DROP TABLE IF EXISTS `risk_limit`;
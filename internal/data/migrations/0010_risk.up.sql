@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS `risk_limit` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `operation` varchar(45) NOT NULL,
+  `vip` int NOT NULL,
+  `kyc_tier` int NOT NULL,
+  `single_max` decimal(65,20) NOT NULL,
+  `daily` decimal(65,20) NOT NULL,
+  `monthly` decimal(65,20) NOT NULL,
+  `review_amount` decimal(65,20) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_risk_limit_operation` (`operation`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `risk_record` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `operation` varchar(45) NOT NULL,
+  `currency` varchar(45) NOT NULL,
+  `amount` decimal(65,20) NOT NULL,
+  `amount_rel` decimal(65,20) NOT NULL,
+  `address` varchar(100) NOT NULL DEFAULT '',
+  `chain` varchar(45) NOT NULL DEFAULT '',
+  `to_user_id` int NOT NULL DEFAULT 0,
+  `status` varchar(45) NOT NULL,
+  `reasons` varchar(255) NOT NULL DEFAULT '',
+  `operator` varchar(100) NOT NULL DEFAULT '',
+  `remark` varchar(500) NOT NULL DEFAULT '',
+  `reviewed_at` datetime DEFAULT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_risk_record_user_operation` (`user_id`, `operation`, `created_at`),
+  KEY `idx_risk_record_status` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,7 @@
+ALTER TABLE `card_two`
+  DROP COLUMN `screening_list_version`,
+  DROP COLUMN `screening_result`;
+
+DROP TABLE IF EXISTS `screening`;
+DROP TABLE IF EXISTS `watchlist_entry`;
+DROP TABLE IF EXISTS `watchlist`;
@@ -0,0 +1,47 @@
+CREATE TABLE IF NOT EXISTS `watchlist` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `name` varchar(100) NOT NULL,
+  `kind` varchar(45) NOT NULL,
+  `version` varchar(100) NOT NULL,
+  `entries` int NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_watchlist_name` (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `watchlist_entry` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `watchlist_id` int NOT NULL,
+  `entry_id` varchar(100) NOT NULL DEFAULT '',
+  `name` varchar(255) NOT NULL,
+  `aliases` text,
+  `country` varchar(100) NOT NULL DEFAULT '',
+  PRIMARY KEY (`id`),
+  KEY `idx_watchlist_entry_watchlist` (`watchlist_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `screening` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `card_id` int NOT NULL DEFAULT 0,
+  `name` varchar(100) NOT NULL,
+  `country` varchar(100) NOT NULL DEFAULT '',
+  `result` varchar(45) NOT NULL,
+  `score` decimal(10,4) NOT NULL,
+  `list_version` varchar(255) NOT NULL DEFAULT '',
+  `matched_list` varchar(100) NOT NULL DEFAULT '',
+  `matched_entry_id` varchar(100) NOT NULL DEFAULT '',
+  `matched_name` varchar(255) NOT NULL DEFAULT '',
+  `fee` decimal(65,20) NOT NULL DEFAULT 0,
+  `refunded` int NOT NULL DEFAULT 0,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_screening_user` (`user_id`),
+  KEY `idx_screening_card` (`card_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+ALTER TABLE `card_two`
+  ADD COLUMN `screening_result` varchar(45) NOT NULL DEFAULT '',
+  ADD COLUMN `screening_list_version` varchar(255) NOT NULL DEFAULT '';
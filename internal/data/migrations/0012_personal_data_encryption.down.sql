@@ -0,0 +1,19 @@
+-- 缩回原长度前需要先解密，否则密文会被截断
+ALTER TABLE `card_two`
+  DROP KEY `idx_card_two_id_card_bidx`,
+  DROP COLUMN `id_card_bidx`,
+  MODIFY COLUMN `first_name` varchar(45) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `last_name` varchar(45) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `email` varchar(100) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `phone` varchar(45) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `street` varchar(100) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `birth_date` varchar(45) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `id_card` varchar(45) NOT NULL DEFAULT 'no';
+
+ALTER TABLE `user`
+  MODIFY COLUMN `first_name` varchar(45) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `last_name` varchar(45) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `email` varchar(100) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `phone` varchar(45) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `street` varchar(100) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `birth_date` varchar(45) NOT NULL DEFAULT 'no';
@@ -0,0 +1,18 @@
+ALTER TABLE `user`
+  MODIFY COLUMN `first_name` varchar(512) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `last_name` varchar(512) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `email` varchar(512) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `phone` varchar(512) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `street` varchar(512) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `birth_date` varchar(512) NOT NULL DEFAULT 'no';
+
+ALTER TABLE `card_two`
+  MODIFY COLUMN `first_name` varchar(512) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `last_name` varchar(512) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `email` varchar(512) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `phone` varchar(512) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `street` varchar(512) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `birth_date` varchar(512) NOT NULL DEFAULT 'no',
+  MODIFY COLUMN `id_card` varchar(512) NOT NULL DEFAULT 'no',
+  ADD COLUMN `id_card_bidx` varchar(32) NOT NULL DEFAULT '',
+  ADD KEY `idx_card_two_id_card_bidx` (`id_card_bidx`);
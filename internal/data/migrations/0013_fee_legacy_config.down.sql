@@ -0,0 +1 @@
+-- 迁移出的规则与旧 config 取值一致，回滚后 getFeeSchedule 仍会读取旧配置，保留数据不删除
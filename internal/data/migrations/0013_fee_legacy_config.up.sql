@@ -0,0 +1,29 @@
+-- 旧 config 中的手续费配置迁移为 fee_schedule 默认规则，已配置规则的操作不覆盖
+-- card_two 是实体卡开卡的固定金额，其余为比例
+INSERT INTO `fee_schedule` (`operation`, `product_id`, `vip`, `fixed`, `rate`, `min`, `max`, `min_amount`, `created_at`, `updated_at`)
+SELECT 'open_card_two', '0', 0, CAST(`value` AS DECIMAL(65,20)), 0, 0, 0, 0, NOW(), NOW()
+FROM `config`
+WHERE `key_name` = 'card_two' AND `value` REGEXP '^[0-9]+(\\.[0-9]+)?$'
+  AND NOT EXISTS (SELECT 1 FROM `fee_schedule` WHERE `operation` = 'open_card_two')
+ORDER BY `id` DESC LIMIT 1;
+
+INSERT INTO `fee_schedule` (`operation`, `product_id`, `vip`, `fixed`, `rate`, `min`, `max`, `min_amount`, `created_at`, `updated_at`)
+SELECT 'withdraw', '0', 0, 0, CAST(`value` AS DECIMAL(65,20)), 0, 0, 0, NOW(), NOW()
+FROM `config`
+WHERE `key_name` = 'withdraw_rate' AND `value` REGEXP '^[0-9]+(\\.[0-9]+)?$'
+  AND NOT EXISTS (SELECT 1 FROM `fee_schedule` WHERE `operation` = 'withdraw')
+ORDER BY `id` DESC LIMIT 1;
+
+INSERT INTO `fee_schedule` (`operation`, `product_id`, `vip`, `fixed`, `rate`, `min`, `max`, `min_amount`, `created_at`, `updated_at`)
+SELECT 'amount_to_card', '0', 0, 0, CAST(`value` AS DECIMAL(65,20)), 0, 0, 20, NOW(), NOW()
+FROM `config`
+WHERE `key_name` = 'amount_to_rate' AND `value` REGEXP '^[0-9]+(\\.[0-9]+)?$'
+  AND NOT EXISTS (SELECT 1 FROM `fee_schedule` WHERE `operation` = 'amount_to_card')
+ORDER BY `id` DESC LIMIT 1;
+
+INSERT INTO `fee_schedule` (`operation`, `product_id`, `vip`, `fixed`, `rate`, `min`, `max`, `min_amount`, `created_at`, `updated_at`)
+SELECT 'exchange', '0', 0, 0, CAST(`value` AS DECIMAL(65,20)), 0, 0, 0, NOW(), NOW()
+FROM `config`
+WHERE `key_name` = 'exchange_rate' AND `value` REGEXP '^[0-9]+(\\.[0-9]+)?$'
+  AND NOT EXISTS (SELECT 1 FROM `fee_schedule` WHERE `operation` = 'exchange')
+ORDER BY `id` DESC LIMIT 1;
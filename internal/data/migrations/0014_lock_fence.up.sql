@@ -0,0 +1,7 @@
+-- Redis 中的 lock:fence:* 计数器被清空后 token 会从 1 重新开始，需要同时清空本表
+CREATE TABLE IF NOT EXISTS `lock_fence` (
+  `lock_key` varchar(100) NOT NULL,
+  `token` bigint NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`lock_key`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
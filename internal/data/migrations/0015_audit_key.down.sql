@@ -0,0 +1,5 @@
+-- ip、user_agent 保持加宽，缩回会截断已加密的值
+ALTER TABLE `audit_log`
+  DROP COLUMN `actor`;
+
+DROP TABLE IF EXISTS `audit_key`;
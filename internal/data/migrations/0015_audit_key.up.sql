@@ -0,0 +1,15 @@
+-- 审计日志中用户的 IP、User-Agent 和请求参数用该用户的密钥加密，注销时删除密钥，哈希链不受影响
+CREATE TABLE IF NOT EXISTS `audit_key` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `secret` varchar(512) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_audit_key_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+ALTER TABLE `audit_log`
+  MODIFY COLUMN `ip` varchar(512) NOT NULL,
+  MODIFY COLUMN `user_agent` varchar(512) NOT NULL,
+  ADD COLUMN `actor` varchar(100) NOT NULL DEFAULT '' AFTER `target_id`;
@@ -0,0 +1,10 @@
+-- 审计哈希链的链头，写入审计日志时在事务内锁住该行串行追加，替代 redis 全局锁
+CREATE TABLE IF NOT EXISTS `audit_chain` (
+  `id` int NOT NULL,
+  `last_hash` varchar(64) NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+INSERT IGNORE INTO `audit_chain` (`id`, `last_hash`, `updated_at`)
+SELECT 1, COALESCE((SELECT `hash` FROM `audit_log` ORDER BY `id` DESC LIMIT 1), ''), NOW();
@@ -0,0 +1,355 @@
+package data
+
+import (
+	"cardbinance/internal/biz"
+	"cardbinance/internal/conf"
+	"cardbinance/internal/pkg/notify"
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type Notification struct {
+	ID        uint64    `gorm:"primarykey;type:int"`
+	UserId    uint64    `gorm:"type:int;not null"`
+	Event     string    `gorm:"type:varchar(45);not null"`
+	Channel   string    `gorm:"type:varchar(45);not null"`
+	Address   string    `gorm:"type:varchar(255);not null;default:''"`
+	Title     string    `gorm:"type:varchar(255);not null"`
+	Body      string    `gorm:"type:text;not null"`
+	Status    string    `gorm:"type:varchar(45);not null"`
+	Error     string    `gorm:"type:varchar(500);not null;default:''"`
+	Attempts  uint64    `gorm:"type:int;not null"`
+	IsRead    uint64    `gorm:"type:int;not null;default:0"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type NotifyTarget struct {
+	ID        uint64    `gorm:"primarykey;type:int"`
+	UserId    uint64    `gorm:"type:int;not null"`
+	Channel   string    `gorm:"type:varchar(45);not null"`
+	Address   string    `gorm:"type:varchar(255);not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type NotifyPreference struct {
+	ID        uint64    `gorm:"primarykey;type:int"`
+	UserId    uint64    `gorm:"type:int;not null"`
+	Event     string    `gorm:"type:varchar(45);not null"`
+	Channel   string    `gorm:"type:varchar(45);not null"`
+	Enabled   uint64    `gorm:"type:int;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type NotifyCursor struct {
+	Name      string    `gorm:"primarykey;type:varchar(45)"`
+	Value     uint64    `gorm:"type:bigint;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type NotifyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewNotifyRepo(data *Data, logger log.Logger) biz.NotifyRepo {
+	return &NotifyRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// notifySender 把 pkg/notify 的发送实现注册到对应渠道
+type notifySender struct {
+	channel string
+	sender  notify.Sender
+}
+
+func (n *notifySender) Channel() string {
+	return n.channel
+}
+
+func (n *notifySender) Send(ctx context.Context, address, title, body string) error {
+	return n.sender.Send(ctx, address, title, body)
+}
+
+// NewNotifySenders 只注册已配置的渠道
+func NewNotifySenders(c *conf.Notify) []biz.NotifySender {
+	res := make([]biz.NotifySender, 0)
+	if nil == c {
+		return res
+	}
+
+	if nil != c.Smtp && "" != c.Smtp.Addr {
+		res = append(res, &notifySender{
+			channel: biz.NotifyChannelEmail,
+			sender:  notify.NewSMTP(c.Smtp.Addr, c.Smtp.Username, c.Smtp.Password, c.Smtp.From),
+		})
+	}
+
+	if nil != c.Telegram && "" != c.Telegram.BotToken {
+		res = append(res, &notifySender{
+			channel: biz.NotifyChannelTelegram,
+			sender:  notify.NewTelegram(c.Telegram.ApiBase, c.Telegram.BotToken),
+		})
+	}
+
+	return res
+}
+
+func notificationToBiz(n *Notification) *biz.Notification {
+	return &biz.Notification{
+		ID:        n.ID,
+		UserId:    n.UserId,
+		Event:     n.Event,
+		Channel:   n.Channel,
+		Address:   n.Address,
+		Title:     n.Title,
+		Body:      n.Body,
+		Status:    n.Status,
+		Error:     n.Error,
+		Attempts:  n.Attempts,
+		Read:      1 == n.IsRead,
+		CreatedAt: n.CreatedAt,
+		UpdatedAt: n.UpdatedAt,
+	}
+}
+
+// CreateNotifications .
+func (n *NotifyRepo) CreateNotifications(ctx context.Context, bns []*biz.Notification) error {
+	ns := make([]*Notification, 0, len(bns))
+	for _, v := range bns {
+		ns = append(ns, &Notification{
+			UserId:  v.UserId,
+			Event:   v.Event,
+			Channel: v.Channel,
+			Address: v.Address,
+			Title:   v.Title,
+			Body:    v.Body,
+			Status:  v.Status,
+		})
+	}
+
+	res := n.data.DB(ctx).Table("notification").Create(&ns)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "CREATE_NOTIFICATION_ERROR", "通知创建失败")
+	}
+
+	return nil
+}
+
+// GetPendingNotifications .
+func (n *NotifyRepo) GetPendingNotifications(ctx context.Context, limit int) ([]*biz.Notification, error) {
+	var ns []*Notification
+	if err := n.data.DB(ctx).Table("notification").Where("status=?", biz.NotificationPending).
+		Order("id asc").Limit(limit).Find(&ns).Error; err != nil {
+		return nil, errors.New(500, "NOTIFICATION ERROR", err.Error())
+	}
+
+	res := make([]*biz.Notification, 0, len(ns))
+	for _, v := range ns {
+		res = append(res, notificationToBiz(v))
+	}
+
+	return res, nil
+}
+
+// UpdateNotification .
+func (n *NotifyRepo) UpdateNotification(ctx context.Context, id uint64, status, errMsg string) error {
+	if 500 < len(errMsg) {
+		errMsg = errMsg[:500]
+	}
+
+	res := n.data.DB(ctx).Table("notification").Where("id=?", id).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"error":      errMsg,
+			"attempts":   gorm.Expr("attempts + 1"),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_NOTIFICATION_ERROR", "通知修改失败")
+	}
+
+	return nil
+}
+
+// GetInboxPage .
+func (n *NotifyRepo) GetInboxPage(ctx context.Context, b *biz.Pagination, userId uint64) ([]*biz.Notification, error, int64) {
+	var (
+		count int64
+		ns    []*Notification
+	)
+
+	res := make([]*biz.Notification, 0)
+
+	instance := n.data.DB(ctx).Table("notification").Where("user_id=?", userId).
+		Where("channel=?", biz.NotifyChannelInbox).Order("id desc")
+
+	instance = instance.Count(&count)
+
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Find(&ns).Error; err != nil {
+		return nil, errors.New(500, "NOTIFICATION ERROR", err.Error()), 0
+	}
+
+	for _, v := range ns {
+		res = append(res, notificationToBiz(v))
+	}
+
+	return res, nil, count
+}
+
+// CountUnread .
+func (n *NotifyRepo) CountUnread(ctx context.Context, userId uint64) (int64, error) {
+	var count int64
+	if err := n.data.DB(ctx).Table("notification").Where("user_id=?", userId).
+		Where("channel=?", biz.NotifyChannelInbox).Where("is_read=?", 0).Count(&count).Error; err != nil {
+		return 0, errors.New(500, "NOTIFICATION ERROR", err.Error())
+	}
+
+	return count, nil
+}
+
+// ReadInbox .
+func (n *NotifyRepo) ReadInbox(ctx context.Context, userId, id uint64) error {
+	instance := n.data.DB(ctx).Table("notification").Where("user_id=?", userId).
+		Where("channel=?", biz.NotifyChannelInbox).Where("is_read=?", 0)
+	if 0 < id {
+		instance = instance.Where("id=?", id)
+	}
+
+	res := instance.Updates(map[string]interface{}{
+		"is_read":    1,
+		"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+	})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_NOTIFICATION_ERROR", "通知修改失败")
+	}
+
+	return nil
+}
+
+// GetNotifyTargets .
+func (n *NotifyRepo) GetNotifyTargets(ctx context.Context, userId uint64) ([]*biz.NotifyTarget, error) {
+	var targets []*NotifyTarget
+	if err := n.data.DB(ctx).Table("notify_target").Where("user_id=?", userId).Find(&targets).Error; err != nil {
+		return nil, errors.New(500, "NOTIFY TARGET ERROR", err.Error())
+	}
+
+	res := make([]*biz.NotifyTarget, 0, len(targets))
+	for _, v := range targets {
+		res = append(res, &biz.NotifyTarget{
+			UserId:  v.UserId,
+			Channel: v.Channel,
+			Address: v.Address,
+		})
+	}
+
+	return res, nil
+}
+
+// SaveNotifyTarget .
+func (n *NotifyRepo) SaveNotifyTarget(ctx context.Context, t *biz.NotifyTarget) error {
+	res := n.data.DB(ctx).Table("notify_target").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "channel"}},
+		DoUpdates: clause.AssignmentColumns([]string{"address", "updated_at"}),
+	}).Create(&NotifyTarget{
+		UserId:  t.UserId,
+		Channel: t.Channel,
+		Address: t.Address,
+	})
+	if res.Error != nil {
+		return errors.New(500, "SAVE_NOTIFY_TARGET_ERROR", "通知地址保存失败")
+	}
+
+	return nil
+}
+
+// DeleteNotifyTarget .
+func (n *NotifyRepo) DeleteNotifyTarget(ctx context.Context, userId uint64, channel string) error {
+	res := n.data.DB(ctx).Table("notify_target").Where("user_id=?", userId).Where("channel=?", channel).Delete(&NotifyTarget{})
+	if res.Error != nil {
+		return errors.New(500, "DELETE_NOTIFY_TARGET_ERROR", "通知地址删除失败")
+	}
+
+	return nil
+}
+
+// GetNotifyPreferences .
+func (n *NotifyRepo) GetNotifyPreferences(ctx context.Context, userId uint64) ([]*biz.NotifyPreference, error) {
+	var prefs []*NotifyPreference
+	if err := n.data.DB(ctx).Table("notify_preference").Where("user_id=?", userId).Find(&prefs).Error; err != nil {
+		return nil, errors.New(500, "NOTIFY PREFERENCE ERROR", err.Error())
+	}
+
+	res := make([]*biz.NotifyPreference, 0, len(prefs))
+	for _, v := range prefs {
+		res = append(res, &biz.NotifyPreference{
+			UserId:  v.UserId,
+			Event:   v.Event,
+			Channel: v.Channel,
+			Enabled: 1 == v.Enabled,
+		})
+	}
+
+	return res, nil
+}
+
+// SaveNotifyPreference .
+func (n *NotifyRepo) SaveNotifyPreference(ctx context.Context, p *biz.NotifyPreference) error {
+	enabled := uint64(0)
+	if p.Enabled {
+		enabled = 1
+	}
+
+	res := n.data.DB(ctx).Table("notify_preference").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "event"}, {Name: "channel"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "updated_at"}),
+	}).Create(&NotifyPreference{
+		UserId:  p.UserId,
+		Event:   p.Event,
+		Channel: p.Channel,
+		Enabled: enabled,
+	})
+	if res.Error != nil {
+		return errors.New(500, "SAVE_NOTIFY_PREFERENCE_ERROR", "通知设置保存失败")
+	}
+
+	return nil
+}
+
+// GetNotifyCursor .
+func (n *NotifyRepo) GetNotifyCursor(ctx context.Context, name string) (uint64, bool, error) {
+	var cursor NotifyCursor
+	if err := n.data.DB(ctx).Table("notify_cursor").Where("name=?", name).First(&cursor).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, false, nil
+		}
+
+		return 0, false, errors.New(500, "NOTIFY CURSOR ERROR", err.Error())
+	}
+
+	return cursor.Value, true, nil
+}
+
+// SetNotifyCursor .
+func (n *NotifyRepo) SetNotifyCursor(ctx context.Context, name string, value uint64) error {
+	res := n.data.DB(ctx).Table("notify_cursor").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"value", "updated_at"}),
+	}).Create(&NotifyCursor{
+		Name:  name,
+		Value: value,
+	})
+	if res.Error != nil {
+		return errors.New(500, "SAVE_NOTIFY_CURSOR_ERROR", "通知游标保存失败")
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package data
+
+import (
+	"cardbinance/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"strconv"
+	"time"
+)
+
+// 注销后 user 表匿名化的列，card_two 另外匿名化证件号码等；盲索引保留用于防止同一证件重复开户
+var (
+	userErasedColumns    = []string{"first_name", "last_name", "email", "phone", "street", "birth_date", "city", "postal_code", "country", "country_code", "pic", "pic_two", "card_number_rel", "card_number_rel_two"}
+	cardTwoErasedColumns = []string{"first_name", "last_name", "email", "phone", "street", "birth_date", "city", "postal_code", "country", "country_code", "phone_country_code", "state", "id_card", "gender"}
+)
+
+// 注销时直接删除的非交易数据
+var erasedTables = []struct {
+	name  string
+	model interface{}
+}{
+	{"withdraw_address", &WithdrawAddress{}},
+	{"user_totp", &UserTotp{}},
+	{"user_totp_recovery", &UserTotpRecovery{}},
+	{"notify_target", &NotifyTarget{}},
+	{"notify_preference", &NotifyPreference{}},
+	{"notification", &Notification{}},
+	{"audit_key", &AuditKey{}},
+}
+
+type PrivacyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewPrivacyRepo(data *Data, logger log.Logger) biz.PrivacyRepo {
+	return &PrivacyRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetCardApplications .
+func (p *PrivacyRepo) GetCardApplications(ctx context.Context, userId uint64) ([]*biz.CardApplication, error) {
+	var cardTwos []*CardTwo
+	if err := p.data.DB(ctx).Table("card_two").Where("user_id=?", userId).Order("id asc").Find(&cardTwos).Error; err != nil {
+		return nil, errors.New(500, "CARD_TWO_ERROR", err.Error())
+	}
+
+	res := make([]*biz.CardApplication, 0, len(cardTwos))
+	for _, v := range cardTwos {
+		if err := p.data.openFields(ctx, "card_two", v.ID, cardTwoPersonalColumns, cardTwoPersonalFields(v)); err != nil {
+			return nil, err
+		}
+
+		res = append(res, &biz.CardApplication{
+			ID:               v.ID,
+			UserId:           v.UserId,
+			FirstName:        v.FirstName,
+			LastName:         v.LastName,
+			Email:            v.Email,
+			CountryCode:      v.CountryCode,
+			Phone:            v.Phone,
+			PhoneCountryCode: v.PhoneCountryCode,
+			City:             v.City,
+			Country:          v.Country,
+			Street:           v.Street,
+			PostalCode:       v.PostalCode,
+			BirthDate:        v.BirthDate,
+			State:            v.State,
+			IdCard:           v.IdCard,
+			Gender:           v.Gender,
+			Status:           v.Status,
+			ScreeningResult:  v.ScreeningResult,
+			CreatedAt:        v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// GetWithdrawals .
+func (p *PrivacyRepo) GetWithdrawals(ctx context.Context, userId uint64) ([]*biz.Withdraw, error) {
+	var withdraws []*Withdraw
+	if err := p.data.DB(ctx).Table("withdraw").Where("user_id=?", userId).Order("id asc").Find(&withdraws).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW_ERROR", err.Error())
+	}
+
+	res := make([]*biz.Withdraw, 0, len(withdraws))
+	for _, v := range withdraws {
+		res = append(res, &biz.Withdraw{
+			ID:        int64(v.ID),
+			UserId:    int64(v.UserId),
+			Amount:    v.Amount,
+			RelAmount: v.RelAmount,
+			Status:    v.Status,
+			Address:   v.Address,
+			Currency:  v.Currency,
+			Chain:     v.Chain,
+			CreatedAt: v.CreatedAt,
+			UpdatedAt: v.UpdatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// ErasePersonalData 需要在事务中调用
+func (p *PrivacyRepo) ErasePersonalData(ctx context.Context, userId uint64) error {
+	now := time.Now().Format("2006-01-02 15:04:05")
+
+	updates := make(map[string]interface{}, len(userErasedColumns)+3)
+	for _, v := range userErasedColumns {
+		updates[v] = "no"
+	}
+	// 钱包地址改为占位值，同一地址再次登录时为新用户
+	updates["address"] = "erased-" + strconv.FormatUint(userId, 10)
+	updates["is_delete"] = 1
+	updates["updated_at"] = now
+
+	res := p.data.DB(ctx).Table("user").Where("id=?", userId).Updates(updates)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "ERASE_USER_ERROR", "用户信息匿名化失败")
+	}
+
+	updates = make(map[string]interface{}, len(cardTwoErasedColumns)+1)
+	for _, v := range cardTwoErasedColumns {
+		updates[v] = "no"
+	}
+	updates["updated_at"] = now
+
+	if err := p.data.DB(ctx).Table("card_two").Where("user_id=?", userId).Updates(updates).Error; err != nil {
+		return errors.New(500, "ERASE_CARD_TWO_ERROR", "实体卡申请匿名化失败")
+	}
+
+	if err := p.data.DB(ctx).Table("screening").Where("user_id=?", userId).
+		Updates(map[string]interface{}{"name": "erased", "country": "", "updated_at": now}).Error; err != nil {
+		return errors.New(500, "ERASE_SCREENING_ERROR", "筛查记录匿名化失败")
+	}
+
+	for _, v := range erasedTables {
+		if err := p.data.DB(ctx).Table(v.name).Where("user_id=?", userId).Delete(v.model).Error; err != nil {
+			return errors.New(500, "ERASE_ERROR", v.name+"删除失败")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,232 @@
+package data
+
+import (
+	"cardbinance/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type RiskLimit struct {
+	ID           uint64    `gorm:"primarykey;type:int"`
+	Operation    string    `gorm:"type:varchar(45);not null"`
+	Vip          uint64    `gorm:"type:int;not null"`
+	KycTier      uint64    `gorm:"type:int;not null"`
+	SingleMax    float64   `gorm:"type:decimal(65,20);not null"`
+	Daily        float64   `gorm:"type:decimal(65,20);not null"`
+	Monthly      float64   `gorm:"type:decimal(65,20);not null"`
+	ReviewAmount float64   `gorm:"type:decimal(65,20);not null"`
+	CreatedAt    time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt    time.Time `gorm:"type:datetime;not null"`
+}
+
+type RiskRecord struct {
+	ID         uint64     `gorm:"primarykey;type:int"`
+	UserId     uint64     `gorm:"type:int;not null"`
+	Operation  string     `gorm:"type:varchar(45);not null"`
+	Currency   string     `gorm:"type:varchar(45);not null"`
+	Amount     float64    `gorm:"type:decimal(65,20);not null"`
+	AmountRel  float64    `gorm:"type:decimal(65,20);not null"`
+	Address    string     `gorm:"type:varchar(100);not null;default:''"`
+	Chain      string     `gorm:"type:varchar(45);not null;default:''"`
+	ToUserId   uint64     `gorm:"type:int;not null;default:0"`
+	Status     string     `gorm:"type:varchar(45);not null"`
+	Reasons    string     `gorm:"type:varchar(255);not null;default:''"`
+	Operator   string     `gorm:"type:varchar(100);not null;default:''"`
+	Remark     string     `gorm:"type:varchar(500);not null;default:''"`
+	ReviewedAt *time.Time `gorm:"type:datetime"`
+	CreatedAt  time.Time  `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time  `gorm:"type:datetime;not null"`
+}
+
+// 计入限额和频率的状态
+var riskCountedStatuses = []string{biz.RiskAllow, biz.RiskReview, biz.RiskApproved}
+
+type RiskRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewRiskRepo(data *Data, logger log.Logger) biz.RiskRepo {
+	return &RiskRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func riskRecordToBiz(r *RiskRecord) *biz.RiskRecord {
+	return &biz.RiskRecord{
+		ID:         r.ID,
+		UserId:     r.UserId,
+		Operation:  r.Operation,
+		Currency:   r.Currency,
+		Amount:     r.Amount,
+		AmountRel:  r.AmountRel,
+		Address:    r.Address,
+		Chain:      r.Chain,
+		ToUserId:   r.ToUserId,
+		Status:     r.Status,
+		Reasons:    r.Reasons,
+		Operator:   r.Operator,
+		Remark:     r.Remark,
+		CreatedAt:  r.CreatedAt,
+		ReviewedAt: r.ReviewedAt,
+	}
+}
+
+// GetRiskLimitsByOperation .
+func (r *RiskRepo) GetRiskLimitsByOperation(ctx context.Context, operation string) ([]*biz.RiskLimit, error) {
+	var limits []*RiskLimit
+	res := make([]*biz.RiskLimit, 0)
+	if err := r.data.db.Table("risk_limit").Where("operation=?", operation).Order("id asc").Find(&limits).Error; err != nil {
+		return nil, errors.New(500, "RISK_LIMIT ERROR", err.Error())
+	}
+
+	for _, v := range limits {
+		res = append(res, &biz.RiskLimit{
+			ID:           v.ID,
+			Operation:    v.Operation,
+			Vip:          v.Vip,
+			KycTier:      v.KycTier,
+			SingleMax:    v.SingleMax,
+			Daily:        v.Daily,
+			Monthly:      v.Monthly,
+			ReviewAmount: v.ReviewAmount,
+		})
+	}
+
+	return res, nil
+}
+
+// SumRiskAmount .
+func (r *RiskRepo) SumRiskAmount(ctx context.Context, userId uint64, operation string, since time.Time) (float64, error) {
+	var total float64
+	if err := r.data.DB(ctx).Table("risk_record").Select("COALESCE(SUM(amount), 0)").
+		Where("user_id=? and operation=? and created_at>=?", userId, operation, since).
+		Where("status in ?", riskCountedStatuses).
+		Scan(&total).Error; err != nil {
+		return 0, errors.New(500, "RISK_RECORD ERROR", err.Error())
+	}
+
+	return total, nil
+}
+
+// CountRiskRecords .
+func (r *RiskRepo) CountRiskRecords(ctx context.Context, userId uint64, operation string, since time.Time) (int64, error) {
+	var count int64
+	if err := r.data.DB(ctx).Table("risk_record").
+		Where("user_id=? and operation=? and created_at>=?", userId, operation, since).
+		Where("status in ?", riskCountedStatuses).
+		Count(&count).Error; err != nil {
+		return 0, errors.New(500, "RISK_RECORD ERROR", err.Error())
+	}
+
+	return count, nil
+}
+
+// HasWithdrawAddress 按提现记录判断，包含风控上线前的提现
+func (r *RiskRepo) HasWithdrawAddress(ctx context.Context, userId uint64, address string) (bool, error) {
+	var count int64
+	if err := r.data.DB(ctx).Table("withdraw").Where("user_id=? and address=?", userId, address).Count(&count).Error; err != nil {
+		return false, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	return 0 < count, nil
+}
+
+// CreateRiskRecord .
+func (r *RiskRepo) CreateRiskRecord(ctx context.Context, br *biz.RiskRecord) (*biz.RiskRecord, error) {
+	rr := &RiskRecord{
+		UserId:    br.UserId,
+		Operation: br.Operation,
+		Currency:  br.Currency,
+		Amount:    br.Amount,
+		AmountRel: br.AmountRel,
+		Address:   br.Address,
+		Chain:     br.Chain,
+		ToUserId:  br.ToUserId,
+		Status:    br.Status,
+		Reasons:   br.Reasons,
+	}
+
+	res := r.data.DB(ctx).Table("risk_record").Create(rr)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return nil, errors.New(500, "CREATE_RISK_RECORD_ERROR", "风控记录创建失败")
+	}
+
+	return riskRecordToBiz(rr), nil
+}
+
+// HoldRiskRecord .
+func (r *RiskRepo) HoldRiskRecord(ctx context.Context, br *biz.RiskRecord) (*biz.RiskRecord, error) {
+	if err := subBalance(r.data.DB(ctx), br.UserId, br.Currency, br.Amount); nil != err {
+		return nil, err
+	}
+
+	br.Status = biz.RiskReview
+	return r.CreateRiskRecord(ctx, br)
+}
+
+// GetRiskRecordById 不存在时返回 nil
+func (r *RiskRepo) GetRiskRecordById(ctx context.Context, id uint64) (*biz.RiskRecord, error) {
+	var rr RiskRecord
+	if err := r.data.DB(ctx).Table("risk_record").Where("id=?", id).First(&rr).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "RISK_RECORD ERROR", err.Error())
+	}
+
+	return riskRecordToBiz(&rr), nil
+}
+
+// GetRiskRecordsPage .
+func (r *RiskRepo) GetRiskRecordsPage(ctx context.Context, b *biz.Pagination, status string, userId uint64) ([]*biz.RiskRecord, error, int64) {
+	var (
+		count   int64
+		records []*RiskRecord
+	)
+
+	res := make([]*biz.RiskRecord, 0)
+
+	instance := r.data.db.Table("risk_record").Where("status=?", status)
+	if 0 < userId {
+		instance = instance.Where("user_id=?", userId)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Order("id desc").Scopes(Paginate(b.PageNum, b.PageSize)).Find(&records).Error; err != nil {
+		return nil, errors.New(500, "RISK_RECORD ERROR", err.Error()), 0
+	}
+
+	for _, v := range records {
+		res = append(res, riskRecordToBiz(v))
+	}
+
+	return res, nil, count
+}
+
+// FinishRiskReview 只处理审核中的记录，并发审核时只有一个成功
+func (r *RiskRepo) FinishRiskReview(ctx context.Context, br *biz.RiskRecord, status, operator, remark string) error {
+	now := time.Now().Format("2006-01-02 15:04:05")
+	res := r.data.DB(ctx).Table("risk_record").Where("id=? and status=?", br.ID, biz.RiskReview).
+		Updates(map[string]interface{}{
+			"status":      status,
+			"operator":    operator,
+			"remark":      remark,
+			"reviewed_at": now,
+			"updated_at":  now,
+		})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_RISK_RECORD_ERROR", "风控记录修改失败")
+	}
+
+	if 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_RISK_RECORD_ERROR", "风控记录已处理")
+	}
+
+	return addBalance(r.data.DB(ctx), br.UserId, br.Currency, br.Amount)
+}
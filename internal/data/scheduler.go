@@ -0,0 +1,136 @@
+package data
+
+import (
+	"cardbinance/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+const leaderKey = "scheduler:leader"
+
+// 未被持有时获取，自己持有时续期
+var acquireLeaderScript = redis.NewScript(`
+local owner = redis.call("GET", KEYS[1])
+if owner == false then
+	redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+	return 1
+end
+if owner == ARGV[1] then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+	return 1
+end
+return 0
+`)
+
+type ScheduledJob struct {
+	ID         uint64    `gorm:"primarykey;type:int"`
+	Name       string    `gorm:"type:varchar(100);not null;uniqueIndex"`
+	Owner      string    `gorm:"type:varchar(255);not null"`
+	LastRunAt  time.Time `gorm:"type:datetime;not null"`
+	DurationMs int64     `gorm:"type:bigint;not null"`
+	Error      string    `gorm:"type:varchar(500);not null;default:''"`
+	Runs       uint64    `gorm:"type:bigint;not null"`
+	Failures   uint64    `gorm:"type:bigint;not null"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+type SchedulerRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewSchedulerRepo(data *Data, logger log.Logger) biz.SchedulerRepo {
+	return &SchedulerRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// AcquireLeader .
+func (s *SchedulerRepo) AcquireLeader(ctx context.Context, owner string, ttl time.Duration) (bool, error) {
+	res, err := acquireLeaderScript.Run(ctx, s.data.rdb, []string{leaderKey}, owner, ttl.Milliseconds()).Int64()
+	if err != nil {
+		return false, errors.New(500, "LEADER ERROR", err.Error())
+	}
+
+	return 1 == res, nil
+}
+
+// ReleaseLeader .
+func (s *SchedulerRepo) ReleaseLeader(ctx context.Context, owner string) error {
+	if err := unlockScript.Run(ctx, s.data.rdb, []string{leaderKey}, owner).Err(); err != nil {
+		return errors.New(500, "LEADER ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// SaveJobRun .
+func (s *SchedulerRepo) SaveJobRun(ctx context.Context, run *biz.JobRun) error {
+	errMsg := run.Error
+	if 500 < len(errMsg) {
+		errMsg = errMsg[:500]
+	}
+
+	failures := uint64(0)
+	if "" != errMsg {
+		failures = 1
+	}
+
+	job := &ScheduledJob{
+		Name:       run.Name,
+		Owner:      run.Owner,
+		LastRunAt:  run.LastRunAt,
+		DurationMs: run.Duration.Milliseconds(),
+		Error:      errMsg,
+		Runs:       1,
+		Failures:   failures,
+	}
+
+	res := s.data.DB(ctx).Table("scheduled_job").Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "name"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"owner":       job.Owner,
+			"last_run_at": job.LastRunAt,
+			"duration_ms": job.DurationMs,
+			"error":       job.Error,
+			"runs":        gorm.Expr("runs + 1"),
+			"failures":    gorm.Expr("failures + ?", failures),
+			"updated_at":  time.Now().Format("2006-01-02 15:04:05"),
+		}),
+	}).Create(job)
+	if res.Error != nil {
+		return errors.New(500, "SAVE_JOB_RUN_ERROR", "任务记录保存失败")
+	}
+
+	return nil
+}
+
+// GetJobRuns .
+func (s *SchedulerRepo) GetJobRuns(ctx context.Context) ([]*biz.JobRun, error) {
+	var jobs []*ScheduledJob
+	if err := s.data.DB(ctx).Table("scheduled_job").Order("name asc").Find(&jobs).Error; err != nil {
+		return nil, errors.New(500, "SCHEDULED JOB ERROR", err.Error())
+	}
+
+	res := make([]*biz.JobRun, 0, len(jobs))
+	for _, v := range jobs {
+		res = append(res, &biz.JobRun{
+			Name:      v.Name,
+			Owner:     v.Owner,
+			LastRunAt: v.LastRunAt,
+			Duration:  time.Duration(v.DurationMs) * time.Millisecond,
+			Error:     v.Error,
+			Runs:      v.Runs,
+			Failures:  v.Failures,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,272 @@
+package data
+
+import (
+	"cardbinance/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+type Watchlist struct {
+	ID        uint64    `gorm:"primarykey;type:int"`
+	Name      string    `gorm:"type:varchar(100);not null"`
+	Kind      string    `gorm:"type:varchar(45);not null"`
+	Version   string    `gorm:"type:varchar(100);not null"`
+	Entries   int64     `gorm:"type:int;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type WatchlistEntry struct {
+	ID          uint64 `gorm:"primarykey;type:int"`
+	WatchlistId uint64 `gorm:"type:int;not null"`
+	EntryId     string `gorm:"type:varchar(100);not null;default:''"`
+	Name        string `gorm:"type:varchar(255);not null"`
+	Aliases     string `gorm:"type:text"` // 分号分隔
+	Country     string `gorm:"type:varchar(100);not null;default:''"`
+}
+
+type Screening struct {
+	ID             uint64    `gorm:"primarykey;type:int"`
+	UserId         uint64    `gorm:"type:int;not null"`
+	CardId         uint64    `gorm:"type:int;not null;default:0"`
+	Name           string    `gorm:"type:varchar(100);not null"`
+	Country        string    `gorm:"type:varchar(100);not null;default:''"`
+	Result         string    `gorm:"type:varchar(45);not null"`
+	Score          float64   `gorm:"type:decimal(10,4);not null"`
+	ListVersion    string    `gorm:"type:varchar(255);not null;default:''"`
+	MatchedList    string    `gorm:"type:varchar(100);not null;default:''"`
+	MatchedEntryId string    `gorm:"type:varchar(100);not null;default:''"`
+	MatchedName    string    `gorm:"type:varchar(255);not null;default:''"`
+	Fee            float64   `gorm:"type:decimal(65,20);not null;default:0"`
+	Refunded       uint64    `gorm:"type:int;not null;default:0"`
+	CreatedAt      time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt      time.Time `gorm:"type:datetime;not null"`
+}
+
+// 导入名单时每批写入的条目数
+const watchlistImportBatch = 500
+
+type ScreeningRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewScreeningRepo(data *Data, logger log.Logger) biz.ScreeningRepo {
+	return &ScreeningRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func watchlistToBiz(w *Watchlist) *biz.Watchlist {
+	return &biz.Watchlist{
+		ID:        w.ID,
+		Name:      w.Name,
+		Kind:      w.Kind,
+		Version:   w.Version,
+		Entries:   w.Entries,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+func screeningToBiz(s *Screening) *biz.Screening {
+	return &biz.Screening{
+		ID:             s.ID,
+		UserId:         s.UserId,
+		CardId:         s.CardId,
+		Name:           s.Name,
+		Country:        s.Country,
+		Result:         s.Result,
+		Score:          s.Score,
+		ListVersion:    s.ListVersion,
+		MatchedList:    s.MatchedList,
+		MatchedEntryId: s.MatchedEntryId,
+		MatchedName:    s.MatchedName,
+		Fee:            s.Fee,
+		Refunded:       1 == s.Refunded,
+		CreatedAt:      s.CreatedAt,
+	}
+}
+
+// GetWatchlists .
+func (s *ScreeningRepo) GetWatchlists(ctx context.Context) ([]*biz.Watchlist, error) {
+	var lists []*Watchlist
+	res := make([]*biz.Watchlist, 0)
+	if err := s.data.DB(ctx).Table("watchlist").Order("id asc").Find(&lists).Error; err != nil {
+		return nil, errors.New(500, "WATCHLIST_ERROR", err.Error())
+	}
+
+	for _, v := range lists {
+		res = append(res, watchlistToBiz(v))
+	}
+
+	return res, nil
+}
+
+// GetWatchlistEntries .
+func (s *ScreeningRepo) GetWatchlistEntries(ctx context.Context, watchlistId uint64) ([]*biz.WatchlistEntry, error) {
+	var entries []*WatchlistEntry
+	res := make([]*biz.WatchlistEntry, 0)
+	if err := s.data.DB(ctx).Table("watchlist_entry").Where("watchlist_id=?", watchlistId).Order("id asc").Find(&entries).Error; err != nil {
+		return nil, errors.New(500, "WATCHLIST_ENTRY_ERROR", err.Error())
+	}
+
+	for _, v := range entries {
+		tmp := &biz.WatchlistEntry{
+			ID:      v.ID,
+			EntryId: v.EntryId,
+			Name:    v.Name,
+			Country: v.Country,
+		}
+		if "" != v.Aliases {
+			tmp.Aliases = strings.Split(v.Aliases, ";")
+		}
+
+		res = append(res, tmp)
+	}
+
+	return res, nil
+}
+
+// ImportWatchlist 需要在事务中调用
+func (s *ScreeningRepo) ImportWatchlist(ctx context.Context, bw *biz.Watchlist, entries []*biz.WatchlistEntry) (*biz.Watchlist, error) {
+	var w Watchlist
+	err := s.data.DB(ctx).Table("watchlist").Where("name=?", bw.Name).First(&w).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New(500, "WATCHLIST_ERROR", err.Error())
+	}
+
+	now := time.Now()
+	w.Name = bw.Name
+	w.Kind = bw.Kind
+	w.Version = bw.Version
+	w.Entries = bw.Entries
+	w.UpdatedAt = now
+	if 0 == w.ID {
+		w.CreatedAt = now
+	}
+
+	if err = s.data.DB(ctx).Table("watchlist").Save(&w).Error; err != nil {
+		return nil, errors.New(500, "SAVE_WATCHLIST_ERROR", "名单保存失败")
+	}
+
+	if err = s.data.DB(ctx).Table("watchlist_entry").Where("watchlist_id=?", w.ID).Delete(&WatchlistEntry{}).Error; err != nil {
+		return nil, errors.New(500, "DELETE_WATCHLIST_ENTRY_ERROR", "名单条目删除失败")
+	}
+
+	rows := make([]*WatchlistEntry, 0, len(entries))
+	for _, v := range entries {
+		rows = append(rows, &WatchlistEntry{
+			WatchlistId: w.ID,
+			EntryId:     v.EntryId,
+			Name:        v.Name,
+			Aliases:     strings.Join(v.Aliases, ";"),
+			Country:     v.Country,
+		})
+	}
+
+	if 0 < len(rows) {
+		if err = s.data.DB(ctx).Table("watchlist_entry").CreateInBatches(rows, watchlistImportBatch).Error; err != nil {
+			return nil, errors.New(500, "CREATE_WATCHLIST_ENTRY_ERROR", "名单条目创建失败")
+		}
+	}
+
+	return watchlistToBiz(&w), nil
+}
+
+// CreateScreening .
+func (s *ScreeningRepo) CreateScreening(ctx context.Context, bs *biz.Screening) (*biz.Screening, error) {
+	now := time.Now()
+	sc := &Screening{
+		UserId:         bs.UserId,
+		CardId:         bs.CardId,
+		Name:           bs.Name,
+		Country:        bs.Country,
+		Result:         bs.Result,
+		Score:          bs.Score,
+		ListVersion:    bs.ListVersion,
+		MatchedList:    bs.MatchedList,
+		MatchedEntryId: bs.MatchedEntryId,
+		MatchedName:    bs.MatchedName,
+		Fee:            bs.Fee,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	res := s.data.DB(ctx).Table("screening").Create(sc)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return nil, errors.New(500, "CREATE_SCREENING_ERROR", "筛查记录创建失败")
+	}
+
+	return screeningToBiz(sc), nil
+}
+
+// GetScreeningByCardId 不存在时返回 nil
+func (s *ScreeningRepo) GetScreeningByCardId(ctx context.Context, cardId uint64) (*biz.Screening, error) {
+	var sc Screening
+	if err := s.data.DB(ctx).Table("screening").Where("card_id=?", cardId).Order("id desc").First(&sc).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "SCREENING_ERROR", err.Error())
+	}
+
+	return screeningToBiz(&sc), nil
+}
+
+// UpdateCardTwoScreening 需要在事务中调用
+func (s *ScreeningRepo) UpdateCardTwoScreening(ctx context.Context, userId uint64, result, listVersion string) error {
+	var cardTwo CardTwo
+	if err := s.data.DB(ctx).Table("card_two").Where("user_id=?", userId).Order("id desc").First(&cardTwo).Error; err != nil {
+		return errors.New(500, "CARD_TWO_ERROR", err.Error())
+	}
+
+	res := s.data.DB(ctx).Table("card_two").Where("id=?", cardTwo.ID).
+		Updates(map[string]interface{}{
+			"screening_result":       result,
+			"screening_list_version": listVersion,
+			"updated_at":             time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_CARD_TWO_ERROR", "实体卡申请修改失败")
+	}
+
+	return nil
+}
+
+// RefundScreeningFee 每条记录只退一次，需要在事务中调用
+func (s *ScreeningRepo) RefundScreeningFee(ctx context.Context, bs *biz.Screening) (bool, error) {
+	res := s.data.DB(ctx).Table("screening").Where("id=? and refunded=0 and fee>0", bs.ID).
+		Updates(map[string]interface{}{
+			"refunded":   1,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil {
+		return false, errors.New(500, "UPDATE_SCREENING_ERROR", "筛查记录修改失败")
+	}
+
+	if 0 >= res.RowsAffected {
+		return false, nil
+	}
+
+	if err := addBalance(s.data.DB(ctx), bs.UserId, biz.CurrencyUSDT, bs.Fee); err != nil {
+		return false, err
+	}
+
+	reward := &Reward{
+		UserId: bs.UserId,
+		Amount: bs.Fee,
+		Reason: 17, // 实体卡申请审核拒绝，退回开卡费
+	}
+	resInsert := s.data.DB(ctx).Table("reward").Create(reward)
+	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
+		return false, errors.New(500, "CREATE_REWARD_ERROR", "退款记录创建失败")
+	}
+
+	return true, nil
+}
@@ -0,0 +1,225 @@
+package data
+
+import (
+	"cardbinance/internal/biz"
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type UserTotp struct {
+	ID        uint64    `gorm:"primarykey;type:int"`
+	UserId    uint64    `gorm:"type:int;not null;uniqueIndex"`
+	Secret    string    `gorm:"type:varchar(512);not null"`
+	Enabled   uint64    `gorm:"type:int;not null;default:0"`
+	LastStep  int64     `gorm:"type:bigint;not null;default:0"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type UserTotpRecovery struct {
+	ID        uint64     `gorm:"primarykey;type:int"`
+	UserId    uint64     `gorm:"type:int;not null"`
+	CodeHash  string     `gorm:"type:varchar(64);not null"`
+	UsedAt    *time.Time `gorm:"type:datetime"`
+	CreatedAt time.Time  `gorm:"type:datetime;not null"`
+}
+
+type TotpRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewTotpRepo(data *Data, logger log.Logger) biz.TotpRepo {
+	return &TotpRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func totpFailureKey(userId uint64) string {
+	return fmt.Sprintf("totp:failures:%d", userId)
+}
+
+// GetUserTotp .
+func (t *TotpRepo) GetUserTotp(ctx context.Context, userId uint64) (*biz.UserTotp, error) {
+	var ut UserTotp
+	if err := t.data.DB(ctx).Table("user_totp").Where("user_id=?", userId).First(&ut).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "USER_TOTP_ERROR", err.Error())
+	}
+
+	if err := t.data.openFields(ctx, "user_totp", ut.UserId, userTotpColumns, userTotpFields(&ut)); err != nil {
+		return nil, err
+	}
+
+	return &biz.UserTotp{
+		UserId:   ut.UserId,
+		Secret:   ut.Secret,
+		Enabled:  1 == ut.Enabled,
+		LastStep: ut.LastStep,
+	}, nil
+}
+
+// SaveUserTotpSecret 密钥与个人信息使用同一套信封加密；按 user_id 写入，密文绑定 user_id 而不是行 id
+func (t *TotpRepo) SaveUserTotpSecret(ctx context.Context, userId uint64, secret string) error {
+	if err := t.data.sealFields(ctx, "user_totp", userId, userTotpColumns, []*string{&secret}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	res := t.data.DB(ctx).Table("user_totp").Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.Set{
+			{Column: clause.Column{Name: "secret"}, Value: gorm.Expr("IF(enabled=1, secret, VALUES(secret))")},
+			{Column: clause.Column{Name: "updated_at"}, Value: now},
+		},
+	}).Create(&UserTotp{
+		UserId:    userId,
+		Secret:    secret,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if res.Error != nil {
+		return errors.New(500, "CREATE_USER_TOTP_ERROR", "两步验证密钥保存失败")
+	}
+
+	return nil
+}
+
+// EnableUserTotp .
+func (t *TotpRepo) EnableUserTotp(ctx context.Context, userId uint64, step int64, recoveryHashes []string) error {
+	res := t.data.DB(ctx).Table("user_totp").Where("user_id=? and enabled=0", userId).
+		Updates(map[string]interface{}{
+			"enabled":    1,
+			"last_step":  step,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_USER_TOTP_ERROR", "开启两步验证失败")
+	}
+
+	if 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_USER_TOTP_ERROR", "两步验证已开启")
+	}
+
+	if err := t.data.DB(ctx).Table("user_totp_recovery").Where("user_id=?", userId).Delete(&UserTotpRecovery{}).Error; err != nil {
+		return errors.New(500, "DELETE_USER_TOTP_RECOVERY_ERROR", "恢复码删除失败")
+	}
+
+	codes := make([]*UserTotpRecovery, 0, len(recoveryHashes))
+	for _, v := range recoveryHashes {
+		codes = append(codes, &UserTotpRecovery{UserId: userId, CodeHash: v, CreatedAt: time.Now()})
+	}
+
+	if err := t.data.DB(ctx).Table("user_totp_recovery").Create(&codes).Error; err != nil {
+		return errors.New(500, "CREATE_USER_TOTP_RECOVERY_ERROR", "恢复码保存失败")
+	}
+
+	return nil
+}
+
+// DisableUserTotp .
+func (t *TotpRepo) DisableUserTotp(ctx context.Context, userId uint64) error {
+	if err := t.data.DB(ctx).Table("user_totp").Where("user_id=?", userId).Delete(&UserTotp{}).Error; err != nil {
+		return errors.New(500, "DELETE_USER_TOTP_ERROR", "关闭两步验证失败")
+	}
+
+	if err := t.data.DB(ctx).Table("user_totp_recovery").Where("user_id=?", userId).Delete(&UserTotpRecovery{}).Error; err != nil {
+		return errors.New(500, "DELETE_USER_TOTP_RECOVERY_ERROR", "恢复码删除失败")
+	}
+
+	return nil
+}
+
+// UpdateTotpStep .
+func (t *TotpRepo) UpdateTotpStep(ctx context.Context, userId uint64, step int64) (bool, error) {
+	res := t.data.DB(ctx).Table("user_totp").Where("user_id=? and last_step<?", userId, step).
+		Updates(map[string]interface{}{
+			"last_step":  step,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil {
+		return false, errors.New(500, "UPDATE_USER_TOTP_ERROR", "两步验证更新失败")
+	}
+
+	return 0 < res.RowsAffected, nil
+}
+
+// UseTotpRecoveryCode .
+func (t *TotpRepo) UseTotpRecoveryCode(ctx context.Context, userId uint64, hash string) (bool, error) {
+	res := t.data.DB(ctx).Table("user_totp_recovery").Where("user_id=? and code_hash=? and used_at is null", userId, hash).
+		Update("used_at", time.Now().Format("2006-01-02 15:04:05"))
+	if res.Error != nil {
+		return false, errors.New(500, "UPDATE_USER_TOTP_RECOVERY_ERROR", "恢复码更新失败")
+	}
+
+	return 0 < res.RowsAffected, nil
+}
+
+// CountTotpRecoveryCodes .
+func (t *TotpRepo) CountTotpRecoveryCodes(ctx context.Context, userId uint64) (int64, error) {
+	var count int64
+	if err := t.data.DB(ctx).Table("user_totp_recovery").Where("user_id=? and used_at is null", userId).Count(&count).Error; err != nil {
+		return 0, errors.New(500, "USER_TOTP_RECOVERY_ERROR", err.Error())
+	}
+
+	return count, nil
+}
+
+// ReencryptTotpSecrets 同 ReencryptUsers
+func (t *TotpRepo) ReencryptTotpSecrets(ctx context.Context, afterId uint64, limit int) (uint64, int, error) {
+	if nil == t.data.fc {
+		return 0, 0, nil
+	}
+
+	var totps []*UserTotp
+	if err := t.data.DB(ctx).Table("user_totp").Where("id>?", afterId).Order("id asc").Limit(limit).Find(&totps).Error; err != nil {
+		return 0, 0, errors.New(500, "USER_TOTP_ERROR", err.Error())
+	}
+
+	var (
+		lastId  uint64
+		updated int
+	)
+	for _, v := range totps {
+		lastId = v.ID
+		ok, err := t.data.reencryptRow(ctx, "user_totp", v.ID, v.UserId, userTotpColumns, userTotpFields(v), nil)
+		if err != nil {
+			return lastId, updated, err
+		}
+		if ok {
+			updated++
+		}
+	}
+
+	return lastId, updated, nil
+}
+
+// IncrTotpFailures 第一次错误时设置过期时间，窗口从第一次错误开始计算
+func (t *TotpRepo) IncrTotpFailures(ctx context.Context, userId uint64, window time.Duration) (int64, error) {
+	return t.data.incrWindow(ctx, totpFailureKey(userId), window)
+}
+
+// GetTotpFailures .
+func (t *TotpRepo) GetTotpFailures(ctx context.Context, userId uint64) (int64, error) {
+	count, err := t.data.rdb.Get(ctx, totpFailureKey(userId)).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+
+	return count, err
+}
+
+// ResetTotpFailures .
+func (t *TotpRepo) ResetTotpFailures(ctx context.Context, userId uint64) error {
+	return t.data.rdb.Del(ctx, totpFailureKey(userId)).Err()
+}
@@ -3,7 +3,6 @@ package data
 import (
 	"cardbinance/internal/biz"
 	"context"
-	"fmt"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
@@ -24,16 +23,16 @@ type User struct {
 	Vip              uint64    `gorm:"type:int"`
 	MyTotalAmount    uint64    `gorm:"type:bigint"`
 	AmountTwo        uint64    `gorm:"type:bigint"`
-	FirstName        string    `gorm:"type:varchar(45);not null;default:'no'"`
-	LastName         string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Email            string    `gorm:"type:varchar(100);not null;default:'no'"`
+	FirstName        string    `gorm:"type:varchar(512);not null;default:'no'"`
+	LastName         string    `gorm:"type:varchar(512);not null;default:'no'"`
+	Email            string    `gorm:"type:varchar(512);not null;default:'no'"`
 	CountryCode      string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Phone            string    `gorm:"type:varchar(45);not null;default:'no'"`
+	Phone            string    `gorm:"type:varchar(512);not null;default:'no'"`
 	City             string    `gorm:"type:varchar(100);not null;default:'no'"`
 	Country          string    `gorm:"type:varchar(100);not null;default:'no'"`
-	Street           string    `gorm:"type:varchar(100);not null;default:'no'"`
+	Street           string    `gorm:"type:varchar(512);not null;default:'no'"`
 	PostalCode       string    `gorm:"type:varchar(45);not null;default:'no'"`
-	BirthDate        string    `gorm:"type:varchar(45);not null;default:'no'"`
+	BirthDate        string    `gorm:"type:varchar(512);not null;default:'no'"`
 	MaxCardQuota     uint64    `gorm:"type:bigint"`
 	ProductId        string    `gorm:"type:varchar(45);not null;default:'0'"`
 	CardUserId       string    `gorm:"type:varchar(45);not null;default:'0'"`
@@ -49,6 +48,8 @@ type User struct {
 	CardNumberRelTwo string    `gorm:"type:varchar(100);not null;default:'no'"`
 	Pic              string    `gorm:"type:varchar(45);not null;default:'no'"`
 	PicTwo           string    `gorm:"type:varchar(45);not null;default:'no'"`
+	LockCard         uint64    `gorm:"type:int"`
+	LockCardTwo      uint64    `gorm:"type:int"`
 }
 
 type CardOrder struct {
@@ -62,26 +63,29 @@ type CardOrder struct {
 }
 
 type CardTwo struct {
-	ID               uint64    `gorm:"primarykey;type:int"`
-	UserId           uint64    `gorm:"type:int;not null"`
-	FirstName        string    `gorm:"type:varchar(45);not null;default:'no'"`
-	LastName         string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Email            string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CountryCode      string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Phone            string    `gorm:"type:varchar(45);not null;default:'no'"`
-	City             string    `gorm:"type:varchar(100);not null;default:'no'"`
-	Country          string    `gorm:"type:varchar(100);not null;default:'no'"`
-	Street           string    `gorm:"type:varchar(100);not null;default:'no'"`
-	PostalCode       string    `gorm:"type:varchar(45);not null;default:'no'"`
-	BirthDate        string    `gorm:"type:varchar(45);not null;default:'no'"`
-	PhoneCountryCode string    `gorm:"type:varchar(45);not null;default:'no'"`
-	State            string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Status           uint64    `gorm:"type:int"`
-	CardId           string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CreatedAt        time.Time `gorm:"type:datetime;not null"`
-	UpdatedAt        time.Time `gorm:"type:datetime;not null"`
-	IdCard           string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Gender           string    `gorm:"type:varchar(45);not null;default:'no'"`
+	ID                   uint64    `gorm:"primarykey;type:int"`
+	UserId               uint64    `gorm:"type:int;not null"`
+	FirstName            string    `gorm:"type:varchar(512);not null;default:'no'"`
+	LastName             string    `gorm:"type:varchar(512);not null;default:'no'"`
+	Email                string    `gorm:"type:varchar(512);not null;default:'no'"`
+	CountryCode          string    `gorm:"type:varchar(45);not null;default:'no'"`
+	Phone                string    `gorm:"type:varchar(512);not null;default:'no'"`
+	City                 string    `gorm:"type:varchar(100);not null;default:'no'"`
+	Country              string    `gorm:"type:varchar(100);not null;default:'no'"`
+	Street               string    `gorm:"type:varchar(512);not null;default:'no'"`
+	PostalCode           string    `gorm:"type:varchar(45);not null;default:'no'"`
+	BirthDate            string    `gorm:"type:varchar(512);not null;default:'no'"`
+	PhoneCountryCode     string    `gorm:"type:varchar(45);not null;default:'no'"`
+	State                string    `gorm:"type:varchar(45);not null;default:'no'"`
+	Status               uint64    `gorm:"type:int"`
+	CardId               string    `gorm:"type:varchar(100);not null;default:'no'"`
+	CreatedAt            time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt            time.Time `gorm:"type:datetime;not null"`
+	IdCard               string    `gorm:"type:varchar(512);not null;default:'no'"`
+	Gender               string    `gorm:"type:varchar(45);not null;default:'no'"`
+	ScreeningResult      string    `gorm:"type:varchar(45);not null;default:''"`
+	ScreeningListVersion string    `gorm:"type:varchar(255);not null;default:''"`
+	IdCardBidx           string    `gorm:"type:varchar(32);not null;default:''"` // 证件号码盲索引
 }
 
 type CardRecord struct {
@@ -121,6 +125,7 @@ type Reward struct {
 	UpdatedAt time.Time `gorm:"type:datetime;not null"`
 	Address   string    `gorm:"type:varchar(100);not null"`
 	One       uint64    `gorm:"type:int;not null"`
+	Currency  string    `gorm:"type:varchar(45);not null;default:'USDT'"`
 }
 
 type Withdraw struct {
@@ -130,6 +135,8 @@ type Withdraw struct {
 	RelAmount float64   `gorm:"type:decimal(65,20);not null"`
 	Status    string    `gorm:"type:varchar(45);not null"`
 	Address   string    `gorm:"type:varchar(45);not null"`
+	Currency  string    `gorm:"type:varchar(45);not null;default:'USDT'"`
+	Chain     string    `gorm:"type:varchar(45);not null;default:''"`
 	CreatedAt time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt time.Time `gorm:"type:datetime;not null"`
 }
@@ -187,21 +194,6 @@ func (u *UserRepo) GetAndDeleteWalletTimestamp(ctx context.Context, wallet strin
 	return val, nil
 }
 
-func (u *UserRepo) SetLockAmountToCardByAddress(ctx context.Context, wallet string) error {
-	return u.data.rdb.Set(ctx, "wallet:"+wallet+"amountocard", "lock", 60*time.Second).Err()
-}
-
-func (u *UserRepo) GetLockAmountToCardByAddress(ctx context.Context, wallet string) (string, error) {
-	key := "wallet:" + wallet + "amountocard"
-
-	val, err := u.data.rdb.Get(ctx, key).Result()
-	if err == redis.Nil {
-		return "", nil
-	}
-
-	return val, nil
-}
-
 func (u *UserRepo) GetUserByAddress(address string) (*biz.User, error) {
 	var user User
 	if err := u.data.db.Where("address=?", address).Table("user").First(&user).Error; err != nil {
@@ -242,6 +234,10 @@ func (u *UserRepo) GetUserById(userId uint64) (*biz.User, error) {
 		return nil, errors.New(500, "USER ERROR", err.Error())
 	}
 
+	if err := u.data.openFields(context.Background(), "user", user.ID, userPersonalColumns, userPersonalFields(&user)); err != nil {
+		return nil, err
+	}
+
 	return &biz.User{
 		CardAmount:       user.CardAmount,
 		MyTotalAmount:    user.MyTotalAmount,
@@ -260,11 +256,17 @@ func (u *UserRepo) GetUserById(userId uint64) (*biz.User, error) {
 		CardUserId:       user.CardUserId,
 		ProductId:        user.ProductId,
 		MaxCardQuota:     user.MaxCardQuota,
+		FirstName:        user.FirstName,
+		LastName:         user.LastName,
 		Email:            user.Email,
 		UserCount:        user.UserCount,
+		City:             user.City,
 		Country:          user.Country,
 		CountryCode:      user.CountryCode,
 		Phone:            user.Phone,
+		Street:           user.Street,
+		PostalCode:       user.PostalCode,
+		BirthDate:        user.BirthDate,
 		VipTwo:           user.VipTwo,
 		CardTwo:          user.CardTwo,
 		CanVip:           user.CanVip,
@@ -465,42 +467,13 @@ func (u *UserRepo) GetConfigByKeys(keys ...string) ([]*biz.Config, error) {
 	return res, nil
 }
 
-// UpdateCardCardNumberRel .
-func (u *UserRepo) UpdateCardCardNumberRel(ctx context.Context, userId uint64, cardNumberRel string) error {
-	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
-		Updates(map[string]interface{}{
-			"card_number_rel": cardNumberRel,
-			"updated_at":      time.Now().Format("2006-01-02 15:04:05"),
-		})
-	if res.Error != nil || 0 >= res.RowsAffected {
-		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
-	}
-
-	return nil
-}
-
-// UpdateCardCardNumberRelTwo .
-func (u *UserRepo) UpdateCardCardNumberRelTwo(ctx context.Context, userId uint64, cardNumberRel string) error {
-	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
-		Updates(map[string]interface{}{
-			"card_number_rel_two": cardNumberRel,
-			"updated_at":          time.Now().Format("2006-01-02 15:04:05"),
-		})
-	if res.Error != nil || 0 >= res.RowsAffected {
-		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
-	}
-
-	return nil
-}
-
 // CreateCard .
 func (u *UserRepo) CreateCard(ctx context.Context, userId uint64, user *biz.User) error {
-	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("amount>=?", user.Amount).Where("card_order_id=?", "no").
+	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("amount>=?", user.Amount).
 		Updates(map[string]interface{}{
-			"amount":        gorm.Expr("amount - ?", user.Amount),
-			"user_count":    gorm.Expr("user_count + ?", 1),
-			"card_order_id": "do",
-			"updated_at":    time.Now().Format("2006-01-02 15:04:05"),
+			"amount":     gorm.Expr("amount - ?", user.Amount),
+			"user_count": gorm.Expr("user_count + ?", 1),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
 		})
 	if res.Error != nil || 0 >= res.RowsAffected {
 		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
@@ -535,62 +508,6 @@ func (u *UserRepo) UploadCardPic(ctx context.Context, userId uint64, pic string)
 	return nil
 }
 
-// UploadCardOneLock .
-func (u *UserRepo) UploadCardOneLock(ctx context.Context, userId uint64) error {
-	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
-		Updates(map[string]interface{}{
-			"lock_card":  1,
-			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
-		})
-	if res.Error != nil || 0 >= res.RowsAffected {
-		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
-	}
-
-	return nil
-}
-
-// UploadCardTwoLock .
-func (u *UserRepo) UploadCardTwoLock(ctx context.Context, userId uint64) error {
-	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
-		Updates(map[string]interface{}{
-			"lock_card_two": 1,
-			"updated_at":    time.Now().Format("2006-01-02 15:04:05"),
-		})
-	if res.Error != nil || 0 >= res.RowsAffected {
-		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
-	}
-
-	return nil
-}
-
-// UploadCardChange .
-func (u *UserRepo) UploadCardChange(ctx context.Context, userId uint64) error {
-	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
-		Updates(map[string]interface{}{
-			"change_card": 1,
-			"updated_at":  time.Now().Format("2006-01-02 15:04:05"),
-		})
-	if res.Error != nil || 0 >= res.RowsAffected {
-		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
-	}
-
-	return nil
-}
-
-// UploadCardChangeTwo .
-func (u *UserRepo) UploadCardChangeTwo(ctx context.Context, userId uint64) error {
-	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
-		Updates(map[string]interface{}{
-			"change_card_two": 1,
-			"updated_at":      time.Now().Format("2006-01-02 15:04:05"),
-		})
-	if res.Error != nil || 0 >= res.RowsAffected {
-		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
-	}
-
-	return nil
-}
-
 // UploadCardPicTwo .
 func (u *UserRepo) UploadCardPicTwo(ctx context.Context, userId uint64, pic string) error {
 	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
@@ -607,11 +524,10 @@ func (u *UserRepo) UploadCardPicTwo(ctx context.Context, userId uint64, pic stri
 
 // CreateCardTwo .
 func (u *UserRepo) CreateCardTwo(ctx context.Context, userId uint64, user *biz.User) error {
-	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("amount>=?", user.Amount).Where("card_two=?", 0).
+	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("amount>=?", user.Amount).
 		Updates(map[string]interface{}{
 			"amount":     gorm.Expr("amount - ?", user.Amount),
 			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
-			"card_two":   1,
 		})
 	if res.Error != nil || 0 >= res.RowsAffected {
 		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
@@ -634,26 +550,50 @@ func (u *UserRepo) CreateCardTwo(ctx context.Context, userId uint64, user *biz.U
 	)
 
 	cardTwo.UserId = userId
-	cardTwo.Phone = user.Phone
-	cardTwo.Street = user.Street
 	cardTwo.PostalCode = user.PostalCode
-	cardTwo.BirthDate = user.BirthDate
-	cardTwo.FirstName = user.FirstName
-	cardTwo.LastName = user.LastName
-	cardTwo.Email = user.Email
 	cardTwo.CountryCode = user.CountryCode
 	cardTwo.Country = user.Country
 	cardTwo.City = user.City
 	cardTwo.State = user.State
 	cardTwo.PhoneCountryCode = user.PhoneCountryCode
-	cardTwo.IdCard = user.IdCard
 	cardTwo.Gender = user.Gender
+	cardTwo.IdCardBidx = u.data.idCardIndex(user.IdCard)
 
+	// 密文绑定行 id，先写入其他字段取得 id，再在同一事务中写入加密字段
 	resInsertTwo := u.data.DB(ctx).Table("card_two").Create(&cardTwo)
 	if resInsertTwo.Error != nil || 0 >= resInsertTwo.RowsAffected {
 		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
 	}
 
+	personal := CardTwo{
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Email:     user.Email,
+		Phone:     user.Phone,
+		Street:    user.Street,
+		BirthDate: user.BirthDate,
+		IdCard:    user.IdCard,
+	}
+	fields := cardTwoPersonalFields(&personal)
+	if err := u.data.sealFields(ctx, "card_two", cardTwo.ID, cardTwoPersonalColumns, fields); err != nil {
+		return err
+	}
+
+	updates := make(map[string]interface{}, len(fields))
+	for k, v := range fields {
+		if !personalPlain(*v) {
+			updates[cardTwoPersonalColumns[k]] = *v
+		}
+	}
+
+	if 0 == len(updates) {
+		return nil
+	}
+
+	if err := u.data.DB(ctx).Table("card_two").Where("id=?", cardTwo.ID).Updates(updates).Error; err != nil {
+		return errors.New(500, "UPDATE_CARD_TWO_ERROR", "信息创建失败")
+	}
+
 	return nil
 }
 
@@ -732,6 +672,10 @@ func (u *UserRepo) GetUsersOpenCard() ([]*biz.User, error) {
 	}
 
 	for _, user := range users {
+		if err := u.data.openFields(context.Background(), "user", user.ID, userPersonalColumns, userPersonalFields(user)); err != nil {
+			return nil, err
+		}
+
 		res = append(res, &biz.User{
 			CardAmount:    user.CardAmount,
 			MyTotalAmount: user.MyTotalAmount,
@@ -789,14 +733,9 @@ func (u *UserRepo) CreateCardRecommend(ctx context.Context, userId uint64, amoun
 }
 
 // AmountToCard .
-func (u *UserRepo) AmountToCard(ctx context.Context, userId uint64, amount float64, amountRel float64, one uint64) (uint64, error) {
-	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("amount>=?", amount).
-		Updates(map[string]interface{}{
-			"amount":     gorm.Expr("amount - ?", amount),
-			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
-		})
-	if res.Error != nil || 0 >= res.RowsAffected {
-		return 0, errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+func (u *UserRepo) AmountToCard(ctx context.Context, userId uint64, amount float64, amountRel float64, one uint64, currency string) (uint64, error) {
+	if err := subBalance(u.data.DB(ctx), userId, currency, amount); nil != err {
+		return 0, err
 	}
 
 	var (
@@ -807,6 +746,7 @@ func (u *UserRepo) AmountToCard(ctx context.Context, userId uint64, amount float
 	reward.Amount = amount
 	reward.Reason = 14 // 给我分红的理由
 	reward.One = one
+	reward.Currency = currency
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
 		return 0, errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
@@ -816,14 +756,14 @@ func (u *UserRepo) AmountToCard(ctx context.Context, userId uint64, amount float
 }
 
 // AmountToCardReward .
-func (u *UserRepo) AmountToCardReward(ctx context.Context, userId uint64, amount float64, orderId string, rewardId, one uint64) error {
+func (u *UserRepo) AmountToCardReward(ctx context.Context, userId uint64, amount float64, orderId string, rewardId, one uint64, currency string) error {
 	res := u.data.DB(ctx).Table("reward").Where("id=?", rewardId).
 		Updates(map[string]interface{}{
 			"one":        1,
 			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
 		})
 	if res.Error != nil || 0 >= res.RowsAffected {
-		fmt.Println(res.RowsAffected, res.Error)
+		u.log.Errorw("msg", "划转信息修改失败", "err", res.Error, "rows", res.RowsAffected, "reward_id", rewardId)
 		return errors.New(500, "UPDATE_REWARD_ERROR", "划转信息修改失败")
 	}
 
@@ -836,6 +776,7 @@ func (u *UserRepo) AmountToCardReward(ctx context.Context, userId uint64, amount
 	reward.Reason = 4 // 给我分红的理由
 	reward.Address = orderId
 	reward.One = one
+	reward.Currency = currency
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
 		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
@@ -845,23 +786,13 @@ func (u *UserRepo) AmountToCardReward(ctx context.Context, userId uint64, amount
 }
 
 // AmountTo .
-func (u *UserRepo) AmountTo(ctx context.Context, userId, toUserId uint64, toAddress string, amount float64) error {
-	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("amount>=?", amount).
-		Updates(map[string]interface{}{
-			"amount":     gorm.Expr("amount - ?", amount),
-			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
-		})
-	if res.Error != nil || 0 >= res.RowsAffected {
-		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+func (u *UserRepo) AmountTo(ctx context.Context, userId, toUserId uint64, toAddress string, amount, amountRel float64, currency string) error {
+	if err := subBalance(u.data.DB(ctx), userId, currency, amount); nil != err {
+		return err
 	}
 
-	resTwo := u.data.DB(ctx).Table("user").Where("id=?", toUserId).
-		Updates(map[string]interface{}{
-			"amount":     gorm.Expr("amount + ?", amount),
-			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
-		})
-	if resTwo.Error != nil || 0 >= resTwo.RowsAffected {
-		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+	if err := addBalance(u.data.DB(ctx), toUserId, currency, amountRel); nil != err {
+		return err
 	}
 
 	var (
@@ -872,6 +803,7 @@ func (u *UserRepo) AmountTo(ctx context.Context, userId, toUserId uint64, toAddr
 	reward.Amount = amount
 	reward.Reason = 5 // 给我分红的理由
 	reward.Address = toAddress
+	reward.Currency = currency
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
 		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
@@ -881,14 +813,9 @@ func (u *UserRepo) AmountTo(ctx context.Context, userId, toUserId uint64, toAddr
 }
 
 // Withdraw .
-func (u *UserRepo) Withdraw(ctx context.Context, userId uint64, amount, amountRel float64, address string) error {
-	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("amount>=?", amount).
-		Updates(map[string]interface{}{
-			"amount":     gorm.Expr("amount - ?", amount),
-			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
-		})
-	if res.Error != nil || 0 >= res.RowsAffected {
-		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+func (u *UserRepo) Withdraw(ctx context.Context, userId uint64, amount, amountRel float64, address, chain, currency string) error {
+	if err := subBalance(u.data.DB(ctx), userId, currency, amount); nil != err {
+		return err
 	}
 
 	var withdraw Withdraw
@@ -897,6 +824,8 @@ func (u *UserRepo) Withdraw(ctx context.Context, userId uint64, amount, amountRe
 	withdraw.RelAmount = amountRel
 	withdraw.Status = "rewarded"
 	withdraw.Address = address
+	withdraw.Currency = currency
+	withdraw.Chain = chain
 	resTwo := u.data.DB(ctx).Table("withdraw").Create(&withdraw)
 	if resTwo.Error != nil || 0 >= resTwo.RowsAffected {
 		return errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录创建失败")
@@ -910,6 +839,7 @@ func (u *UserRepo) Withdraw(ctx context.Context, userId uint64, amount, amountRe
 	reward.Amount = amount
 	reward.Reason = 2 // 给我分红的理由
 	reward.Address = address
+	reward.Currency = currency
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
 		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
@@ -959,12 +889,53 @@ func (u *UserRepo) GetUserRewardByUserIdPage(ctx context.Context, b *biz.Paginat
 			Address:   reward.Address,
 			One:       reward.One,
 			UpdatedAt: reward.UpdatedAt,
+			Currency:  reward.Currency,
 		})
 	}
 
 	return res, nil, count
 }
 
+// GetLastRewardId .
+func (u *UserRepo) GetLastRewardId(ctx context.Context) (uint64, error) {
+	var reward Reward
+	if err := u.data.DB(ctx).Table("reward").Order("id desc").First(&reward).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+
+		return 0, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	return reward.ID, nil
+}
+
+// GetRewardsAfterId .
+func (u *UserRepo) GetRewardsAfterId(ctx context.Context, id uint64, reason uint64, limit int) ([]*biz.Reward, error) {
+	var rewards []*Reward
+	if err := u.data.DB(ctx).Table("reward").Where("id>?", id).Where("reason=?", reason).
+		Order("id asc").Limit(limit).Find(&rewards).Error; err != nil {
+		return nil, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	res := make([]*biz.Reward, 0, len(rewards))
+	for _, reward := range rewards {
+		res = append(res, &biz.Reward{
+			ID:        reward.ID,
+			UserId:    reward.UserId,
+			Amount:    reward.Amount,
+			Reason:    reward.Reason,
+			CreatedAt: reward.CreatedAt,
+			Address:   reward.Address,
+			One:       reward.One,
+			UpdatedAt: reward.UpdatedAt,
+			Currency:  reward.Currency,
+		})
+	}
+
+	return res, nil
+}
+
 // GetUserRecordByUserIdPage .
 func (u *UserRepo) GetUserRecordByUserIdPage(ctx context.Context, b *biz.Pagination, userId uint64) ([]*biz.CardRecord, error, int64) {
 	var (
@@ -1001,6 +972,41 @@ func (u *UserRepo) GetUserRecordByUserIdPage(ctx context.Context, b *biz.Paginat
 	return res, nil, count
 }
 
+// GetLastCardCodeId .
+func (u *UserRepo) GetLastCardCodeId(ctx context.Context) (uint64, error) {
+	var code CardOrder
+	if err := u.data.DB(ctx).Table("card_code").Order("id desc").First(&code).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+
+		return 0, errors.New(500, "CARD_CODE ERROR", err.Error())
+	}
+
+	return code.ID, nil
+}
+
+// GetCardCodesAfterId .
+func (u *UserRepo) GetCardCodesAfterId(ctx context.Context, id uint64, limit int) ([]*biz.CardOrder, error) {
+	var codes []*CardOrder
+	if err := u.data.DB(ctx).Table("card_code").Where("id>?", id).Order("id asc").Limit(limit).Find(&codes).Error; err != nil {
+		return nil, errors.New(500, "CARD_CODE ERROR", err.Error())
+	}
+
+	res := make([]*biz.CardOrder, 0, len(codes))
+	for _, code := range codes {
+		res = append(res, &biz.CardOrder{
+			ID:   code.ID,
+			Last: code.Last,
+			Code: code.Code,
+			Card: code.Card,
+			Time: code.Time,
+		})
+	}
+
+	return res, nil
+}
+
 // GetUserCodePage .
 func (u *UserRepo) GetUserCodePage(ctx context.Context, b *biz.Pagination, card string) ([]*biz.CardOrder, error, int64) {
 	var (
@@ -0,0 +1,222 @@
+// Package envelope 字段级信封加密：数据密钥加密字段，主密钥加密数据密钥，密文中带主密钥版本
+//
+// 密文格式为 enc2:<主密钥版本>:<加密的数据密钥>:<nonce+密文>，后两段为 base64url。
+// 附加数据为主密钥版本和调用方给出的绑定信息（表、列和行），密文被复制到其他行或列时解密失败。
+// 没有前缀的值按明文返回，旧数据可以在线逐步重新加密。
+package envelope
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const prefix = "enc2:"
+
+// 同一个数据密钥最多加密的次数，之后重新生成，远低于 AES-GCM 随机 nonce 的安全上限
+const dataKeyMaxUses = 1 << 20
+
+var encoding = base64.RawURLEncoding
+
+// ErrMalformed 密文格式错误
+var ErrMalformed = errors.New("envelope: malformed ciphertext")
+
+// KeyProvider 主密钥，开发环境用本地密钥文件，生产环境接 KMS
+type KeyProvider interface {
+	// CurrentKeyId 新数据使用的主密钥版本
+	CurrentKeyId() string
+	// GenerateDataKey 用当前主密钥生成数据密钥，返回版本、明文和加密后的数据密钥
+	GenerateDataKey(ctx context.Context) (string, []byte, []byte, error)
+	// DecryptDataKey 用指定版本的主密钥解密数据密钥
+	DecryptDataKey(ctx context.Context, keyId string, wrapped []byte) ([]byte, error)
+}
+
+type dataKey struct {
+	keyId   string
+	wrapped string
+	aead    cipher.AEAD
+	uses    int
+}
+
+// Cipher 并发安全；解密过的数据密钥缓存在内存中，避免每个字段都访问 KMS
+type Cipher struct {
+	provider KeyProvider
+	indexKey []byte
+
+	mu      sync.Mutex
+	current *dataKey
+	cache   map[string]cipher.AEAD
+}
+
+// New indexKey 为盲索引的 HMAC 密钥，与主密钥分开且不能轮换，否则已有索引失效
+func New(provider KeyProvider, indexKey []byte) (*Cipher, error) {
+	if nil == provider {
+		return nil, errors.New("envelope: nil key provider")
+	}
+
+	if 16 > len(indexKey) {
+		return nil, errors.New("envelope: index key must be at least 16 bytes")
+	}
+
+	return &Cipher{
+		provider: provider,
+		indexKey: indexKey,
+		cache:    make(map[string]cipher.AEAD),
+	}, nil
+}
+
+// IsEncrypted .
+func IsEncrypted(s string) bool {
+	return strings.HasPrefix(s, prefix)
+}
+
+// split 去掉前缀后的主密钥版本、加密的数据密钥和密文
+func split(s string) ([]string, bool) {
+	if !IsEncrypted(s) {
+		return nil, false
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(s, prefix), ":", 3)
+	return parts, 3 == len(parts)
+}
+
+// KeyId 密文使用的主密钥版本，明文返回空
+func KeyId(s string) string {
+	parts, ok := split(s)
+	if !ok {
+		return ""
+	}
+
+	return parts[0]
+}
+
+// Stale 明文或使用旧主密钥加密，需要重新加密
+func (c *Cipher) Stale(s string) bool {
+	return !IsEncrypted(s) || KeyId(s) != c.provider.CurrentKeyId()
+}
+
+func additionalData(keyId, bind string) []byte {
+	return []byte(keyId + ":" + bind)
+}
+
+// Encrypt bind 为密文所在的位置，解密时必须相同
+func (c *Cipher) Encrypt(ctx context.Context, plaintext, bind string) (string, error) {
+	dk, err := c.dataKey(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, dk.aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := dk.aead.Seal(nonce, nonce, []byte(plaintext), additionalData(dk.keyId, bind))
+	return prefix + dk.keyId + ":" + dk.wrapped + ":" + encoding.EncodeToString(sealed), nil
+}
+
+// Decrypt 没有密文前缀的值原样返回，bind 与加密时相同
+func (c *Cipher) Decrypt(ctx context.Context, s, bind string) (string, error) {
+	if !IsEncrypted(s) {
+		return s, nil
+	}
+
+	parts, ok := split(s)
+	if !ok {
+		return "", ErrMalformed
+	}
+
+	aead, err := c.aead(ctx, parts[0], parts[1])
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := encoding.DecodeString(parts[2])
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", ErrMalformed
+	}
+
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], additionalData(parts[0], bind))
+	if err != nil {
+		return "", fmt.Errorf("envelope: decrypt: %w", err)
+	}
+
+	return string(plaintext), nil
+}
+
+// BlindIndex 确定性的 HMAC，用于等值查询和查重；调用方负责统一大小写等格式
+func (c *Cipher) BlindIndex(s string) string {
+	mac := hmac.New(sha256.New, c.indexKey)
+	mac.Write([]byte(s))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+func (c *Cipher) dataKey(ctx context.Context) (*dataKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if nil != c.current && dataKeyMaxUses > c.current.uses && c.provider.CurrentKeyId() == c.current.keyId {
+		c.current.uses++
+		return c.current, nil
+	}
+
+	keyId, plaintext, wrapped, err := c.provider.GenerateDataKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("envelope: generate data key: %w", err)
+	}
+
+	aead, err := newAEAD(plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	c.current = &dataKey{keyId: keyId, wrapped: encoding.EncodeToString(wrapped), aead: aead, uses: 1}
+	c.cache[keyId+":"+c.current.wrapped] = aead
+	return c.current, nil
+}
+
+func (c *Cipher) aead(ctx context.Context, keyId, wrapped string) (cipher.AEAD, error) {
+	c.mu.Lock()
+	aead, ok := c.cache[keyId+":"+wrapped]
+	c.mu.Unlock()
+	if ok {
+		return aead, nil
+	}
+
+	raw, err := encoding.DecodeString(wrapped)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+
+	plaintext, err := c.provider.DecryptDataKey(ctx, keyId, raw)
+	if err != nil {
+		return nil, fmt.Errorf("envelope: decrypt data key: %w", err)
+	}
+
+	if aead, err = newAEAD(plaintext); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.cache[keyId+":"+wrapped] = aead
+	c.mu.Unlock()
+	return aead, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
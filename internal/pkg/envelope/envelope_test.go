@@ -0,0 +1,114 @@
+package envelope
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTestCipher(t *testing.T, versions ...string) *Cipher {
+	t.Helper()
+
+	lines := make([]string, 0, len(versions))
+	for _, v := range versions {
+		key := make([]byte, 32)
+		_, _ = rand.Read(key)
+		lines = append(lines, v+" "+base64.StdEncoding.EncodeToString(key))
+	}
+
+	path := filepath.Join(t.TempDir(), "keys")
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	provider, err := NewKeyfileProvider(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := New(provider, []byte("0123456789abcdef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
+
+func TestCipherBind(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCipher(t, "v1")
+
+	sealed, err := c.Encrypt(ctx, "Ivan", "user.first_name.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		bind    string
+		want    string
+		wantErr bool
+	}{
+		{name: "same bind", bind: "user.first_name.1", want: "Ivan"},
+		{name: "other row", bind: "user.first_name.2", wantErr: true},
+		{name: "other column", bind: "user.last_name.1", wantErr: true},
+		{name: "other table", bind: "card_two.first_name.1", wantErr: true},
+		{name: "empty bind", bind: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.Decrypt(ctx, sealed, tt.bind)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Decrypt() err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Decrypt() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCipherDecrypt(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCipher(t, "v1")
+
+	current, err := c.Encrypt(ctx, "secret", "user_totp.secret.7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.SplitN(current, ":", 4)
+
+	tests := []struct {
+		name      string
+		value     string
+		want      string
+		wantErr   bool
+		wantStale bool
+	}{
+		{name: "plaintext passes through", value: "plain", want: "plain", wantStale: true},
+		{name: "current format", value: current, want: "secret"},
+		{name: "unbound format not decrypted", value: "enc1:v1:abc:def", want: "enc1:v1:abc:def", wantStale: true},
+		{name: "malformed", value: prefix + "v1:abc", wantErr: true},
+		{name: "tampered ciphertext", value: parts[0] + ":" + parts[1] + ":" + parts[2] + ":" + encoding.EncodeToString(make([]byte, 40)), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.Decrypt(ctx, tt.value, "user_totp.secret.7")
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Decrypt() err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Decrypt() = %q, want %q", got, tt.want)
+			}
+			if !tt.wantErr && c.Stale(tt.value) != tt.wantStale {
+				t.Errorf("Stale() = %v, want %v", c.Stale(tt.value), tt.wantStale)
+			}
+		})
+	}
+}
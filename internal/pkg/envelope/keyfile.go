@@ -0,0 +1,110 @@
+package envelope
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// KeyfileProvider 本地密钥文件，每行一个主密钥：版本 base64(32字节)，# 开头为注释。
+// 最后一行为当前密钥，轮换时在末尾追加新版本，旧版本保留到数据重新加密完成。
+//
+//	v1 q83vEjRWeJq83vEjRWeJq83vEjRWeJq83vEjRWeJq80=
+//	v2 ...
+type KeyfileProvider struct {
+	keys    map[string][]byte
+	current string
+}
+
+// NewKeyfileProvider .
+func NewKeyfileProvider(path string) (*KeyfileProvider, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	p := &KeyfileProvider{keys: make(map[string][]byte)}
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if "" == text || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if 2 != len(fields) || strings.Contains(fields[0], ":") {
+			return nil, fmt.Errorf("keyfile line %d: expected \"<version> <base64 key>\"", line)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil || 32 != len(key) {
+			return nil, fmt.Errorf("keyfile line %d: key must be 32 bytes base64", line)
+		}
+
+		if _, ok := p.keys[fields[0]]; ok {
+			return nil, fmt.Errorf("keyfile line %d: duplicate version %s", line, fields[0])
+		}
+
+		p.keys[fields[0]] = key
+		p.current = fields[0]
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if "" == p.current {
+		return nil, fmt.Errorf("keyfile %s: no keys", path)
+	}
+
+	return p, nil
+}
+
+// CurrentKeyId .
+func (p *KeyfileProvider) CurrentKeyId() string {
+	return p.current
+}
+
+// GenerateDataKey .
+func (p *KeyfileProvider) GenerateDataKey(ctx context.Context) (string, []byte, []byte, error) {
+	plaintext := make([]byte, 32)
+	if _, err := rand.Read(plaintext); err != nil {
+		return "", nil, nil, err
+	}
+
+	aead, err := newAEAD(p.keys[p.current])
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", nil, nil, err
+	}
+
+	return p.current, plaintext, aead.Seal(nonce, nonce, plaintext, []byte(p.current)), nil
+}
+
+// DecryptDataKey .
+func (p *KeyfileProvider) DecryptDataKey(ctx context.Context, keyId string, wrapped []byte) ([]byte, error) {
+	key, ok := p.keys[keyId]
+	if !ok {
+		return nil, fmt.Errorf("unknown key version %s", keyId)
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(wrapped) < aead.NonceSize() {
+		return nil, ErrMalformed
+	}
+
+	return aead.Open(nil, wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():], []byte(keyId))
+}
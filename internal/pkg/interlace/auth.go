@@ -0,0 +1,99 @@
+package interlace
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	tokenExpirySkew    = time.Minute      // 缓存的 token 在过期前多久视为不可用
+	tokenRefreshBefore = 15 * time.Minute // 定时任务在过期前多久换新
+)
+
+// AccessToken 返回缓存中可用的 accessToken，没有或即将过期时重新获取
+func (c *Client) AccessToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if "" != c.accessToken && time.Now().Add(tokenExpirySkew).Before(c.expireAt) {
+		return c.accessToken, nil
+	}
+
+	return c.renewAccessToken(ctx)
+}
+
+// RefreshAccessToken 定时任务调用，token 即将过期时提前换新，避免请求路径上同步获取
+func (c *Client) RefreshAccessToken(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if "" != c.accessToken && time.Now().Add(tokenRefreshBefore).Before(c.expireAt) {
+		return nil
+	}
+
+	return c.guard(ctx, func() error {
+		_, err := c.renewAccessToken(ctx)
+		return err
+	})
+}
+
+// Check 健康检查，熔断打开时不请求发卡方
+func (c *Client) Check(ctx context.Context) error {
+	if err := c.Available(); err != nil {
+		return err
+	}
+
+	_, err := c.AccessToken(ctx)
+	return err
+}
+
+// TokenExpireAt 当前 token 的过期时间，用于排查鉴权失败
+func (c *Client) TokenExpireAt() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.expireAt
+}
+
+// renewAccessToken 用 clientId 换 code，再用 code 换 accessToken；调用方持有 c.mu
+func (c *Client) renewAccessToken(ctx context.Context) (string, error) {
+	var code struct {
+		Timestamp int64  `json:"timestamp"`
+		Code      string `json:"code"`
+	}
+	q := url.Values{}
+	q.Set("clientId", c.cfg.ClientId)
+	if err := c.do(ctx, http.MethodGet, []string{"oauth", "authorize"}, q, nil, &code, false); err != nil {
+		return "", err
+	}
+	if "" == code.Code {
+		return "", errors.New("interlace: authorize returned empty code")
+	}
+
+	var token struct {
+		AccessToken  string `json:"accessToken"`
+		RefreshToken string `json:"refreshToken"`
+		ExpiresIn    int64  `json:"expiresIn"` // 有效期秒数，比如 86400
+		Timestamp    int64  `json:"timestamp"`
+	}
+	in := map[string]string{
+		"clientId": c.cfg.ClientId,
+		"code":     code.Code,
+	}
+	if err := c.do(ctx, http.MethodPost, []string{"oauth", "access-token"}, nil, in, &token, false); err != nil {
+		return "", err
+	}
+	if "" == token.AccessToken {
+		return "", errors.New("interlace: access-token returned empty accessToken")
+	}
+
+	// 过期时间按本地时钟计算，不依赖发卡方返回的时间戳单位
+	c.accessToken = token.AccessToken
+	c.refreshToken = token.RefreshToken
+	c.expireAt = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+
+	return c.accessToken, nil
+}
@@ -0,0 +1,207 @@
+package interlace
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultMaxRetries       = 2
+	defaultBreakerThreshold = 5
+	defaultBreakerCooldown  = 30 * time.Second
+	retryBaseDelay          = 200 * time.Millisecond
+	retryMaxDelay           = 2 * time.Second
+)
+
+// ErrCircuitOpen 发卡方连续不可用，熔断期间不发请求
+var ErrCircuitOpen = errors.New("interlace: circuit open")
+
+// BreakerState 熔断器状态
+type BreakerState string
+
+const (
+	BreakerClosed   BreakerState = "closed"
+	BreakerOpen     BreakerState = "open"
+	BreakerHalfOpen BreakerState = "half_open" // 冷却结束，放行一个探测请求
+)
+
+// breaker 连续 threshold 次不可用后打开，cooldown 后放行一个请求探测，成功则关闭
+type breaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func (b *breaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		if time.Since(b.openedAt) < b.cooldown {
+			return ErrCircuitOpen
+		}
+		b.state = BreakerHalfOpen
+		b.probing = true
+		return nil
+	case BreakerHalfOpen:
+		if b.probing {
+			return ErrCircuitOpen
+		}
+		b.probing = true
+		return nil
+	}
+
+	return nil
+}
+
+// record 返回状态是否变化
+func (b *breaker) record(failed bool) (BreakerState, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	prev := b.state
+	b.probing = false
+	if !failed {
+		b.state = BreakerClosed
+		b.failures = 0
+		return b.state, prev != b.state
+	}
+
+	b.failures++
+	if BreakerHalfOpen == b.state || b.threshold <= b.failures {
+		b.state = BreakerOpen
+		b.openedAt = time.Now()
+	}
+
+	return b.state, prev != b.state
+}
+
+// release 请求没有结果时释放探测名额，不改变状态
+func (b *breaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+func (b *breaker) current() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if BreakerOpen == b.state && time.Since(b.openedAt) >= b.cooldown {
+		return BreakerHalfOpen
+	}
+
+	return b.state
+}
+
+// BreakerState 当前熔断状态，用于健康检查
+func (c *Client) BreakerState() BreakerState {
+	return c.breaker.current()
+}
+
+// Available 熔断打开时返回 ErrCircuitOpen，调用方可在扣款等不可回退的操作前提前失败
+func (c *Client) Available() error {
+	if BreakerOpen == c.breaker.current() {
+		return ErrCircuitOpen
+	}
+
+	return nil
+}
+
+// unavailable 超时、连接失败、5xx 和 429 视为发卡方不可用，业务错误说明发卡方正常响应
+func unavailable(err error) bool {
+	if nil == err {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return http.StatusTooManyRequests == apiErr.HTTPStatus || http.StatusInternalServerError <= apiErr.HTTPStatus
+	}
+
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// IsUnavailable 发卡方不可用或已熔断，调用方可以降级
+func IsUnavailable(err error) bool {
+	return errors.Is(err, ErrCircuitOpen) || unavailable(err)
+}
+
+// guard 经过熔断器执行一次请求
+func (c *Client) guard(ctx context.Context, fn func() error) error {
+	if err := c.breaker.allow(); err != nil {
+		return err
+	}
+
+	err := fn()
+
+	// 调用方取消的请求不计入发卡方可用性
+	if nil != ctx.Err() && unavailable(err) {
+		c.breaker.release()
+		return err
+	}
+
+	if state, changed := c.breaker.record(unavailable(err)); changed {
+		c.log.Warnw("msg", "interlace 熔断状态变化", "state", state, "err", err)
+	}
+
+	return err
+}
+
+// call 经过熔断器发送请求，idempotent 为 true 时对发卡方不可用按指数退避加随机抖动重试
+func (c *Client) call(ctx context.Context, idempotent bool, method string, path []string, query url.Values, in, out interface{}) error {
+	attempts := 1
+	if idempotent {
+		attempts += c.cfg.MaxRetries
+	}
+
+	var err error
+	for i := 0; i < attempts; i++ {
+		if 0 < i {
+			timer := time.NewTimer(backoff(i))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+		}
+
+		err = c.guard(ctx, func() error {
+			return c.do(ctx, method, path, query, in, out, true)
+		})
+		if !unavailable(err) || nil != ctx.Err() {
+			return err
+		}
+
+		if i+1 < attempts {
+			c.log.Warnw("msg", "interlace 请求重试", "path", strings.Join(path, "/"), "attempt", i+1, "err", err)
+		}
+	}
+
+	return err
+}
+
+// backoff 第 n 次重试前的等待时间，full jitter
+func backoff(n int) time.Duration {
+	d := retryBaseDelay << (n - 1)
+	if retryMaxDelay < d || 0 >= d {
+		d = retryMaxDelay
+	}
+
+	return time.Duration(rand.Int63n(int64(d)) + 1)
+}
@@ -0,0 +1,269 @@
+package interlace
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// FeeDetail .
+type FeeDetail struct {
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+	FeeType  string `json:"feeType"`
+}
+
+// Transaction 卡交易，划转入返回的也是交易
+type Transaction struct {
+	ID                       string      `json:"id"`
+	AccountId                string      `json:"accountId"`
+	CardId                   string      `json:"cardId"`
+	CardholderId             string      `json:"cardholderId"`
+	CardTransactionId        string      `json:"cardTransactionId"`
+	Currency                 string      `json:"currency"`
+	Amount                   string      `json:"amount"`
+	Fee                      string      `json:"fee"`
+	FeeDetails               []FeeDetail `json:"feeDetails"`
+	ClientTransactionId      string      `json:"clientTransactionId"`
+	RelatedCardTransactionId string      `json:"relatedCardTransactionId"`
+	TransactionDisplayId     string      `json:"transactionDisplayId"`
+	Type                     int32       `json:"type"`
+	Status                   string      `json:"status"`
+
+	MerchantName    string `json:"merchantName"`
+	Mcc             string `json:"mcc"`
+	MccCategory     string `json:"mccCategory"`
+	MerchantCity    string `json:"merchantCity"`
+	MerchantCountry string `json:"merchantCountry"`
+	MerchantState   string `json:"merchantState"`
+	MerchantZipcode string `json:"merchantZipcode"`
+	MerchantMid     string `json:"merchantMid"`
+
+	TransactionTime     string `json:"transactionTime"`
+	TransactionCurrency string `json:"transactionCurrency"`
+	TransactionAmount   string `json:"transactionAmount"`
+	CreateTime          string `json:"createTime"`
+	Remark              string `json:"remark"`
+	Detail              string `json:"detail"`
+}
+
+// TransferInRequest 预付卡划转入，账户取 Config.AccountId
+type TransferInRequest struct {
+	CardId              string // 卡 UUID
+	ClientTransactionId string // 自定义交易 ID，幂等
+	Amount              string
+}
+
+// TransactionListRequest 交易列表查询条件，空值不过滤
+type TransactionListRequest struct {
+	ID                  string
+	ClientTransactionId string
+	CardId              string
+	Type                string // "0".."14"
+	Status              string // CLOSED/PENDING/FAIL
+	StartTime           string // 毫秒时间戳
+	EndTime             string
+	Limit               int // 1-100，默认 10
+	Page                int // 默认 1
+}
+
+// CardSummary 卡片余额、统计和限额
+type CardSummary struct {
+	CardId    string `json:"cardId"`
+	AccountId string `json:"accountId"`
+
+	Balance struct {
+		ID        string `json:"id"`
+		Available string `json:"available"`
+		Currency  string `json:"currency"`
+	} `json:"balance"`
+
+	Statistics struct {
+		Consumption    string `json:"consumption"`
+		Reversal       string `json:"reversal"`
+		ReversalFee    string `json:"reversalFee"`
+		Refund         string `json:"refund"`
+		RefundFee      string `json:"refundFee"`
+		NetConsumption string `json:"netConsumption"`
+		Currency       string `json:"currency"`
+	} `json:"statistics"`
+
+	VelocityControl struct {
+		Type      string `json:"type"` // DAY/WEEK/MONTH/.../NA
+		Limit     string `json:"limit"`
+		Available string `json:"available"`
+	} `json:"velocityControl"`
+}
+
+// Card .
+type Card struct {
+	ID                string             `json:"id"`
+	AccountID         string             `json:"accountId"`
+	Status            string             `json:"status"` // INACTIVE, CONTROL, ACTIVE, PENDING, FROZEN
+	Currency          string             `json:"currency"`
+	Bin               string             `json:"bin"`
+	UserName          string             `json:"userName"`
+	CreateTime        string             `json:"createTime"`
+	CardLastFour      string             `json:"cardLastFour"`
+	BillingAddress    *BillingAddress    `json:"billingAddress"`
+	Label             string             `json:"label"`
+	BalanceID         string             `json:"balanceId"`
+	BudgetID          string             `json:"budgetId"`
+	CardholderID      string             `json:"cardholderId"`
+	ReferenceID       string             `json:"referenceId"`
+	CardMode          string             `json:"cardMode"` // PHYSICAL_CARD / VIRTUAL_CARD
+	TransactionLimits []TransactionLimit `json:"transactionLimits"`
+}
+
+// BillingAddress .
+type BillingAddress struct {
+	AddressLine1 string `json:"addressLine1,omitempty"`
+	AddressLine2 string `json:"addressLine2,omitempty"`
+	City         string `json:"city,omitempty"`
+	State        string `json:"state,omitempty"`
+	PostalCode   string `json:"postalCode,omitempty"`
+	Country      string `json:"country,omitempty"`
+}
+
+// TransactionLimit .
+type TransactionLimit struct {
+	Type     string `json:"type"` // DAY/WEEK/MONTH/QUARTER/YEAR/LIFETIME/TRANSACTION/NA
+	Value    string `json:"value"`
+	Currency string `json:"currency"`
+}
+
+var errCardIdRequired = errors.New("interlace: cardId is required")
+
+func (c *Client) accountQuery() url.Values {
+	q := url.Values{}
+	q.Set("accountId", c.cfg.AccountId)
+	return q
+}
+
+// CardPrivateAccessToken 获取某张卡 iframe 用的一次性 accessToken
+func (c *Client) CardPrivateAccessToken(ctx context.Context, cardId string) (string, error) {
+	if "" == cardId {
+		return "", errCardIdRequired
+	}
+
+	var out struct {
+		AccessToken string `json:"accessToken"`
+	}
+	if err := c.call(ctx, true, http.MethodGet, []string{"cards", cardId, "private-info", "access-token"}, c.accountQuery(), nil, &out); err != nil {
+		return "", err
+	}
+
+	return out.AccessToken, nil
+}
+
+// TransferIn 预付卡划转入（从 Quantum 账户到卡），同一 ClientTransactionId 只入账一次，可以重试
+func (c *Client) TransferIn(ctx context.Context, in *TransferInRequest) (*Transaction, error) {
+	if nil == in || "" == in.CardId || "" == in.ClientTransactionId || "" == in.Amount {
+		return nil, errors.New("interlace: cardId, clientTransactionId and amount are required")
+	}
+
+	body := map[string]string{
+		"accountId":           c.cfg.AccountId,
+		"cardId":              in.CardId,
+		"clientTransactionId": in.ClientTransactionId,
+		"amount":              in.Amount,
+	}
+
+	var out Transaction
+	if err := c.call(ctx, true, http.MethodPost, []string{"cards", "transfer-in"}, nil, body, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// CardSummary 获取卡片 summary（余额/统计/限额）
+func (c *Client) CardSummary(ctx context.Context, cardId string) (*CardSummary, error) {
+	if "" == cardId {
+		return nil, errCardIdRequired
+	}
+
+	var out CardSummary
+	if err := c.call(ctx, true, http.MethodGet, []string{"cards", cardId, "card-summary"}, c.accountQuery(), nil, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// ListTransactions 查询卡交易，返回当前页和总条数
+func (c *Client) ListTransactions(ctx context.Context, in *TransactionListRequest) ([]*Transaction, string, error) {
+	if nil == in {
+		in = &TransactionListRequest{}
+	}
+
+	q := c.accountQuery()
+	for k, v := range map[string]string{
+		"id":                  in.ID,
+		"clientTransactionId": in.ClientTransactionId,
+		"cardId":              in.CardId,
+		"type":                in.Type,
+		"status":              in.Status,
+		"startTime":           in.StartTime,
+		"endTime":             in.EndTime,
+	} {
+		if "" != v {
+			q.Set(k, v)
+		}
+	}
+
+	limit, page := in.Limit, in.Page
+	if 0 >= limit {
+		limit = 10
+	}
+	if 0 >= page {
+		page = 1
+	}
+	q.Set("limit", strconv.Itoa(limit))
+	q.Set("page", strconv.Itoa(page))
+
+	var out struct {
+		List  []*Transaction `json:"list"`
+		Total string         `json:"total"`
+	}
+	if err := c.call(ctx, true, http.MethodGet, []string{"cards", "transaction-list"}, q, nil, &out); err != nil {
+		return nil, "", err
+	}
+
+	return out.List, out.Total, nil
+}
+
+// FreezeCard 冻结卡片，返回冻结后的卡片
+func (c *Client) FreezeCard(ctx context.Context, cardId string) (*Card, error) {
+	if "" == cardId {
+		return nil, errCardIdRequired
+	}
+
+	var out Card
+	if err := c.call(ctx, true, http.MethodPost, []string{"cards", cardId, "freeze"}, nil, map[string]string{"accountId": c.cfg.AccountId}, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// SetCardPin 设置卡片 PIN，pin 为 6 位数字，不重试
+func (c *Client) SetCardPin(ctx context.Context, cardId, pin string) (bool, error) {
+	if "" == cardId {
+		return false, errCardIdRequired
+	}
+	if 6 != len(pin) {
+		return false, errors.New("interlace: pin length must be 6")
+	}
+
+	var out struct {
+		Success bool `json:"success"`
+	}
+	if err := c.call(ctx, false, http.MethodPost, []string{"cards", cardId, "pin"}, nil, map[string]string{"pin": pin, "accountId": c.cfg.AccountId}, &out); err != nil {
+		return false, err
+	}
+
+	return out.Success, nil
+}
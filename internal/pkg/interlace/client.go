@@ -0,0 +1,210 @@
+// Package interlace 发卡方 Interlace open-api v3 客户端
+//
+// 响应统一为 {"code":"000000","message":"","data":{...}}，code 不为 000000 或 HTTP 状态非 2xx 时返回 *APIError。
+// accessToken 缓存在进程内，过期前由定时任务调用 RefreshAccessToken 换新。
+// 请求经过熔断器，发卡方连续不可用时直接返回 ErrCircuitOpen；幂等请求在超时、5xx 时退避重试。
+package interlace
+
+import (
+	"bytes"
+	"cardbinance/internal/pkg/telemetry"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	codeOK         = "000000"
+	defaultTimeout = 10 * time.Second
+	// 响应体最多读取的字节数，错误信息中最多保留的字节数
+	maxBodySize  = 4 << 20
+	maxErrorBody = 512
+)
+
+// 所有 Client 共用的连接池
+var sharedTransport = telemetry.Transport(&http.Transport{
+	Proxy: http.ProxyFromEnvironment,
+	DialContext: (&net.Dialer{
+		Timeout:   5 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}).DialContext,
+	ForceAttemptHTTP2:     true,
+	MaxIdleConns:          100,
+	MaxIdleConnsPerHost:   20,
+	IdleConnTimeout:       90 * time.Second,
+	TLSHandshakeTimeout:   5 * time.Second,
+	ExpectContinueTimeout: time.Second,
+}, "interlace")
+
+// ErrNotConfigured 未配置 base_url 或 client_id
+var ErrNotConfigured = errors.New("interlace: not configured")
+
+// Config .
+type Config struct {
+	BaseURL   string // 例如 https://api-sandbox.interlace.money/open-api/v3
+	ClientId  string
+	AccountId string
+	Timeout   time.Duration // 单次请求超时，默认 10s
+
+	MaxRetries       int           // 幂等请求失败后的重试次数，默认 2
+	BreakerThreshold int           // 连续失败多少次后熔断，默认 5
+	BreakerCooldown  time.Duration // 熔断后多久放行探测请求，默认 30s
+}
+
+// APIError 发卡方返回的错误，HTTP 状态非 2xx 时 Code 可能为空
+type APIError struct {
+	Path       string
+	HTTPStatus int
+	Code       string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("interlace %s: http %d code=%s msg=%s", e.Path, e.HTTPStatus, e.Code, e.Message)
+}
+
+// Client 并发安全
+type Client struct {
+	cfg     Config
+	hc      *http.Client
+	log     *log.Helper
+	breaker *breaker
+
+	mu           sync.Mutex
+	accessToken  string
+	refreshToken string
+	expireAt     time.Time
+}
+
+// New .
+func New(cfg Config, logger log.Logger) *Client {
+	if 0 >= cfg.Timeout {
+		cfg.Timeout = defaultTimeout
+	}
+	if 0 >= cfg.MaxRetries {
+		cfg.MaxRetries = defaultMaxRetries
+	}
+	if 0 >= cfg.BreakerThreshold {
+		cfg.BreakerThreshold = defaultBreakerThreshold
+	}
+	if 0 >= cfg.BreakerCooldown {
+		cfg.BreakerCooldown = defaultBreakerCooldown
+	}
+
+	return &Client{
+		cfg:     cfg,
+		hc:      &http.Client{Transport: sharedTransport, Timeout: cfg.Timeout},
+		log:     log.NewHelper(log.With(logger, "module", "pkg/interlace")),
+		breaker: &breaker{threshold: cfg.BreakerThreshold, cooldown: cfg.BreakerCooldown, state: BreakerClosed},
+	}
+}
+
+// AccountId 请求中默认使用的账户
+func (c *Client) AccountId() string {
+	return c.cfg.AccountId
+}
+
+type envelope struct {
+	Code    string          `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// do 发送一次请求并把 data 解析到 out，不经过熔断器；authorized 为 true 时带上 accessToken
+func (c *Client) do(ctx context.Context, method string, path []string, query url.Values, in, out interface{}, authorized bool) error {
+	if "" == c.cfg.BaseURL || "" == c.cfg.ClientId {
+		return ErrNotConfigured
+	}
+
+	urlStr, err := url.JoinPath(c.cfg.BaseURL, path...)
+	if err != nil {
+		return err
+	}
+	if 0 < len(query) {
+		urlStr += "?" + query.Encode()
+	}
+
+	var body io.Reader
+	if nil != in {
+		b, errTwo := json.Marshal(in)
+		if errTwo != nil {
+			return fmt.Errorf("interlace: marshal request: %w", errTwo)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, urlStr, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if nil != in {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if authorized {
+		token, errTwo := c.AccessToken(ctx)
+		if errTwo != nil {
+			return errTwo
+		}
+		req.Header.Set("x-access-token", token)
+	}
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		return err
+	}
+
+	apiPath := req.URL.Path
+	var env envelope
+	errJson := json.Unmarshal(respBody, &env)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		c.log.Warnw("msg", "interlace 请求失败", "path", apiPath, "status", resp.StatusCode, "token_expire_at", c.TokenExpireAt())
+
+		apiErr := &APIError{Path: apiPath, HTTPStatus: resp.StatusCode, Code: env.Code, Message: env.Message}
+		if errJson != nil || "" == env.Message {
+			apiErr.Message = truncate(string(respBody), maxErrorBody)
+		}
+		return apiErr
+	}
+
+	if errJson != nil {
+		return fmt.Errorf("interlace %s: unmarshal: %w", apiPath, errJson)
+	}
+
+	if codeOK != env.Code {
+		return &APIError{Path: apiPath, HTTPStatus: resp.StatusCode, Code: env.Code, Message: env.Message}
+	}
+
+	if nil != out && 0 < len(env.Data) {
+		if err = json.Unmarshal(env.Data, out); err != nil {
+			return fmt.Errorf("interlace %s: unmarshal data: %w", apiPath, err)
+		}
+	}
+
+	return nil
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	return s[:n]
+}
@@ -0,0 +1,120 @@
+package ratelimit
+
+import (
+	"cardbinance/internal/pkg/realip"
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-redis/redis/v8"
+	jwt2 "github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	KeyUser = "user"
+	KeyIP   = "ip"
+)
+
+// Rule 单个接口的令牌桶配置
+type Rule struct {
+	Rate  float64 // 每秒补充的令牌数
+	Burst int64   // 桶容量
+	Key   string  // KeyUser 或 KeyIP
+	// FailClosed redis 不可用时拒绝请求，用于资金、PIN、登录等不能失去限流保护的接口
+	FailClosed bool
+}
+
+// Replayable 请求已有保存的结果可以直接重放时返回 true，这类请求不消耗令牌
+type Replayable func(ctx context.Context, operation string, req interface{}) bool
+
+// 令牌桶，返回 {是否放行, 需要等待的毫秒数}
+var bucketScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call("TIME")
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+
+local data = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(data[1])
+local ts = tonumber(data[2])
+if tokens == nil then
+	tokens = burst
+	ts = now
+end
+
+tokens = math.min(burst, tokens + (now - ts) / 1000 * rate)
+
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	wait = math.ceil((1 - tokens) / rate * 1000)
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "ts", tostring(now))
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst / rate * 1000) + 1000)
+return {allowed, wait}
+`)
+
+// Server 按 operation 限流，JWT 中间件之后使用才能取到用户；replayable 可以为 nil
+func Server(rdb *redis.Client, rules map[string]Rule, replayable Replayable) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			rule, ok := rules[tr.Operation()]
+			if !ok || 0 >= rule.Rate || 0 >= rule.Burst {
+				return handler(ctx, req)
+			}
+
+			// 超时重试时返回第一次的结果，不能被限流挡住
+			if nil != replayable && replayable(ctx, tr.Operation(), req) {
+				return handler(ctx, req)
+			}
+
+			key := clientKey(ctx, rule.Key)
+			res, err := bucketScript.Run(ctx, rdb, []string{"ratelimit:" + tr.Operation() + ":" + key}, rule.Rate, rule.Burst).Int64Slice()
+			if err != nil || 2 != len(res) {
+				if rule.FailClosed {
+					return nil, errors.New(503, "RATE_LIMIT_UNAVAILABLE", "服务繁忙，请稍后再试")
+				}
+
+				// redis 不可用时放行，不影响业务
+				return handler(ctx, req)
+			}
+
+			if 1 != res[0] {
+				retryAfter := strconv.FormatInt(int64((time.Duration(res[1])*time.Millisecond+time.Second-1)/time.Second), 10)
+				tr.ReplyHeader().Set("Retry-After", retryAfter)
+				return nil, errors.New(429, "TOO_MANY_REQUESTS", "请求过于频繁，请稍后再试").
+					WithMetadata(map[string]string{"retry_after": retryAfter})
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// clientKey JWT 用户或客户端 IP
+func clientKey(ctx context.Context, mode string) string {
+	if KeyUser == mode {
+		if claims, ok := jwt.FromContext(ctx); ok {
+			if c, ok := claims.(jwt2.MapClaims); ok && nil != c["UserId"] {
+				if userId, ok := c["UserId"].(float64); ok {
+					return "u:" + strconv.FormatUint(uint64(userId), 10)
+				}
+			}
+		}
+	}
+
+	return "ip:" + realip.FromContext(ctx)
+}
@@ -0,0 +1,164 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+const sendTimeout = 10 * time.Second
+
+// Sender 向一个地址发送一条消息，地址为邮箱或 Telegram chat id
+type Sender interface {
+	Send(ctx context.Context, to, title, body string) error
+}
+
+// SMTP 邮件通知，服务端支持 STARTTLS 时自动加密
+type SMTP struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     string
+}
+
+func NewSMTP(addr, username, password, from string) *SMTP {
+	host, _, _ := net.SplitHostPort(addr)
+	return &SMTP{addr: addr, host: host, username: username, password: password, from: from}
+}
+
+// Send .
+func (s *SMTP) Send(ctx context.Context, to, title, body string) error {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	c, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err = c.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return err
+		}
+	}
+
+	if "" != s.username {
+		if err = c.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return err
+		}
+	}
+
+	if err = c.Mail(s.from); err != nil {
+		return err
+	}
+	if err = c.Rcpt(to); err != nil {
+		return err
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", s.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", title))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	msg.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	msg.WriteString("\r\n")
+
+	if _, err = w.Write(msg.Bytes()); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
+
+// Telegram 通过 Bot API sendMessage 发送
+type Telegram struct {
+	apiBase string
+	token   string
+	client  *http.Client
+}
+
+// NewTelegram 请求路径中带有 token，不经过 telemetry.Transport，避免写入链路和指标标签
+func NewTelegram(apiBase, token string) *Telegram {
+	if "" == apiBase {
+		apiBase = "https://api.telegram.org"
+	}
+
+	return &Telegram{
+		apiBase: strings.TrimRight(apiBase, "/"),
+		token:   token,
+		client:  &http.Client{Timeout: sendTimeout},
+	}
+}
+
+type telegramResp struct {
+	Ok          bool   `json:"ok"`
+	Description string `json:"description"`
+}
+
+// Send .
+func (t *Telegram) Send(ctx context.Context, chatId, title, body string) error {
+	b, _ := json.Marshal(map[string]interface{}{
+		"chat_id": chatId,
+		"text":    title + "\n\n" + body,
+	})
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.apiBase+"/bot"+t.token+"/sendMessage", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		// 错误信息里的 URL 带有 bot token
+		return fmt.Errorf("telegram sendMessage: %s", strings.ReplaceAll(err.Error(), t.token, "***"))
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return err
+	}
+
+	var result telegramResp
+	if err = json.Unmarshal(respBody, &result); err != nil {
+		return fmt.Errorf("telegram sendMessage http %d: %s", resp.StatusCode, string(respBody))
+	}
+	if !result.Ok {
+		return fmt.Errorf("telegram sendMessage http %d: %s", resp.StatusCode, result.Description)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package realip
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync/atomic"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+)
+
+// 可信代理网段，为空时不信任任何代理头
+var trusted atomic.Pointer[[]*net.IPNet]
+
+// SetTrustedProxies 设置可信反向代理，支持单个 IP 和 CIDR
+func SetTrustedProxies(proxies []string) error {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, v := range proxies {
+		v = strings.TrimSpace(v)
+		if "" == v {
+			continue
+		}
+
+		if !strings.Contains(v, "/") {
+			ip := net.ParseIP(v)
+			if nil == ip {
+				return fmt.Errorf("realip: invalid trusted proxy %q", v)
+			}
+
+			bits := 128
+			if nil != ip.To4() {
+				ip = ip.To4()
+				bits = 32
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, n, err := net.ParseCIDR(v)
+		if nil != err {
+			return fmt.Errorf("realip: invalid trusted proxy %q: %w", v, err)
+		}
+		nets = append(nets, n)
+	}
+
+	trusted.Store(&nets)
+	return nil
+}
+
+func isTrusted(addr string) bool {
+	nets := trusted.Load()
+	if nil == nets {
+		return false
+	}
+
+	ip := net.ParseIP(addr)
+	if nil == ip {
+		return false
+	}
+
+	for _, n := range *nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolve 直连地址不是可信代理时直接使用；否则从右往左取 X-Forwarded-For 中第一个不可信的地址，
+// 客户端可以伪造最左边的值，只有可信代理追加的部分可信
+func resolve(remote, forwardedFor, realIP string) string {
+	if !isTrusted(remote) {
+		return remote
+	}
+
+	hops := strings.Split(forwardedFor, ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if "" == hop {
+			continue
+		}
+
+		if nil == net.ParseIP(hop) {
+			break
+		}
+
+		if !isTrusted(hop) || 0 == i {
+			return hop
+		}
+	}
+
+	if ip := strings.TrimSpace(realIP); nil != net.ParseIP(ip) {
+		return ip
+	}
+
+	return remote
+}
+
+func hostOnly(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); nil == err {
+		return host
+	}
+
+	return addr
+}
+
+// FromContext 客户端 IP，只有直连地址是可信代理时才读取 X-Forwarded-For、X-Real-IP
+func FromContext(ctx context.Context) string {
+	remote := ""
+	tr, hasTr := transport.FromServerContext(ctx)
+	if hasTr {
+		if ht, ok := tr.(http.Transporter); ok {
+			remote = hostOnly(ht.Request().RemoteAddr)
+		}
+	}
+
+	if "" == remote {
+		if p, ok := peer.FromContext(ctx); ok && nil != p.Addr {
+			remote = hostOnly(p.Addr.String())
+		}
+	}
+
+	if "" == remote {
+		return "unknown"
+	}
+
+	if !hasTr {
+		return remote
+	}
+
+	return resolve(remote, tr.RequestHeader().Get("X-Forwarded-For"), tr.RequestHeader().Get("X-Real-IP"))
+}
+
+// UserAgent 客户端 User-Agent
+func UserAgent(ctx context.Context) string {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		return tr.RequestHeader().Get("User-Agent")
+	}
+
+	return ""
+}
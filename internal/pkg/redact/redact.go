@@ -0,0 +1,149 @@
+package redact
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const mask = "***"
+
+// 值整体隐藏的字段名，小写且去掉下划线和横线后比较
+var sensitiveKeys = map[string]struct{}{
+	"token":         {},
+	"accesstoken":   {},
+	"refreshtoken":  {},
+	"authorization": {},
+	"secret":        {},
+	"clientsecret":  {},
+	"password":      {},
+	"pin":           {},
+	"cvv":           {},
+	"cvc":           {},
+	"sign":          {},
+	"signature":     {},
+	"idcard":        {},
+	"phone":         {},
+	"mobile":        {},
+	"email":         {},
+	"pan":           {},
+	"cardnumber":    {},
+	"cardno":        {},
+}
+
+var (
+	// JSON 中的敏感字段 "accessToken":"xxx"
+	jsonFieldRe = regexp.MustCompile(`(?i)"(access_?token|refresh_?token|token|client_?secret|secret|password|pin|cvv|cvc|sign|signature|id_?card|phone|mobile|email|pan|card_?number|card_?no)"\s*:\s*"[^"]*"`)
+	// key=value 形式
+	kvFieldRe = regexp.MustCompile(`(?i)\b(access_?token|refresh_?token|token|client_?secret|secret|password|pin|cvv|sign)=([^&\s"]+)`)
+	bearerRe  = regexp.MustCompile(`(?i)(bearer\s+)[A-Za-z0-9\-._~+/]+=*`)
+	emailRe   = regexp.MustCompile(`([A-Za-z0-9])[A-Za-z0-9._%+\-]*@([A-Za-z0-9.\-]+\.[A-Za-z]{2,})`)
+	// 18位身份证号，末位可为X
+	idCardRe = regexp.MustCompile(`\b(\d{3})\d{11}(\d{3}[\dXx])\b`)
+	// 大陆手机号
+	phoneRe = regexp.MustCompile(`\b(1[3-9]\d)\d{4}(\d{4})\b`)
+	// 卡号：15-19位数字，通过 Luhn 校验才处理，避免误伤毫秒时间戳和订单号
+	panRe = regexp.MustCompile(`\b\d{15,19}\b`)
+)
+
+// String 对字符串中的卡号、身份证、手机号、邮箱和令牌脱敏
+func String(s string) string {
+	if "" == s {
+		return s
+	}
+
+	s = jsonFieldRe.ReplaceAllString(s, `"$1":"`+mask+`"`)
+	s = kvFieldRe.ReplaceAllString(s, `$1=`+mask)
+	s = bearerRe.ReplaceAllString(s, `${1}`+mask)
+	s = emailRe.ReplaceAllString(s, `$1`+mask+`@$2`)
+	s = panRe.ReplaceAllStringFunc(s, func(v string) string {
+		if !luhn(v) {
+			return v
+		}
+
+		return v[:6] + strings.Repeat("*", len(v)-10) + v[len(v)-4:]
+	})
+	s = idCardRe.ReplaceAllString(s, `$1`+mask+`$2`)
+	s = phoneRe.ReplaceAllString(s, `$1****$2`)
+
+	return s
+}
+
+// SensitiveKey 字段名是否需要整体隐藏
+func SensitiveKey(key string) bool {
+	key = strings.ToLower(strings.NewReplacer("_", "", "-", "", ".", "").Replace(key))
+	_, ok := sensitiveKeys[key]
+	return ok
+}
+
+func luhn(v string) bool {
+	sum := 0
+	double := false
+	for i := len(v) - 1; i >= 0; i-- {
+		d := int(v[i] - '0')
+		if double {
+			d *= 2
+			if 9 < d {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+
+	return 0 == sum%10
+}
+
+// NewLogger 输出前对所有字段脱敏
+func NewLogger(logger log.Logger) log.Logger {
+	return &redactLogger{logger: logger}
+}
+
+type redactLogger struct {
+	logger log.Logger
+}
+
+func (l *redactLogger) Log(level log.Level, keyvals ...interface{}) error {
+	kvs := make([]interface{}, len(keyvals))
+	copy(kvs, keyvals)
+
+	for i := 1; i < len(kvs); i += 2 {
+		if key, ok := kvs[i-1].(string); ok && SensitiveKey(key) {
+			kvs[i] = mask
+			continue
+		}
+
+		switch v := kvs[i].(type) {
+		case string:
+			kvs[i] = String(v)
+		case []byte:
+			kvs[i] = String(string(v))
+		case error:
+			kvs[i] = String(v.Error())
+		case fmt.Stringer:
+			kvs[i] = String(v.String())
+		}
+	}
+
+	return l.logger.Log(level, kvs...)
+}
+
+// NewWriter 写入前脱敏，用于 SQL 日志等直接写文件的场景
+func NewWriter(w io.Writer) io.Writer {
+	return &redactWriter{w: w}
+}
+
+type redactWriter struct {
+	w io.Writer
+}
+
+func (r *redactWriter) Write(p []byte) (int, error) {
+	if _, err := r.w.Write([]byte(String(string(p)))); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
@@ -0,0 +1,194 @@
+package telemetry
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"cardbinance/internal/conf"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "cardbinance"
+
+var (
+	tracer = otel.Tracer(instrumentationName)
+	meter  = otel.Meter(instrumentationName)
+
+	// 全局 MeterProvider 设置前创建的指标会自动转发到之后设置的 provider
+	outboundSeconds, _ = meter.Float64Histogram("outbound_requests_seconds",
+		metric.WithUnit("s"),
+		metric.WithDescription("发卡方等外部 HTTP 接口耗时"),
+		metric.WithExplicitBucketBoundaries(0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10))
+	outboundErrors, _ = meter.Int64Counter("outbound_requests_errors_total",
+		metric.WithDescription("外部 HTTP 接口网络错误或非2xx响应"))
+	txSeconds, _ = meter.Float64Histogram("db_transactions_seconds",
+		metric.WithUnit("s"),
+		metric.WithDescription("数据库事务耗时"),
+		metric.WithExplicitBucketBoundaries(0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1))
+	txErrors, _ = meter.Int64Counter("db_transactions_errors_total",
+		metric.WithDescription("回滚的数据库事务"))
+)
+
+// Setup 注册 prometheus 指标导出和链路追踪，trace 配置了 endpoint 时通过 OTLP 导出
+func Setup(c *conf.Trace, name, version, id string) (func(), error) {
+	res := resource.NewSchemaless(
+		semconv.ServiceName(name),
+		semconv.ServiceVersion(version),
+		semconv.ServiceInstanceID(id),
+	)
+
+	exporter, err := prometheus.New()
+	if err != nil {
+		return nil, err
+	}
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(exporter), sdkmetric.WithResource(res))
+	otel.SetMeterProvider(mp)
+
+	ratio := 1.0
+	opts := []sdktrace.TracerProviderOption{sdktrace.WithResource(res)}
+	if nil != c {
+		if 0 < c.SampleRatio && 1 > c.SampleRatio {
+			ratio = c.SampleRatio
+		}
+
+		if "" != c.Endpoint {
+			clientOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(c.Endpoint)}
+			if c.Insecure {
+				clientOpts = append(clientOpts, otlptracegrpc.WithInsecure())
+			}
+
+			traceExporter, err := otlptracegrpc.New(context.Background(), clientOpts...)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, sdktrace.WithBatcher(traceExporter))
+		}
+	}
+	opts = append(opts, sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))))
+
+	// 未配置导出时也生成 trace id，日志中的 trace.id 可用于串联请求
+	tp := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(tp)
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = tp.Shutdown(ctx)
+		_ = mp.Shutdown(ctx)
+	}, nil
+}
+
+// Transport 外部 HTTP 调用的链路和耗时统计，peer 为对端名称，例如 interlace
+func Transport(base http.RoundTripper, peer string) http.RoundTripper {
+	if nil == base {
+		base = http.DefaultTransport
+	}
+
+	return &transport{base: base, peer: peer}
+}
+
+type transport struct {
+	base http.RoundTripper
+	peer string
+}
+
+// routeTemplate 把路径中的卡号、卡 id 等替换为 {id}，避免 span 名称和指标标签带上敏感值和无限多的取值
+func routeTemplate(path string) string {
+	segments := strings.Split(path, "/")
+	for i, v := range segments {
+		if isIdSegment(v) {
+			segments[i] = "{id}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// isIdSegment 纯数字，或较长且含数字的段（uuid、hex 等）；v1 这类短的版本号保留
+func isIdSegment(segment string) bool {
+	if "" == segment {
+		return false
+	}
+
+	digits := 0
+	for _, r := range segment {
+		if '0' <= r && r <= '9' {
+			digits++
+		}
+	}
+
+	return len(segment) == digits || (0 < digits && 16 <= len(segment))
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	route := routeTemplate(req.URL.Path)
+	ctx, span := tracer.Start(req.Context(), t.peer+" "+req.Method+" "+route,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.HTTPRoute(route),
+			semconv.ServerAddress(req.URL.Host),
+		))
+	defer span.End()
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+
+	code := "error"
+	if nil == err {
+		code = strconv.Itoa(resp.StatusCode)
+		span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	}
+
+	attrs := metric.WithAttributes(
+		attribute.String("peer", t.peer),
+		attribute.String("method", req.Method),
+		attribute.String("path", route),
+		attribute.String("code", code),
+	)
+	outboundSeconds.Record(ctx, time.Since(start).Seconds(), attrs)
+
+	if nil != err || 300 <= resp.StatusCode || 200 > resp.StatusCode {
+		outboundErrors.Add(ctx, 1, attrs)
+		if nil != err {
+			span.RecordError(err)
+		}
+		span.SetStatus(codes.Error, code)
+	}
+
+	return resp, err
+}
+
+// Tx 数据库事务的链路和耗时统计
+func Tx(ctx context.Context, fn func(ctx context.Context) error) error {
+	ctx, span := tracer.Start(ctx, "db.transaction", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	start := time.Now()
+	err := fn(ctx)
+
+	result := "commit"
+	if nil != err {
+		result = "rollback"
+		txErrors.Add(ctx, 1)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	txSeconds.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(attribute.String("result", result)))
+
+	return err
+}
@@ -0,0 +1,225 @@
+// Package watchlist 制裁和 PEP 名单的导入与姓名模糊匹配
+//
+// CSV 第一行为表头，name 必填，可选 id、aliases（分号分隔）、country：
+//
+//	id,name,aliases,country
+//	1001,Ivan Petrov,Ivan Petrov Jr;I. Petrov,RU
+//
+// XML 格式：
+//
+//	<list version="2024-06-01">
+//	  <entry id="1001"><name>Ivan Petrov</name><alias>I. Petrov</alias><country>RU</country></entry>
+//	</list>
+package watchlist
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Entry 名单中的一个人或实体
+type Entry struct {
+	ID      string
+	Name    string
+	Aliases []string
+	Country string
+}
+
+// ParseCSV .
+func ParseCSV(r io.Reader) ([]*Entry, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+
+	cols := make(map[string]int, len(header))
+	for i, v := range header {
+		cols[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(v, "\ufeff")))] = i
+	}
+
+	if _, ok := cols["name"]; !ok {
+		return nil, fmt.Errorf("missing name column")
+	}
+
+	get := func(record []string, col string) string {
+		if i, ok := cols[col]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	res := make([]*Entry, 0)
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		e := &Entry{
+			ID:      get(record, "id"),
+			Name:    get(record, "name"),
+			Country: get(record, "country"),
+		}
+		if "" == e.Name {
+			continue
+		}
+
+		for _, v := range strings.Split(get(record, "aliases"), ";") {
+			if v = strings.TrimSpace(v); "" != v {
+				e.Aliases = append(e.Aliases, v)
+			}
+		}
+
+		res = append(res, e)
+	}
+
+	return res, nil
+}
+
+type xmlList struct {
+	Version string     `xml:"version,attr"`
+	Entries []xmlEntry `xml:"entry"`
+}
+
+type xmlEntry struct {
+	ID      string   `xml:"id,attr"`
+	Name    string   `xml:"name"`
+	Aliases []string `xml:"alias"`
+	Country string   `xml:"country"`
+}
+
+// ParseXML 返回文件中的版本号，未填写时为空
+func ParseXML(r io.Reader) (string, []*Entry, error) {
+	var l xmlList
+	if err := xml.NewDecoder(r).Decode(&l); err != nil {
+		return "", nil, err
+	}
+
+	res := make([]*Entry, 0, len(l.Entries))
+	for _, v := range l.Entries {
+		e := &Entry{
+			ID:      strings.TrimSpace(v.ID),
+			Name:    strings.TrimSpace(v.Name),
+			Country: strings.TrimSpace(v.Country),
+		}
+		if "" == e.Name {
+			continue
+		}
+
+		for _, a := range v.Aliases {
+			if a = strings.TrimSpace(a); "" != a {
+				e.Aliases = append(e.Aliases, a)
+			}
+		}
+
+		res = append(res, e)
+	}
+
+	return strings.TrimSpace(l.Version), res, nil
+}
+
+// Normalize 去掉重音符号和标点，转小写，按空格分词
+func Normalize(name string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(name) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(' ')
+		}
+	}
+
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// Similarity 0-1，取整体和词序无关两种比较的较高值，姓和名顺序颠倒也能匹配
+func Similarity(a, b string) float64 {
+	a, b = Normalize(a), Normalize(b)
+	if "" == a || "" == b {
+		return 0
+	}
+
+	score := jaroWinkler(a, b)
+	if tmp := jaroWinkler(sortTokens(a), sortTokens(b)); tmp > score {
+		score = tmp
+	}
+
+	return score
+}
+
+func sortTokens(s string) string {
+	tokens := strings.Fields(s)
+	sort.Strings(tokens)
+	return strings.Join(tokens, " ")
+}
+
+func jaroWinkler(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	if 0 == len(ra) || 0 == len(rb) {
+		return 0
+	}
+
+	window := max(len(ra), len(rb))/2 - 1
+	if 0 > window {
+		window = 0
+	}
+
+	matchedA := make([]bool, len(ra))
+	matchedB := make([]bool, len(rb))
+	matches := 0
+	for i := range ra {
+		lo, hi := max(0, i-window), min(len(rb), i+window+1)
+		for j := lo; j < hi; j++ {
+			if matchedB[j] || ra[i] != rb[j] {
+				continue
+			}
+			matchedA[i], matchedB[j] = true, true
+			matches++
+			break
+		}
+	}
+
+	if 0 == matches {
+		return 0
+	}
+
+	transpositions, j := 0, 0
+	for i := range ra {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if ra[i] != rb[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(ra)) + m/float64(len(rb)) + (m-float64(transpositions)/2)/m) / 3
+
+	prefix := 0
+	for i := 0; i < min(4, len(ra), len(rb)) && ra[i] == rb[i]; i++ {
+		prefix++
+	}
+
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
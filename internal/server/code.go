@@ -0,0 +1,50 @@
+package server
+
+import (
+	"cardbinance/internal/service"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+// CodePoller 定时检查新验证码推送给本实例的 SSE 连接，没有连接时不查库
+type CodePoller struct {
+	cs   *service.CodeService
+	stop chan struct{}
+	log  *log.Helper
+}
+
+// NewCodePoller new a card code poller.
+func NewCodePoller(cs *service.CodeService, logger log.Logger) *CodePoller {
+	return &CodePoller{
+		cs:   cs,
+		stop: make(chan struct{}),
+		log:  log.NewHelper(log.With(logger, "module", "server/code")),
+	}
+}
+
+// Start .
+func (p *CodePoller) Start(ctx context.Context) error {
+	interval := p.cs.PollInterval()
+	p.log.Infow("msg", "code poller started", "interval", interval.String())
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			p.cs.Poll(context.Background())
+		}
+	}
+}
+
+// Stop .
+func (p *CodePoller) Stop(ctx context.Context) error {
+	close(p.stop)
+	return nil
+}
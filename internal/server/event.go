@@ -0,0 +1,59 @@
+package server
+
+import (
+	"cardbinance/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+// EventRelay 订阅 Redis 中的账户事件转发给本实例的推送连接
+type EventRelay struct {
+	hub    *biz.EventHub
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+	log    *log.Helper
+}
+
+// NewEventRelay new an account event relay.
+func NewEventRelay(hub *biz.EventHub, logger log.Logger) *EventRelay {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &EventRelay{
+		hub:    hub,
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+		log:    log.NewHelper(log.With(logger, "module", "server/event")),
+	}
+}
+
+// Start 订阅失败时每秒重试
+func (e *EventRelay) Start(context.Context) error {
+	defer close(e.done)
+
+	e.log.Info("event relay started")
+	for {
+		if err := e.hub.Run(e.ctx); nil != err {
+			e.log.Errorw("msg", "账户事件订阅失败", "err", err)
+		}
+
+		select {
+		case <-e.ctx.Done():
+			return nil
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// Stop .
+func (e *EventRelay) Stop(ctx context.Context) error {
+	e.cancel()
+
+	select {
+	case <-e.done:
+	case <-ctx.Done():
+	}
+
+	return nil
+}
@@ -2,16 +2,27 @@ package server
 
 import (
 	"cardbinance/internal/conf"
+	"cardbinance/internal/pkg/middleware/ratelimit"
+	"cardbinance/internal/pkg/realip"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/go-redis/redis/v8"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, rdb *redis.Client, logger log.Logger) *grpc.Server {
+	if err := realip.SetTrustedProxies(c.TrustedProxies); err != nil {
+		panic(err)
+	}
+
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			tracing.Server(),
+			metricsServer(),
+			ratelimit.Server(rdb, rateLimitRules(c), nil),
 		),
 	}
 	if c.Grpc.Network != "" {
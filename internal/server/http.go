@@ -3,32 +3,44 @@ package server
 import (
 	v1 "cardbinance/api/user/v1"
 	"cardbinance/internal/conf"
+	"cardbinance/internal/pkg/middleware/ratelimit"
+	"cardbinance/internal/pkg/realip"
 	"cardbinance/internal/service"
 	"context"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/go-redis/redis/v8"
 	jwt2 "github.com/golang-jwt/jwt/v5"
 	"github.com/gorilla/handlers"
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, userService *service.UserService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, userService *service.UserService, healthService *service.HealthService, codeService *service.CodeService, eventService *service.EventService, rdb *redis.Client, logger log.Logger) *http.Server {
+	if err := realip.SetTrustedProxies(c.TrustedProxies); err != nil {
+		panic(err)
+	}
+
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			tracing.Server(),
+			metricsServer(),
 			selector.Server( // jwt 验证
 				jwt.Server(func(token *jwt2.Token) (interface{}, error) {
 					return []byte("5485c6f09a1a9bf5edeb841d85e09250"), nil
 				}, jwt.WithSigningMethod(jwt2.SigningMethodHS256)),
 			).Match(NewWhiteListMatcher()).Build(),
+			ratelimit.Server(rdb, rateLimitRules(c), userService.IdempotentReplayable),
 		),
-		http.Filter(handlers.CORS(
-			handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type", "Authorization"}),
+		http.Filter(service.StreamFilter, handlers.CORS(
+			handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type", "Authorization", "Idempotency-Key", "X-Admin-Key"}),
 			handlers.AllowedMethods([]string{"GET", "POST", "PUT", "HEAD", "OPTIONS"}),
 			handlers.AllowedOrigins([]string{"*"}),
+			handlers.ExposedHeaders([]string{"Retry-After"}),
 		)),
 	}
 	if c.Http.Network != "" {
@@ -42,6 +54,14 @@ func NewHTTPServer(c *conf.Server, userService *service.UserService, logger log.
 	}
 	srv := http.NewServer(opts...)
 	v1.RegisterUserHTTPServer(srv, userService)
+	srv.HandleFunc("/healthz", healthService.Live)
+	srv.HandleFunc("/readyz", healthService.Ready)
+	// 验证码和账户事件推送，SSE 长连接，不经过接口中间件，在处理函数中校验 token
+	srv.HandleFunc("/api/app_server/code_stream", codeService.Stream)
+	srv.HandleFunc("/api/app_server/event_stream", eventService.Stream)
+	// 数据导出 zip 包下载，同样在处理函数中校验 token 或 X-Admin-Key
+	srv.HandleFunc("/api/app_server/data_export_zip", userService.DataExportZip)
+	srv.HandleFunc("/api/admin/data_export_zip", userService.AdminDataExportZip)
 
 	//路由注册
 	route := srv.Route("/api/app_server")
@@ -55,6 +75,14 @@ func NewWhiteListMatcher() selector.MatchFunc {
 	whiteList := make(map[string]struct{})
 	whiteList["/api.user.v1.User/CreateNonce"] = struct{}{}
 	whiteList["/api.user.v1.User/EthAuthorize"] = struct{}{}
+	// 后台接口使用 X-Admin-Key
+	whiteList["/api.user.v1.User/AuditList"] = struct{}{}
+	whiteList["/api.user.v1.User/AuditVerify"] = struct{}{}
+	whiteList["/api.user.v1.User/RiskReviewList"] = struct{}{}
+	whiteList["/api.user.v1.User/RiskReview"] = struct{}{}
+	whiteList["/api.user.v1.User/AdminDataExport"] = struct{}{}
+	whiteList["/api.user.v1.User/PinKey"] = struct{}{}
+	whiteList["/api.user.v1.User/AdminAccountErase"] = struct{}{}
 	return func(ctx context.Context, operation string) bool {
 		if _, ok := whiteList[operation]; ok {
 			return false
@@ -0,0 +1,26 @@
+package server
+
+import (
+	"cardbinance/internal/conf"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const defaultMetricsAddr = "127.0.0.1:9100"
+
+// MetricsServer 单独监听内网地址导出 /metrics，不经过对外的 HTTP 服务
+type MetricsServer struct {
+	*http.Server
+}
+
+// NewMetricsServer new a metrics server.
+func NewMetricsServer(c *conf.Server) *MetricsServer {
+	addr := defaultMetricsAddr
+	if nil != c.Metrics && "" != c.Metrics.Addr {
+		addr = c.Metrics.Addr
+	}
+
+	srv := http.NewServer(http.Address(addr))
+	srv.Handle("/metrics", promhttp.Handler())
+	return &MetricsServer{Server: srv}
+}
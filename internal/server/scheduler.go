@@ -0,0 +1,109 @@
+package server
+
+import (
+	"cardbinance/internal/biz"
+	"cardbinance/internal/conf"
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/robfig/cron/v3"
+	"time"
+)
+
+const defaultLeaderTTL = 30 * time.Second
+
+// Scheduler 在应用内执行定时任务，多实例部署时通过 Redis 选出一个主节点执行
+type Scheduler struct {
+	c    *conf.Scheduler
+	sc   *biz.SchedulerUseCase
+	cron *cron.Cron
+	stop chan struct{}
+	log  *log.Helper
+}
+
+// NewScheduler new a job scheduler.
+func NewScheduler(c *conf.Scheduler, sc *biz.SchedulerUseCase, logger log.Logger) (*Scheduler, error) {
+	if nil == c {
+		c = &conf.Scheduler{}
+	}
+
+	s := &Scheduler{
+		c:    c,
+		sc:   sc,
+		stop: make(chan struct{}),
+		log:  log.NewHelper(log.With(logger, "module", "server/scheduler")),
+	}
+
+	// 上一次未结束时跳过本次，避免慢任务堆积
+	s.cron = cron.New(cron.WithChain(cron.SkipIfStillRunning(cron.DiscardLogger)))
+	for _, job := range sc.Jobs() {
+		spec := job.Spec
+		if v, ok := c.Jobs[job.Name]; ok {
+			spec = v
+		}
+		if "-" == spec {
+			continue
+		}
+
+		job := job
+		if _, err := s.cron.AddFunc(spec, func() {
+			s.sc.RunJob(context.Background(), job)
+		}); err != nil {
+			return nil, fmt.Errorf("scheduler job %s spec %q: %w", job.Name, spec, err)
+		}
+	}
+
+	return s, nil
+}
+
+func (s *Scheduler) leaderTTL() time.Duration {
+	if nil != s.c.LeaderTtl && 0 < s.c.LeaderTtl.AsDuration() {
+		return s.c.LeaderTtl.AsDuration()
+	}
+
+	return defaultLeaderTTL
+}
+
+// Start 按租期的三分之一续期，续期失败后不再执行主节点任务
+func (s *Scheduler) Start(ctx context.Context) error {
+	if s.c.Disabled {
+		s.log.Info("scheduler disabled")
+		return nil
+	}
+
+	ttl := s.leaderTTL()
+	s.sc.Campaign(ctx, ttl)
+	s.cron.Start()
+	s.log.Infow("msg", "scheduler started", "owner", s.sc.Owner(), "jobs", len(s.cron.Entries()))
+
+	ticker := time.NewTicker(ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			s.sc.Campaign(ctx, ttl)
+		}
+	}
+}
+
+// Stop 等待正在执行的任务结束后释放主节点
+func (s *Scheduler) Stop(ctx context.Context) error {
+	if s.c.Disabled {
+		return nil
+	}
+
+	close(s.stop)
+
+	select {
+	case <-s.cron.Stop().Done():
+	case <-ctx.Done():
+	}
+
+	s.sc.Resign(context.Background())
+	return nil
+}
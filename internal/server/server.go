@@ -1,8 +1,68 @@
 package server
 
 import (
+	"cardbinance/internal/conf"
+	"cardbinance/internal/pkg/middleware/ratelimit"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/metrics"
 	"github.com/google/wire"
+	"go.opentelemetry.io/otel"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer)
+var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewMetricsServer, NewScheduler, NewCodePoller, NewEventRelay)
+
+// 默认限流，划转与原先每分钟1笔一致；配置中的同名规则覆盖默认值
+var defaultRateLimitRules = map[string]ratelimit.Rule{
+	"/api.user.v1.User/AmountToCard": {Rate: 1.0 / 60, Burst: 1, Key: ratelimit.KeyUser},
+	"/api.user.v1.User/AmountTo":     {Rate: 1.0 / 6, Burst: 3, Key: ratelimit.KeyUser},
+	"/api.user.v1.User/Withdraw":     {Rate: 1.0 / 6, Burst: 3, Key: ratelimit.KeyUser},
+	"/api.user.v1.User/ChangePin":    {Rate: 1.0 / 60, Burst: 3, Key: ratelimit.KeyUser},
+	"/api.user.v1.User/EthAuthorize": {Rate: 1, Burst: 5, Key: ratelimit.KeyIP},
+}
+
+// 资金、PIN 和登录接口在 redis 不可用时拒绝请求，不受配置影响
+var failClosedOperations = map[string]struct{}{
+	"/api.user.v1.User/AmountToCard": {},
+	"/api.user.v1.User/AmountTo":     {},
+	"/api.user.v1.User/Withdraw":     {},
+	"/api.user.v1.User/ChangePin":    {},
+	"/api.user.v1.User/EthAuthorize": {},
+}
+
+// rateLimitRules 默认规则合并配置中的限流规则
+func rateLimitRules(c *conf.Server) map[string]ratelimit.Rule {
+	rules := make(map[string]ratelimit.Rule, len(defaultRateLimitRules))
+	for k, v := range defaultRateLimitRules {
+		rules[k] = v
+	}
+
+	if nil != c.RateLimit {
+		for _, v := range c.RateLimit.Rules {
+			rules[v.Operation] = ratelimit.Rule{
+				Rate:       v.Rate,
+				Burst:      v.Burst,
+				Key:        v.Key,
+				FailClosed: v.FailClosed,
+			}
+		}
+	}
+
+	for k, v := range rules {
+		if _, ok := failClosedOperations[k]; ok {
+			v.FailClosed = true
+			rules[k] = v
+		}
+	}
+
+	return rules
+}
+
+// metricsServer 接口请求数和耗时，通过 /metrics 导出
+func metricsServer() middleware.Middleware {
+	meter := otel.Meter("cardbinance")
+	requests, _ := metrics.DefaultRequestsCounter(meter, metrics.DefaultServerRequestsCounterName)
+	seconds, _ := metrics.DefaultSecondsHistogram(meter, metrics.DefaultServerSecondsHistogramName)
+
+	return metrics.Server(metrics.WithRequests(requests), metrics.WithSeconds(seconds))
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"cardbinance/internal/biz"
+	"cardbinance/internal/conf"
+	"context"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"net/http"
+	"time"
+)
+
+// CodeService 3DS/OTP 验证码推送
+type CodeService struct {
+	hub     *biz.CodeHub
+	cc      *conf.CardCode
+	ca      *conf.Auth
+	streams *Streams
+	log     *log.Helper
+}
+
+func NewCodeService(hub *biz.CodeHub, cc *conf.CardCode, ca *conf.Auth, streams *Streams, logger log.Logger) *CodeService {
+	return &CodeService{hub: hub, cc: cc, ca: ca, streams: streams, log: log.NewHelper(logger)}
+}
+
+type codeEvent struct {
+	Id        uint64 `json:"id"`
+	CardId    uint64 `json:"cardId"`
+	Code      string `json:"code"`
+	CreatedAt string `json:"createdAt"`
+	ExpiresAt string `json:"expiresAt"`
+}
+
+// Stream GET /api/app_server/code_stream
+func (s *CodeService) Stream(w http.ResponseWriter, r *http.Request) {
+	stream, ok := openStream(s.streams, s.ca, w, r)
+	if !ok {
+		return
+	}
+	defer stream.close()
+
+	sub, backlog, err := s.hub.Subscribe(stream.ctx, stream.userId, s.TTL())
+	if nil != err {
+		s.log.Errorw("msg", "验证码订阅失败", "err", err, "user_id", stream.userId)
+		http.Error(w, "查询卡片错误", http.StatusInternalServerError)
+		return
+	}
+	defer sub.Close()
+
+	if err = stream.start(); nil != err {
+		return
+	}
+
+	var lastId uint64
+	for _, v := range backlog {
+		if err = writeCode(stream, v); nil != err {
+			return
+		}
+		if v.ID > lastId {
+			lastId = v.ID
+		}
+	}
+
+	done := time.NewTimer(stream.maxAge)
+	defer done.Stop()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case v := <-sub.C:
+			// 补发的验证码在订阅后可能再次被轮询到
+			if v.ID <= lastId {
+				continue
+			}
+			if err = writeCode(stream, v); nil != err {
+				return
+			}
+		case <-heartbeat.C:
+			if err = stream.ping(); nil != err {
+				return
+			}
+		case <-done.C:
+			return
+		case <-stream.ctx.Done():
+			return
+		}
+	}
+}
+
+// TTL 验证码有效期
+func (s *CodeService) TTL() time.Duration {
+	return codeTTL(s.cc)
+}
+
+// PollInterval .
+func (s *CodeService) PollInterval() time.Duration {
+	if nil != s.cc && nil != s.cc.PollInterval && 0 < s.cc.PollInterval.AsDuration() {
+		return s.cc.PollInterval.AsDuration()
+	}
+
+	return time.Second
+}
+
+// Poll 有推送连接时读取新验证码
+func (s *CodeService) Poll(ctx context.Context) {
+	if !s.hub.HasSubscribers() {
+		return
+	}
+
+	if err := s.hub.Poll(ctx, s.TTL()); nil != err {
+		s.log.Errorw("msg", "验证码读取失败", "err", err)
+	}
+}
+
+func writeCode(stream *sseStream, v *biz.CardCode) error {
+	b, err := json.Marshal(&codeEvent{
+		Id:        v.ID,
+		CardId:    v.CardId,
+		Code:      v.Code,
+		CreatedAt: v.Time.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		ExpiresAt: v.ExpiresAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+	})
+	if nil != err {
+		return err
+	}
+
+	return stream.event(v.ID, "code", b)
+}
+
+func codeTTL(cc *conf.CardCode) time.Duration {
+	if nil != cc && nil != cc.Ttl {
+		return cc.Ttl.AsDuration()
+	}
+
+	return 0
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"cardbinance/internal/biz"
+	"cardbinance/internal/conf"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"net/http"
+	"time"
+)
+
+// EventService 账户事件推送，余额、卡片余额、卡片交易和卡片状态
+type EventService struct {
+	hub     *biz.EventHub
+	ca      *conf.Auth
+	streams *Streams
+	log     *log.Helper
+}
+
+func NewEventService(hub *biz.EventHub, ca *conf.Auth, streams *Streams, logger log.Logger) *EventService {
+	return &EventService{hub: hub, ca: ca, streams: streams, log: log.NewHelper(logger)}
+}
+
+type accountEvent struct {
+	Data json.RawMessage `json:"data"`
+	At   int64           `json:"at"`
+}
+
+// Stream GET /api/app_server/event_stream，SSE 事件名为事件类型，连接后先推送一次钱包余额
+func (s *EventService) Stream(w http.ResponseWriter, r *http.Request) {
+	stream, ok := openStream(s.streams, s.ca, w, r)
+	if !ok {
+		return
+	}
+	defer stream.close()
+
+	sub, snapshot, err := s.hub.Subscribe(stream.ctx, stream.userId)
+	if nil != err {
+		s.log.Errorw("msg", "账户事件订阅失败", "err", err, "user_id", stream.userId)
+		http.Error(w, "查询余额错误", http.StatusInternalServerError)
+		return
+	}
+	defer sub.Close()
+
+	if err = stream.start(); nil != err {
+		return
+	}
+
+	if err = writeAccountEvent(stream, snapshot); nil != err {
+		return
+	}
+
+	done := time.NewTimer(stream.maxAge)
+	defer done.Stop()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case e := <-sub.C:
+			if err = writeAccountEvent(stream, e); nil != err {
+				return
+			}
+		case <-heartbeat.C:
+			if err = stream.ping(); nil != err {
+				return
+			}
+		case <-done.C:
+			return
+		case <-stream.ctx.Done():
+			return
+		}
+	}
+}
+
+func writeAccountEvent(stream *sseStream, e *biz.AccountEvent) error {
+	b, err := json.Marshal(&accountEvent{Data: e.Data, At: e.At})
+	if nil != err {
+		return err
+	}
+
+	return stream.event(0, e.Type, b)
+}
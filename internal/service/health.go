@@ -0,0 +1,51 @@
+package service
+
+import (
+	"cardbinance/internal/biz"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"net/http"
+)
+
+type HealthService struct {
+	hc  *biz.HealthUseCase
+	log *log.Helper
+}
+
+func NewHealthService(hc *biz.HealthUseCase, logger log.Logger) *HealthService {
+	return &HealthService{hc: hc, log: log.NewHelper(logger)}
+}
+
+type healthReply struct {
+	Status string             `json:"status"`
+	Checks []*biz.HealthCheck `json:"checks,omitempty"`
+}
+
+// Shutdown .
+func (h *HealthService) Shutdown() {
+	h.hc.Shutdown()
+}
+
+// Live 进程存活即返回200，不检查依赖，避免依赖故障时被反复重启
+func (h *HealthService) Live(w http.ResponseWriter, r *http.Request) {
+	writeHealth(w, http.StatusOK, &healthReply{Status: biz.HealthStatusUp})
+}
+
+// Ready 依赖检查，未就绪时返回503
+func (h *HealthService) Ready(w http.ResponseWriter, r *http.Request) {
+	status, ready, checks := h.hc.Ready(r.Context())
+
+	code := http.StatusOK
+	if !ready {
+		code = http.StatusServiceUnavailable
+	}
+
+	writeHealth(w, code, &healthReply{Status: status, Checks: checks})
+}
+
+func writeHealth(w http.ResponseWriter, code int, reply *healthReply) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(reply)
+}
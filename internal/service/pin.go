@@ -0,0 +1,68 @@
+package service
+
+import (
+	"cardbinance/internal/biz"
+	"cardbinance/internal/conf"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"os"
+)
+
+// pinKey 读取加密PIN的RSA私钥，PKCS#1 或 PKCS#8；未配置或读取失败时不能修改PIN，除非 allow_plaintext
+func pinKey(pc *conf.Pin, l *log.Helper) *biz.PinKey {
+	if nil == pc {
+		pc = &conf.Pin{}
+	}
+
+	if "" == pc.PrivateKey {
+		if !pc.AllowPlaintext {
+			l.Warnw("msg", "未配置 pin.private_key 且未开启 allow_plaintext，不能修改PIN")
+		}
+
+		k, _ := biz.NewPinKey(nil, pc.AllowPlaintext)
+		return k
+	}
+
+	private, err := readRsaPrivateKey(pc.PrivateKey)
+	if nil == err {
+		var k *biz.PinKey
+		if k, err = biz.NewPinKey(private, pc.AllowPlaintext); nil == err {
+			return k
+		}
+	}
+
+	l.Errorw("msg", "pin.private_key 读取失败，不能修改PIN", "err", err)
+	k, _ := biz.NewPinKey(nil, pc.AllowPlaintext)
+	return k
+}
+
+func readRsaPrivateKey(path string) (*rsa.PrivateKey, error) {
+	b, err := os.ReadFile(path)
+	if nil != err {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(b)
+	if nil == block {
+		return nil, fmt.Errorf("%s: no PEM block", path)
+	}
+
+	if key, errTwo := x509.ParsePKCS1PrivateKey(block.Bytes); nil == errTwo {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if nil != err {
+		return nil, err
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%s: not an RSA private key", path)
+	}
+
+	return rsaKey, nil
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/biz"
+	"cardbinance/internal/conf"
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// exportKey 解析数据导出签名密钥，配置错误时关闭数据导出
+func exportKey(cp *conf.Privacy, l *log.Helper) ed25519.PrivateKey {
+	if nil == cp || "" == cp.ExportKey {
+		return nil
+	}
+
+	seed, err := base64.StdEncoding.DecodeString(cp.ExportKey)
+	if nil != err || ed25519.SeedSize != len(seed) {
+		l.Errorw("msg", "privacy.export_key 需要 base64 编码的32字节种子，数据导出已关闭")
+		return nil
+	}
+
+	return ed25519.NewKeyFromSeed(seed)
+}
+
+// DataExportZip GET /api/app_server/data_export_zip，鉴权同 SSE 接口，返回 data.json、签名和公钥的 zip 包
+func (u *UserService) DataExportZip(w http.ResponseWriter, r *http.Request) {
+	userId, _, ok := requestUser(u.uuc, u.ca, r)
+	if !ok {
+		http.Error(w, "无效TOKEN", http.StatusUnauthorized)
+		return
+	}
+
+	user, err := u.uuc.GetUserDataById(userId)
+	if nil != err || nil == user || 1 == user.IsDelete {
+		http.Error(w, "用户已删除", http.StatusNotFound)
+		return
+	}
+
+	u.writeExportZip(w, r, user, auditEntry(r.Context(), userId, "DataExportZip"), "")
+}
+
+// AdminDataExportZip GET /api/admin/data_export_zip?userId=，需要请求头 X-Admin-Key
+func (u *UserService) AdminDataExportZip(w http.ResponseWriter, r *http.Request) {
+	admin, ok := u.adminCheck(r.Context())
+	if !ok {
+		http.Error(w, "无权限", http.StatusForbidden)
+		return
+	}
+
+	userId, err := strconv.ParseUint(r.URL.Query().Get("userId"), 10, 64)
+	if nil != err {
+		http.Error(w, "参数错误", http.StatusBadRequest)
+		return
+	}
+
+	user, err := u.uuc.GetUserDataById(userId)
+	if nil != err || nil == user {
+		http.Error(w, "用户不存在", http.StatusNotFound)
+		return
+	}
+
+	u.writeExportZip(w, r, user, adminAuditEntry(r.Context(), admin, "AdminDataExportZip"), user.Address)
+}
+
+func (u *UserService) writeExportZip(w http.ResponseWriter, r *http.Request, user *biz.User, entry *biz.AuditLog, targetAddress string) {
+	if nil == u.exportKey {
+		http.Error(w, "暂不支持数据导出", http.StatusNotImplemented)
+		return
+	}
+
+	ctx := r.Context()
+
+	var body []byte
+	_, _ = biz.Audited(ctx, u.uuc, entry, targetAddress, &pb.AdminDataExportRequest{UserId: user.ID}, func() (*pb.DataExportReply, error) {
+		bundle, err := u.uuc.ExportPersonalData(ctx, user.ID, u.exportKey)
+		if nil != err {
+			u.log.Errorw("msg", "导出用户数据错误", "err", err, "user_id", user.ID)
+			return &pb.DataExportReply{Status: "导出错误"}, nil
+		}
+
+		if body, err = bundle.Zip(); nil != err {
+			u.log.Errorw("msg", "打包导出数据错误", "err", err, "user_id", user.ID)
+			return &pb.DataExportReply{Status: "导出错误"}, nil
+		}
+
+		return &pb.DataExportReply{Status: "ok"}, nil
+	})
+
+	if nil == body {
+		http.Error(w, "导出错误", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"data_export_%d_%s.zip\"", user.ID, time.Now().UTC().Format("20060102")))
+	w.Header().Set("Cache-Control", "no-store")
+	_, _ = w.Write(body)
+}
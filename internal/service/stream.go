@@ -0,0 +1,172 @@
+package service
+
+import (
+	"cardbinance/internal/biz"
+	"cardbinance/internal/conf"
+	"context"
+	"errors"
+	"fmt"
+	jwt2 "github.com/golang-jwt/jwt/v5"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	streamHeartbeat = 15 * time.Second
+	streamMaxAge    = time.Hour // 到期后断开，客户端按 SSE 规则自动重连
+	streamWrite     = 10 * time.Second
+)
+
+// Streams 所有 SSE 长连接的统一取消点，停服时先断开长连接，否则 http 服务关闭会一直等待连接结束
+type Streams struct {
+	uuc    *biz.UserUseCase
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func NewStreams(uuc *biz.UserUseCase) *Streams {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Streams{uuc: uuc, ctx: ctx, cancel: cancel}
+}
+
+// Shutdown 断开所有长连接，客户端按 SSE 规则重连到其他实例
+func (s *Streams) Shutdown() {
+	s.cancel()
+}
+
+type streamConnKey struct{}
+
+// StreamFilter 在服务端统一的请求超时之前记录连接的 context，长连接据此感知客户端断开
+func StreamFilter(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), streamConnKey{}, r.Context())))
+	})
+}
+
+// sseStream SSE 长连接，接口中间件不处理这类请求，在处理函数中校验 token
+type sseStream struct {
+	w      http.ResponseWriter
+	rc     *http.ResponseController
+	ctx    context.Context
+	cancel context.CancelFunc
+	userId uint64
+	maxAge time.Duration
+}
+
+// openStream 校验 token 并返回连接，失败时已写入响应，调用方结束时需要 close
+func openStream(streams *Streams, ca *conf.Auth, w http.ResponseWriter, r *http.Request) (*sseStream, bool) {
+	userId, exp, ok := requestUser(streams.uuc, ca, r)
+	if !ok {
+		http.Error(w, "无效TOKEN", http.StatusUnauthorized)
+		return nil, false
+	}
+
+	maxAge := streamMaxAge
+	if untilExp := time.Until(exp); untilExp < maxAge {
+		maxAge = untilExp
+	}
+
+	ctx, cancel := streamContext(streams, r)
+	return &sseStream{
+		w:      w,
+		rc:     http.NewResponseController(w),
+		ctx:    ctx,
+		cancel: cancel,
+		userId: userId,
+		maxAge: maxAge,
+	}, true
+}
+
+// streamContext 保留请求的值，去掉服务端统一的请求超时；客户端断开或停服时取消
+func streamContext(streams *Streams, r *http.Request) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.WithoutCancel(r.Context()))
+
+	conn, ok := r.Context().Value(streamConnKey{}).(context.Context)
+	if !ok {
+		// 没有经过 StreamFilter 时只能从请求 context 区分超时和断开，超时之后的断开由心跳写失败发现
+		conn = r.Context()
+	}
+
+	stopConn := context.AfterFunc(conn, func() {
+		if !errors.Is(conn.Err(), context.DeadlineExceeded) {
+			cancel()
+		}
+	})
+	stopShutdown := context.AfterFunc(streams.ctx, cancel)
+
+	return ctx, func() {
+		stopConn()
+		stopShutdown()
+		cancel()
+	}
+}
+
+func (s *sseStream) close() {
+	s.cancel()
+}
+
+// start 写入响应头，之后只能写事件
+func (s *sseStream) start() error {
+	s.w.Header().Set("Content-Type", "text/event-stream")
+	s.w.Header().Set("Cache-Control", "no-store")
+	s.w.Header().Set("X-Accel-Buffering", "no")
+	s.w.WriteHeader(http.StatusOK)
+
+	return s.write(": ok\n\n")
+}
+
+func (s *sseStream) event(id uint64, name string, data []byte) error {
+	if 0 < id {
+		return s.write(fmt.Sprintf("id: %d\nevent: %s\ndata: %s\n\n", id, name, data))
+	}
+
+	return s.write(fmt.Sprintf("event: %s\ndata: %s\n\n", name, data))
+}
+
+func (s *sseStream) ping() error {
+	return s.write(": ping\n\n")
+}
+
+func (s *sseStream) write(msg string) error {
+	_ = s.rc.SetWriteDeadline(time.Now().Add(streamWrite))
+	if _, err := s.w.Write([]byte(msg)); nil != err {
+		return err
+	}
+
+	return s.rc.Flush()
+}
+
+// requestUser Authorization: Bearer 与接口使用同一个 JWT；浏览器 EventSource、下载链接不能设置请求头，
+// 使用 StreamTicket 签发的一次性票据 ?ticket=，JWT 不出现在 URL 和访问日志中
+func requestUser(uuc *biz.UserUseCase, ca *conf.Auth, r *http.Request) (uint64, time.Time, bool) {
+	tokenStr := strings.TrimSpace(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+	if "" == tokenStr {
+		st, err := uuc.TakeStreamTicket(r.Context(), r.URL.Query().Get("ticket"))
+		if nil != err || nil == st {
+			return 0, time.Time{}, false
+		}
+
+		return st.UserId, time.Unix(st.ExpiresAt, 0), true
+	}
+
+	claims := jwt2.MapClaims{}
+	token, err := jwt2.ParseWithClaims(tokenStr, claims, func(token *jwt2.Token) (interface{}, error) {
+		return []byte(ca.JwtKey), nil
+	}, jwt2.WithValidMethods([]string{jwt2.SigningMethodHS256.Alg()}), jwt2.WithExpirationRequired())
+	if nil != err || !token.Valid {
+		return 0, time.Time{}, false
+	}
+
+	userId, ok := claims["UserId"].(float64)
+	if !ok || 0 >= userId {
+		return 0, time.Time{}, false
+	}
+
+	exp, err := claims.GetExpirationTime()
+	if nil != err || nil == exp {
+		return 0, time.Time{}, false
+	}
+
+	return uint64(userId), exp.Time, true
+}
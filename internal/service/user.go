@@ -5,14 +5,19 @@ import (
 	"cardbinance/internal/biz"
 	"cardbinance/internal/conf"
 	"cardbinance/internal/pkg/middleware/auth"
+	"cardbinance/internal/pkg/realip"
 	"context"
+	"crypto/ed25519"
+	"crypto/subtle"
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
 	transporthttp "github.com/go-kratos/kratos/v2/transport/http"
 	jwt2 "github.com/golang-jwt/jwt/v5"
+	"google.golang.org/protobuf/proto"
 	"regexp"
 	"strings"
 	"time"
@@ -24,10 +29,16 @@ type UserService struct {
 	uuc *biz.UserUseCase
 	log *log.Helper
 	ca  *conf.Auth
+	cc  *conf.CardCode
+	cw  *conf.Withdraw
+
+	exportKey ed25519.PrivateKey // 数据导出签名密钥，未配置时为 nil
+	pinKey    *biz.PinKey
 }
 
-func NewUserService(uuc *biz.UserUseCase, logger log.Logger, ca *conf.Auth) *UserService {
-	return &UserService{uuc: uuc, log: log.NewHelper(logger), ca: ca}
+func NewUserService(uuc *biz.UserUseCase, logger log.Logger, ca *conf.Auth, cc *conf.CardCode, cw *conf.Withdraw, cp *conf.Privacy, pc *conf.Pin) *UserService {
+	l := log.NewHelper(logger)
+	return &UserService{uuc: uuc, log: l, ca: ca, cc: cc, cw: cw, exportKey: exportKey(cp, l), pinKey: pinKey(pc, l)}
 }
 
 func (u *UserService) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserReply, error) {
@@ -139,84 +150,562 @@ func (u *UserService) CodeList(ctx context.Context, req *pb.CodeListRequest) (*p
 		userId = uint64(c["UserId"].(float64))
 	}
 
-	return u.uuc.CodeList(ctx, req, userId)
+	return u.uuc.CodeList(ctx, req, userId, codeTTL(u.cc))
+}
+
+// CardList cardList.
+func (u *UserService) CardList(ctx context.Context, req *pb.CardListRequest) (*pb.CardListReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId uint64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return &pb.CardListReply{
+				Status: "无效TOKEN",
+			}, nil
+		}
+
+		userId = uint64(c["UserId"].(float64))
+	}
+
+	return u.uuc.CardList(ctx, req, userId)
+}
+
+func (u *UserService) FeeQuote(ctx context.Context, req *pb.FeeQuoteRequest) (*pb.FeeQuoteReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId uint64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return &pb.FeeQuoteReply{
+				Status: "无效TOKEN",
+			}, nil
+		}
+
+		userId = uint64(c["UserId"].(float64))
+	}
+
+	return u.uuc.FeeQuote(ctx, req, userId)
 }
 
 // CreateNonce createNonce.
-func (u *UserService) CreateNonce(ctx context.Context, req *pb.CreateNonceRequest) (*pb.CreateNonceReply, error) {
-	userAddress := req.SendBody.Address // 以太坊账户
+func (u *UserService) NotifyList(ctx context.Context, req *pb.NotifyListRequest) (*pb.NotifyListReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId uint64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return &pb.NotifyListReply{
+				Status: "无效TOKEN",
+			}, nil
+		}
 
-	if "" == userAddress || 20 > len(userAddress) ||
-		strings.EqualFold("0x000000000000000000000000000000000000dead", userAddress) {
-		return &pb.CreateNonceReply{
-			Nonce:  "",
-			Status: "账户地址参数错误",
+		userId = uint64(c["UserId"].(float64))
+	}
+
+	return u.uuc.NotifyList(ctx, req, userId)
+}
+
+func (u *UserService) NotifyRead(ctx context.Context, req *pb.NotifyReadRequest) (*pb.NotifyReadReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId uint64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return &pb.NotifyReadReply{
+				Status: "无效TOKEN",
+			}, nil
+		}
+
+		userId = uint64(c["UserId"].(float64))
+	}
+
+	return u.uuc.NotifyRead(ctx, req, userId)
+}
+
+func (u *UserService) NotifySetting(ctx context.Context, req *pb.NotifySettingRequest) (*pb.NotifySettingReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId uint64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return &pb.NotifySettingReply{
+				Status: "无效TOKEN",
+			}, nil
+		}
+
+		userId = uint64(c["UserId"].(float64))
+	}
+
+	return u.uuc.NotifySetting(ctx, req, userId)
+}
+
+func (u *UserService) SetNotifySetting(ctx context.Context, req *pb.SetNotifySettingRequest) (*pb.SetNotifySettingReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId uint64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return &pb.SetNotifySettingReply{
+				Status: "无效TOKEN",
+			}, nil
+		}
+
+		userId = uint64(c["UserId"].(float64))
+	}
+
+	return biz.Audited(ctx, u.uuc, auditEntry(ctx, userId, "SetNotifySetting"), "", req, func() (*pb.SetNotifySettingReply, error) {
+		return u.uuc.SetNotifySetting(ctx, req, userId)
+	})
+}
+
+func (u *UserService) StreamTicket(ctx context.Context, req *pb.StreamTicketRequest) (*pb.StreamTicketReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var (
+		userId    uint64
+		expiresAt time.Time
+	)
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return &pb.StreamTicketReply{
+				Status: "无效TOKEN",
+			}, nil
+		}
+
+		userId = uint64(c["UserId"].(float64))
+
+		// 推送连接不能比 JWT 活得更久
+		exp, err := c.GetExpirationTime()
+		if nil != err || nil == exp {
+			return &pb.StreamTicketReply{
+				Status: "无效TOKEN",
+			}, nil
+		}
+		expiresAt = exp.Time
+	}
+
+	return u.uuc.StreamTicket(ctx, req, userId, expiresAt)
+}
+
+func (u *UserService) TotpStatus(ctx context.Context, req *pb.TotpStatusRequest) (*pb.TotpStatusReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId uint64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return &pb.TotpStatusReply{
+				Status: "无效TOKEN",
+			}, nil
+		}
+
+		userId = uint64(c["UserId"].(float64))
+	}
+
+	return u.uuc.TotpStatus(ctx, req, userId)
+}
+
+func (u *UserService) TotpSetup(ctx context.Context, req *pb.TotpSetupRequest) (*pb.TotpSetupReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var (
+		err    error
+		userId uint64
+	)
+
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return &pb.TotpSetupReply{
+				Status: "无效TOKEN",
+			}, nil
+		}
+
+		userId = uint64(c["UserId"].(float64))
+	}
+
+	var (
+		user *biz.User
+	)
+	user, err = u.uuc.GetUserDataById(userId)
+	if nil != err {
+		return &pb.TotpSetupReply{
+			Status: "无效TOKEN",
+		}, nil
+	}
+
+	if 1 == user.IsDelete {
+		return &pb.TotpSetupReply{
+			Status: "用户已删除",
 		}, nil
 	}
 
-	// 验证
 	var (
-		res bool
-		err error
+		res             bool
+		addressFromSign string
+	)
+	if 10 >= len(req.SendBody.Sign) {
+		return &pb.TotpSetupReply{
+			Status: "签名错误",
+		}, nil
+	}
+	var (
+		contentStr string
 	)
 
-	res, err = addressCheck(userAddress)
+	contentStr, err = u.uuc.GetAddressNonce(ctx, user.Address)
 	if nil != err {
-		return &pb.CreateNonceReply{
-			Nonce:  "",
-			Status: "地址验证失败",
+		return &pb.TotpSetupReply{
+			Status: "错误",
 		}, nil
 	}
-	if !res {
-		return &pb.CreateNonceReply{
-			Nonce:  "",
-			Status: "地址验证失败",
+	if 0 >= len(contentStr) {
+		return &pb.TotpSetupReply{
+			Status: "错误nonce",
+		}, nil
+	}
+	content := []byte(contentStr)
+
+	res, addressFromSign = verifySig(req.SendBody.Sign, content)
+	if !res || addressFromSign != user.Address {
+		return &pb.TotpSetupReply{
+			Status: "签名错误",
 		}, nil
 	}
 
-	return u.uuc.CreateNonce(ctx, req)
+	return biz.Audited(ctx, u.uuc, auditEntry(ctx, userId, "TotpSetup"), "", req, func() (*pb.TotpSetupReply, error) {
+		return u.uuc.TotpSetup(ctx, req, userId)
+	})
 }
 
-// EthAuthorize ethAuthorize.
-func (u *UserService) EthAuthorize(ctx context.Context, req *pb.EthAuthorizeRequest) (*pb.EthAuthorizeReply, error) {
-	userAddress := req.SendBody.Address // 以太坊账户
+func (u *UserService) TotpEnable(ctx context.Context, req *pb.TotpEnableRequest) (*pb.TotpEnableReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var (
+		err    error
+		userId uint64
+	)
+
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return &pb.TotpEnableReply{
+				Status: "无效TOKEN",
+			}, nil
+		}
+
+		userId = uint64(c["UserId"].(float64))
+	}
+
+	var (
+		user *biz.User
+	)
+	user, err = u.uuc.GetUserDataById(userId)
+	if nil != err {
+		return &pb.TotpEnableReply{
+			Status: "无效TOKEN",
+		}, nil
+	}
+
+	if 1 == user.IsDelete {
+		return &pb.TotpEnableReply{
+			Status: "用户已删除",
+		}, nil
+	}
 
+	var (
+		res             bool
+		addressFromSign string
+	)
 	if 10 >= len(req.SendBody.Sign) {
-		return &pb.EthAuthorizeReply{
-			Token:  "",
+		return &pb.TotpEnableReply{
 			Status: "签名错误",
 		}, nil
 	}
-
-	// 验证
 	var (
-		res  bool
-		err  error
-		user *biz.User
-		msg  string
+		contentStr string
 	)
 
-	res, err = addressCheck(userAddress)
+	contentStr, err = u.uuc.GetAddressNonce(ctx, user.Address)
 	if nil != err {
-		return &pb.EthAuthorizeReply{
-			Token:  "",
-			Status: "地址验证失败",
+		return &pb.TotpEnableReply{
+			Status: "错误",
 		}, nil
 	}
-	if !res {
-		return &pb.EthAuthorizeReply{
-			Token:  "",
-			Status: "地址格式错误",
+	if 0 >= len(contentStr) {
+		return &pb.TotpEnableReply{
+			Status: "错误nonce",
 		}, nil
 	}
+	content := []byte(contentStr)
+
+	res, addressFromSign = verifySig(req.SendBody.Sign, content)
+	if !res || addressFromSign != user.Address {
+		return &pb.TotpEnableReply{
+			Status: "签名错误",
+		}, nil
+	}
+
+	return biz.Audited(ctx, u.uuc, auditEntry(ctx, userId, "TotpEnable"), "", req, func() (*pb.TotpEnableReply, error) {
+		return u.uuc.TotpEnable(ctx, req, userId)
+	})
+}
 
+func (u *UserService) TotpDisable(ctx context.Context, req *pb.TotpDisableRequest) (*pb.TotpDisableReply, error) {
+	// 在上下文 context 中取出 claims 对象
 	var (
-		addressFromSign string
-		content         = []byte(userAddress)
+		err    error
+		userId uint64
 	)
 
-	//var (
-	//	contentStr string
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return &pb.TotpDisableReply{
+				Status: "无效TOKEN",
+			}, nil
+		}
+
+		userId = uint64(c["UserId"].(float64))
+	}
+
+	var (
+		user *biz.User
+	)
+	user, err = u.uuc.GetUserDataById(userId)
+	if nil != err {
+		return &pb.TotpDisableReply{
+			Status: "无效TOKEN",
+		}, nil
+	}
+
+	if 1 == user.IsDelete {
+		return &pb.TotpDisableReply{
+			Status: "用户已删除",
+		}, nil
+	}
+
+	var (
+		res             bool
+		addressFromSign string
+	)
+	if 10 >= len(req.SendBody.Sign) {
+		return &pb.TotpDisableReply{
+			Status: "签名错误",
+		}, nil
+	}
+	var (
+		contentStr string
+	)
+
+	contentStr, err = u.uuc.GetAddressNonce(ctx, user.Address)
+	if nil != err {
+		return &pb.TotpDisableReply{
+			Status: "错误",
+		}, nil
+	}
+	if 0 >= len(contentStr) {
+		return &pb.TotpDisableReply{
+			Status: "错误nonce",
+		}, nil
+	}
+	content := []byte(contentStr)
+
+	res, addressFromSign = verifySig(req.SendBody.Sign, content)
+	if !res || addressFromSign != user.Address {
+		return &pb.TotpDisableReply{
+			Status: "签名错误",
+		}, nil
+	}
+
+	return biz.Audited(ctx, u.uuc, auditEntry(ctx, userId, "TotpDisable"), "", req, func() (*pb.TotpDisableReply, error) {
+		return u.uuc.TotpDisable(ctx, req, userId)
+	})
+}
+
+func (u *UserService) DataExport(ctx context.Context, req *pb.DataExportRequest) (*pb.DataExportReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var (
+		err    error
+		userId uint64
+	)
+
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return &pb.DataExportReply{
+				Status: "无效TOKEN",
+			}, nil
+		}
+
+		userId = uint64(c["UserId"].(float64))
+	}
+
+	var (
+		user *biz.User
+	)
+	user, err = u.uuc.GetUserDataById(userId)
+	if nil != err {
+		return &pb.DataExportReply{
+			Status: "无效TOKEN",
+		}, nil
+	}
+
+	if 1 == user.IsDelete {
+		return &pb.DataExportReply{
+			Status: "用户已删除",
+		}, nil
+	}
+
+	return biz.Audited(ctx, u.uuc, auditEntry(ctx, userId, "DataExport"), "", req, func() (*pb.DataExportReply, error) {
+		return u.uuc.DataExport(ctx, userId, u.exportKey)
+	})
+}
+
+func (u *UserService) AccountErase(ctx context.Context, req *pb.AccountEraseRequest) (*pb.AccountEraseReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var (
+		err    error
+		userId uint64
+	)
+
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return &pb.AccountEraseReply{
+				Status: "无效TOKEN",
+			}, nil
+		}
+
+		userId = uint64(c["UserId"].(float64))
+	}
+
+	var (
+		user *biz.User
+	)
+	user, err = u.uuc.GetUserDataById(userId)
+	if nil != err {
+		return &pb.AccountEraseReply{
+			Status: "无效TOKEN",
+		}, nil
+	}
+
+	if 1 == user.IsDelete {
+		return &pb.AccountEraseReply{
+			Status: "用户已删除",
+		}, nil
+	}
+
+	var (
+		res             bool
+		addressFromSign string
+	)
+	if 10 >= len(req.SendBody.Sign) {
+		return &pb.AccountEraseReply{
+			Status: "签名错误",
+		}, nil
+	}
+	var (
+		contentStr string
+	)
+
+	contentStr, err = u.uuc.GetAddressNonce(ctx, user.Address)
+	if nil != err {
+		return &pb.AccountEraseReply{
+			Status: "错误",
+		}, nil
+	}
+	if 0 >= len(contentStr) {
+		return &pb.AccountEraseReply{
+			Status: "错误nonce",
+		}, nil
+	}
+	content := []byte(contentStr)
+
+	res, addressFromSign = verifySig(req.SendBody.Sign, content)
+	if !res || addressFromSign != user.Address {
+		return &pb.AccountEraseReply{
+			Status: "签名错误",
+		}, nil
+	}
+
+	return biz.Audited(ctx, u.uuc, auditEntry(ctx, userId, "AccountErase"), "", req, func() (*pb.AccountEraseReply, error) {
+		return u.uuc.AccountErase(ctx, req, userId)
+	})
+}
+
+func (u *UserService) CreateNonce(ctx context.Context, req *pb.CreateNonceRequest) (*pb.CreateNonceReply, error) {
+	userAddress := req.SendBody.Address // 以太坊账户
+
+	if "" == userAddress || 20 > len(userAddress) ||
+		strings.EqualFold("0x000000000000000000000000000000000000dead", userAddress) {
+		return &pb.CreateNonceReply{
+			Nonce:  "",
+			Status: "账户地址参数错误",
+		}, nil
+	}
+
+	// 验证
+	var (
+		res bool
+		err error
+	)
+
+	res, err = addressCheck(userAddress)
+	if nil != err {
+		return &pb.CreateNonceReply{
+			Nonce:  "",
+			Status: "地址验证失败",
+		}, nil
+	}
+	if !res {
+		return &pb.CreateNonceReply{
+			Nonce:  "",
+			Status: "地址验证失败",
+		}, nil
+	}
+
+	return u.uuc.CreateNonce(ctx, req)
+}
+
+// EthAuthorize ethAuthorize.
+func (u *UserService) EthAuthorize(ctx context.Context, req *pb.EthAuthorizeRequest) (*pb.EthAuthorizeReply, error) {
+	userAddress := req.SendBody.Address // 以太坊账户
+
+	if 10 >= len(req.SendBody.Sign) {
+		return &pb.EthAuthorizeReply{
+			Token:  "",
+			Status: "签名错误",
+		}, nil
+	}
+
+	// 验证
+	var (
+		res  bool
+		err  error
+		user *biz.User
+		msg  string
+	)
+
+	res, err = addressCheck(userAddress)
+	if nil != err {
+		return &pb.EthAuthorizeReply{
+			Token:  "",
+			Status: "地址验证失败",
+		}, nil
+	}
+	if !res {
+		return &pb.EthAuthorizeReply{
+			Token:  "",
+			Status: "地址格式错误",
+		}, nil
+	}
+
+	var (
+		addressFromSign string
+		content         = []byte(userAddress)
+	)
+
+	//var (
+	//	contentStr string
 	//)
 	//contentStr, err = u.uuc.GetAddressNonce(ctx, userAddress)
 	//if nil != err {
@@ -241,50 +730,283 @@ func (u *UserService) EthAuthorize(ctx context.Context, req *pb.EthAuthorizeRequ
 		}, nil
 	}
 
-	// 根据地址查询用户，不存在时则创建
-	user, err, msg = u.uuc.GetExistUserByAddressOrCreate(ctx, &biz.User{
-		Address: userAddress,
-	}, req)
-	if nil == user || nil != err {
-		return &pb.EthAuthorizeReply{
-			Token:  "",
-			Status: msg,
+	// 根据地址查询用户，不存在时则创建
+	user, err, msg = u.uuc.GetExistUserByAddressOrCreate(ctx, &biz.User{
+		Address: userAddress,
+	}, req)
+	if nil == user || nil != err {
+		return &pb.EthAuthorizeReply{
+			Token:  "",
+			Status: msg,
+		}, nil
+	}
+
+	if 1 == user.IsDelete {
+		return &pb.EthAuthorizeReply{
+			Token:  "",
+			Status: "用户已禁用",
+		}, nil
+	}
+
+	claims := auth.CustomClaims{
+		UserId:   user.ID,
+		UserType: "user",
+		RegisteredClaims: jwt2.RegisteredClaims{
+			NotBefore: jwt2.NewNumericDate(time.Now()),                      // 签名的生效时间
+			ExpiresAt: jwt2.NewNumericDate(time.Now().Add(100 * time.Hour)), // 2天过期
+			Issuer:    "user",
+		},
+	}
+	token, err := auth.CreateToken(claims, u.ca.JwtKey)
+	if err != nil {
+		return &pb.EthAuthorizeReply{
+			Token:  token,
+			Status: "生成token失败",
+		}, nil
+	}
+
+	userInfoRsp := pb.EthAuthorizeReply{
+		Token:  token,
+		Status: "ok",
+	}
+
+	return &userInfoRsp, nil
+}
+
+func (u *UserService) SetVip(ctx context.Context, req *pb.SetVipRequest) (*pb.SetVipReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var (
+		err    error
+		userId uint64
+	)
+
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return &pb.SetVipReply{
+				Status: "无效TOKEN",
+			}, nil
+		}
+
+		userId = uint64(c["UserId"].(float64))
+	}
+
+	var (
+		user *biz.User
+	)
+	user, err = u.uuc.GetUserDataById(userId)
+	if nil != err {
+		return &pb.SetVipReply{
+			Status: "无效TOKEN",
+		}, nil
+	}
+
+	if 1 == user.IsDelete {
+		return &pb.SetVipReply{
+			Status: "用户已删除",
+		}, nil
+	}
+
+	var (
+		res             bool
+		addressFromSign string
+	)
+	if 10 >= len(req.SendBody.Sign) {
+		return &pb.SetVipReply{
+			Status: "签名错误",
+		}, nil
+	}
+
+	var (
+		contentStr string
+	)
+	contentStr, err = u.uuc.GetAddressNonce(ctx, user.Address)
+	if nil != err {
+		return &pb.SetVipReply{
+			Status: "错误",
+		}, nil
+	}
+	if 0 >= len(contentStr) {
+		return &pb.SetVipReply{
+			Status: "错误nonce",
+		}, nil
+	}
+	content := []byte(contentStr)
+
+	res, addressFromSign = verifySig(req.SendBody.Sign, content)
+	if !res || addressFromSign != user.Address {
+		return &pb.SetVipReply{
+			Status: "签名错误",
+		}, nil
+	}
+
+	if status := u.uuc.CheckTotp(ctx, userId, req.SendBody.TotpCode); "" != status {
+		return &pb.SetVipReply{
+			Status: status,
+		}, nil
+	}
+
+	return biz.Audited(ctx, u.uuc, auditEntry(ctx, userId, "SetVip"), req.SendBody.Address, req, func() (*pb.SetVipReply, error) {
+		return u.uuc.SetVip(ctx, req, userId)
+	})
+}
+
+func (u *UserService) OpenCard(ctx context.Context, req *pb.OpenCardRequest) (*pb.OpenCardReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var (
+		err    error
+		userId uint64
+	)
+
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return &pb.OpenCardReply{
+				Status: "无效TOKEN",
+			}, nil
+		}
+
+		userId = uint64(c["UserId"].(float64))
+	}
+
+	var (
+		user *biz.User
+	)
+	user, err = u.uuc.GetUserDataById(userId)
+	if nil != err {
+		return &pb.OpenCardReply{
+			Status: "无效TOKEN",
+		}, nil
+	}
+
+	if 1 == user.IsDelete {
+		return &pb.OpenCardReply{
+			Status: "用户已删除",
+		}, nil
+	}
+
+	var (
+		res             bool
+		addressFromSign string
+	)
+	if 10 >= len(req.SendBody.Sign) {
+		return &pb.OpenCardReply{
+			Status: "签名错误",
+		}, nil
+	}
+
+	var (
+		contentStr string
+	)
+	// 重试请求的 nonce 已被第一次消耗，先按 Idempotency-Key 返回已有结果
+	if reply, ok := biz.IdempotentReplay(ctx, u.uuc, userId, idempotencyKey(ctx), "OpenCard", req, func(status string) *pb.OpenCardReply {
+		return &pb.OpenCardReply{Status: status}
+	}); ok {
+		return reply, nil
+	}
+
+	contentStr, err = u.uuc.GetAddressNonce(ctx, user.Address)
+	if nil != err {
+		return &pb.OpenCardReply{
+			Status: "错误",
+		}, nil
+	}
+	if 0 >= len(contentStr) {
+		return &pb.OpenCardReply{
+			Status: "错误nonce",
+		}, nil
+	}
+	content := []byte(contentStr)
+
+	res, addressFromSign = verifySig(req.SendBody.Sign, content)
+	if !res || addressFromSign != user.Address {
+		return &pb.OpenCardReply{
+			Status: "签名错误",
+		}, nil
+	}
+
+	return biz.Idempotent(ctx, u.uuc, userId, idempotencyKey(ctx), "OpenCard", req, func() (*pb.OpenCardReply, error) {
+		return biz.Audited(ctx, u.uuc, auditEntry(ctx, userId, "OpenCard"), "", req, func() (*pb.OpenCardReply, error) {
+			return u.uuc.OpenCard(ctx, req, userId)
+		})
+	}, func(status string) *pb.OpenCardReply {
+		return &pb.OpenCardReply{Status: status}
+	})
+}
+
+func (u *UserService) CheckCard(ctx context.Context, req *pb.CheckCardRequest) (*pb.CheckCardReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var (
+		err    error
+		userId uint64
+	)
+
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return &pb.CheckCardReply{
+				Status: "无效TOKEN",
+			}, nil
+		}
+
+		userId = uint64(c["UserId"].(float64))
+	}
+
+	var (
+		user *biz.User
+	)
+	user, err = u.uuc.GetUserDataById(userId)
+	if nil != err {
+		return &pb.CheckCardReply{
+			Status: "无效TOKEN",
+		}, nil
+	}
+
+	if 1 == user.IsDelete {
+		return &pb.CheckCardReply{
+			Status: "用户已删除",
 		}, nil
 	}
 
-	if 1 == user.IsDelete {
-		return &pb.EthAuthorizeReply{
-			Token:  "",
-			Status: "用户已禁用",
+	var (
+		res             bool
+		addressFromSign string
+	)
+	if 10 >= len(req.SendBody.Sign) {
+		return &pb.CheckCardReply{
+			Status: "签名错误",
 		}, nil
 	}
 
-	claims := auth.CustomClaims{
-		UserId:   user.ID,
-		UserType: "user",
-		RegisteredClaims: jwt2.RegisteredClaims{
-			NotBefore: jwt2.NewNumericDate(time.Now()),                      // 签名的生效时间
-			ExpiresAt: jwt2.NewNumericDate(time.Now().Add(100 * time.Hour)), // 2天过期
-			Issuer:    "user",
-		},
+	var (
+		contentStr string
+	)
+	contentStr, err = u.uuc.GetAddressNonce(ctx, user.Address)
+	if nil != err {
+		return &pb.CheckCardReply{
+			Status: "错误",
+		}, nil
 	}
-	token, err := auth.CreateToken(claims, u.ca.JwtKey)
-	if err != nil {
-		return &pb.EthAuthorizeReply{
-			Token:  token,
-			Status: "生成token失败",
+	if 0 >= len(contentStr) {
+		return &pb.CheckCardReply{
+			Status: "错误nonce",
 		}, nil
 	}
+	content := []byte(contentStr)
 
-	userInfoRsp := pb.EthAuthorizeReply{
-		Token:  token,
-		Status: "ok",
+	res, addressFromSign = verifySig(req.SendBody.Sign, content)
+	if !res || addressFromSign != user.Address {
+		return &pb.CheckCardReply{
+			Status: "签名错误",
+		}, nil
 	}
 
-	return &userInfoRsp, nil
+	return biz.Audited(ctx, u.uuc, auditEntry(ctx, userId, "CheckCard"), "", req, func() (*pb.CheckCardReply, error) {
+		return u.uuc.CheckCard(ctx, req, userId)
+	})
 }
 
-func (u *UserService) SetVip(ctx context.Context, req *pb.SetVipRequest) (*pb.SetVipReply, error) {
+func (u *UserService) OpenCardTwo(ctx context.Context, req *pb.OpenCardRequest) (*pb.OpenCardReply, error) {
 	// 在上下文 context 中取出 claims 对象
 	var (
 		err    error
@@ -294,7 +1016,7 @@ func (u *UserService) SetVip(ctx context.Context, req *pb.SetVipRequest) (*pb.Se
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return &pb.SetVipReply{
+			return &pb.OpenCardReply{
 				Status: "无效TOKEN",
 			}, nil
 		}
@@ -307,13 +1029,13 @@ func (u *UserService) SetVip(ctx context.Context, req *pb.SetVipRequest) (*pb.Se
 	)
 	user, err = u.uuc.GetUserDataById(userId)
 	if nil != err {
-		return &pb.SetVipReply{
+		return &pb.OpenCardReply{
 			Status: "无效TOKEN",
 		}, nil
 	}
 
 	if 1 == user.IsDelete {
-		return &pb.SetVipReply{
+		return &pb.OpenCardReply{
 			Status: "用户已删除",
 		}, nil
 	}
@@ -323,7 +1045,7 @@ func (u *UserService) SetVip(ctx context.Context, req *pb.SetVipRequest) (*pb.Se
 		addressFromSign string
 	)
 	if 10 >= len(req.SendBody.Sign) {
-		return &pb.SetVipReply{
+		return &pb.OpenCardReply{
 			Status: "签名错误",
 		}, nil
 	}
@@ -331,14 +1053,21 @@ func (u *UserService) SetVip(ctx context.Context, req *pb.SetVipRequest) (*pb.Se
 	var (
 		contentStr string
 	)
+	// 重试请求的 nonce 已被第一次消耗，先按 Idempotency-Key 返回已有结果
+	if reply, ok := biz.IdempotentReplay(ctx, u.uuc, userId, idempotencyKey(ctx), "OpenCardTwo", req, func(status string) *pb.OpenCardReply {
+		return &pb.OpenCardReply{Status: status}
+	}); ok {
+		return reply, nil
+	}
+
 	contentStr, err = u.uuc.GetAddressNonce(ctx, user.Address)
 	if nil != err {
-		return &pb.SetVipReply{
+		return &pb.OpenCardReply{
 			Status: "错误",
 		}, nil
 	}
 	if 0 >= len(contentStr) {
-		return &pb.SetVipReply{
+		return &pb.OpenCardReply{
 			Status: "错误nonce",
 		}, nil
 	}
@@ -346,15 +1075,21 @@ func (u *UserService) SetVip(ctx context.Context, req *pb.SetVipRequest) (*pb.Se
 
 	res, addressFromSign = verifySig(req.SendBody.Sign, content)
 	if !res || addressFromSign != user.Address {
-		return &pb.SetVipReply{
+		return &pb.OpenCardReply{
 			Status: "签名错误",
 		}, nil
 	}
 
-	return u.uuc.SetVip(ctx, req, userId)
+	return biz.Idempotent(ctx, u.uuc, userId, idempotencyKey(ctx), "OpenCardTwo", req, func() (*pb.OpenCardReply, error) {
+		return biz.Audited(ctx, u.uuc, auditEntry(ctx, userId, "OpenCardTwo"), "", req, func() (*pb.OpenCardReply, error) {
+			return u.uuc.OpenCardTwo(ctx, req, userId)
+		})
+	}, func(status string) *pb.OpenCardReply {
+		return &pb.OpenCardReply{Status: status}
+	})
 }
 
-func (u *UserService) OpenCard(ctx context.Context, req *pb.OpenCardRequest) (*pb.OpenCardReply, error) {
+func (u *UserService) AmountToCard(ctx context.Context, req *pb.AmountToCardRequest) (*pb.AmountToCardReply, error) {
 	// 在上下文 context 中取出 claims 对象
 	var (
 		err    error
@@ -364,7 +1099,7 @@ func (u *UserService) OpenCard(ctx context.Context, req *pb.OpenCardRequest) (*p
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return &pb.OpenCardReply{
+			return &pb.AmountToCardReply{
 				Status: "无效TOKEN",
 			}, nil
 		}
@@ -377,13 +1112,13 @@ func (u *UserService) OpenCard(ctx context.Context, req *pb.OpenCardRequest) (*p
 	)
 	user, err = u.uuc.GetUserDataById(userId)
 	if nil != err {
-		return &pb.OpenCardReply{
+		return &pb.AmountToCardReply{
 			Status: "无效TOKEN",
 		}, nil
 	}
 
 	if 1 == user.IsDelete {
-		return &pb.OpenCardReply{
+		return &pb.AmountToCardReply{
 			Status: "用户已删除",
 		}, nil
 	}
@@ -393,7 +1128,7 @@ func (u *UserService) OpenCard(ctx context.Context, req *pb.OpenCardRequest) (*p
 		addressFromSign string
 	)
 	if 10 >= len(req.SendBody.Sign) {
-		return &pb.OpenCardReply{
+		return &pb.AmountToCardReply{
 			Status: "签名错误",
 		}, nil
 	}
@@ -401,14 +1136,21 @@ func (u *UserService) OpenCard(ctx context.Context, req *pb.OpenCardRequest) (*p
 	var (
 		contentStr string
 	)
+	// 重试请求的 nonce 已被第一次消耗，先按 Idempotency-Key 返回已有结果
+	if reply, ok := biz.IdempotentReplay(ctx, u.uuc, userId, idempotencyKey(ctx), "AmountToCard", req, func(status string) *pb.AmountToCardReply {
+		return &pb.AmountToCardReply{Status: status}
+	}); ok {
+		return reply, nil
+	}
+
 	contentStr, err = u.uuc.GetAddressNonce(ctx, user.Address)
 	if nil != err {
-		return &pb.OpenCardReply{
+		return &pb.AmountToCardReply{
 			Status: "错误",
 		}, nil
 	}
 	if 0 >= len(contentStr) {
-		return &pb.OpenCardReply{
+		return &pb.AmountToCardReply{
 			Status: "错误nonce",
 		}, nil
 	}
@@ -416,15 +1158,21 @@ func (u *UserService) OpenCard(ctx context.Context, req *pb.OpenCardRequest) (*p
 
 	res, addressFromSign = verifySig(req.SendBody.Sign, content)
 	if !res || addressFromSign != user.Address {
-		return &pb.OpenCardReply{
+		return &pb.AmountToCardReply{
 			Status: "签名错误",
 		}, nil
 	}
 
-	return u.uuc.OpenCard(ctx, req, userId)
+	return biz.Idempotent(ctx, u.uuc, userId, idempotencyKey(ctx), "AmountToCard", req, func() (*pb.AmountToCardReply, error) {
+		return biz.Audited(ctx, u.uuc, auditEntry(ctx, userId, "AmountToCard"), "", req, func() (*pb.AmountToCardReply, error) {
+			return u.uuc.AmountToCard(ctx, req, userId)
+		})
+	}, func(status string) *pb.AmountToCardReply {
+		return &pb.AmountToCardReply{Status: status}
+	})
 }
 
-func (u *UserService) CheckCard(ctx context.Context, req *pb.CheckCardRequest) (*pb.CheckCardReply, error) {
+func (u *UserService) AmountTo(ctx context.Context, req *pb.AmountToRequest) (*pb.AmountToReply, error) {
 	// 在上下文 context 中取出 claims 对象
 	var (
 		err    error
@@ -434,7 +1182,7 @@ func (u *UserService) CheckCard(ctx context.Context, req *pb.CheckCardRequest) (
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return &pb.CheckCardReply{
+			return &pb.AmountToReply{
 				Status: "无效TOKEN",
 			}, nil
 		}
@@ -447,13 +1195,13 @@ func (u *UserService) CheckCard(ctx context.Context, req *pb.CheckCardRequest) (
 	)
 	user, err = u.uuc.GetUserDataById(userId)
 	if nil != err {
-		return &pb.CheckCardReply{
+		return &pb.AmountToReply{
 			Status: "无效TOKEN",
 		}, nil
 	}
 
 	if 1 == user.IsDelete {
-		return &pb.CheckCardReply{
+		return &pb.AmountToReply{
 			Status: "用户已删除",
 		}, nil
 	}
@@ -463,22 +1211,29 @@ func (u *UserService) CheckCard(ctx context.Context, req *pb.CheckCardRequest) (
 		addressFromSign string
 	)
 	if 10 >= len(req.SendBody.Sign) {
-		return &pb.CheckCardReply{
+		return &pb.AmountToReply{
 			Status: "签名错误",
 		}, nil
 	}
-
 	var (
 		contentStr string
 	)
+
+	// 重试请求的 nonce 已被第一次消耗，先按 Idempotency-Key 返回已有结果
+	if reply, ok := biz.IdempotentReplay(ctx, u.uuc, userId, idempotencyKey(ctx), "AmountTo", req, func(status string) *pb.AmountToReply {
+		return &pb.AmountToReply{Status: status}
+	}); ok {
+		return reply, nil
+	}
+
 	contentStr, err = u.uuc.GetAddressNonce(ctx, user.Address)
 	if nil != err {
-		return &pb.CheckCardReply{
+		return &pb.AmountToReply{
 			Status: "错误",
 		}, nil
 	}
 	if 0 >= len(contentStr) {
-		return &pb.CheckCardReply{
+		return &pb.AmountToReply{
 			Status: "错误nonce",
 		}, nil
 	}
@@ -486,15 +1241,27 @@ func (u *UserService) CheckCard(ctx context.Context, req *pb.CheckCardRequest) (
 
 	res, addressFromSign = verifySig(req.SendBody.Sign, content)
 	if !res || addressFromSign != user.Address {
-		return &pb.CheckCardReply{
+		return &pb.AmountToReply{
 			Status: "签名错误",
 		}, nil
 	}
 
-	return u.uuc.CheckCard(ctx, req, userId)
+	if status := u.uuc.CheckTotp(ctx, userId, req.SendBody.TotpCode); "" != status {
+		return &pb.AmountToReply{
+			Status: status,
+		}, nil
+	}
+
+	return biz.Idempotent(ctx, u.uuc, userId, idempotencyKey(ctx), "AmountTo", req, func() (*pb.AmountToReply, error) {
+		return biz.Audited(ctx, u.uuc, auditEntry(ctx, userId, "AmountTo"), req.SendBody.Address, req, func() (*pb.AmountToReply, error) {
+			return u.uuc.AmountTo(ctx, req, userId)
+		})
+	}, func(status string) *pb.AmountToReply {
+		return &pb.AmountToReply{Status: status}
+	})
 }
 
-func (u *UserService) OpenCardTwo(ctx context.Context, req *pb.OpenCardRequest) (*pb.OpenCardReply, error) {
+func (u *UserService) Withdraw(ctx context.Context, req *pb.WithdrawRequest) (*pb.WithdrawReply, error) {
 	// 在上下文 context 中取出 claims 对象
 	var (
 		err    error
@@ -504,7 +1271,7 @@ func (u *UserService) OpenCardTwo(ctx context.Context, req *pb.OpenCardRequest)
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return &pb.OpenCardReply{
+			return &pb.WithdrawReply{
 				Status: "无效TOKEN",
 			}, nil
 		}
@@ -517,13 +1284,13 @@ func (u *UserService) OpenCardTwo(ctx context.Context, req *pb.OpenCardRequest)
 	)
 	user, err = u.uuc.GetUserDataById(userId)
 	if nil != err {
-		return &pb.OpenCardReply{
+		return &pb.WithdrawReply{
 			Status: "无效TOKEN",
 		}, nil
 	}
 
 	if 1 == user.IsDelete {
-		return &pb.OpenCardReply{
+		return &pb.WithdrawReply{
 			Status: "用户已删除",
 		}, nil
 	}
@@ -533,22 +1300,29 @@ func (u *UserService) OpenCardTwo(ctx context.Context, req *pb.OpenCardRequest)
 		addressFromSign string
 	)
 	if 10 >= len(req.SendBody.Sign) {
-		return &pb.OpenCardReply{
+		return &pb.WithdrawReply{
 			Status: "签名错误",
 		}, nil
 	}
-
 	var (
 		contentStr string
 	)
+
+	// 重试请求的 nonce 已被第一次消耗，先按 Idempotency-Key 返回已有结果
+	if reply, ok := biz.IdempotentReplay(ctx, u.uuc, userId, idempotencyKey(ctx), "Withdraw", req, func(status string) *pb.WithdrawReply {
+		return &pb.WithdrawReply{Status: status}
+	}); ok {
+		return reply, nil
+	}
+
 	contentStr, err = u.uuc.GetAddressNonce(ctx, user.Address)
 	if nil != err {
-		return &pb.OpenCardReply{
+		return &pb.WithdrawReply{
 			Status: "错误",
 		}, nil
 	}
 	if 0 >= len(contentStr) {
-		return &pb.OpenCardReply{
+		return &pb.WithdrawReply{
 			Status: "错误nonce",
 		}, nil
 	}
@@ -556,15 +1330,44 @@ func (u *UserService) OpenCardTwo(ctx context.Context, req *pb.OpenCardRequest)
 
 	res, addressFromSign = verifySig(req.SendBody.Sign, content)
 	if !res || addressFromSign != user.Address {
-		return &pb.OpenCardReply{
+		return &pb.WithdrawReply{
 			Status: "签名错误",
 		}, nil
 	}
 
-	return u.uuc.OpenCardTwo(ctx, req, userId)
+	if status := u.uuc.CheckTotp(ctx, userId, req.SendBody.TotpCode); "" != status {
+		return &pb.WithdrawReply{
+			Status: status,
+		}, nil
+	}
+
+	return biz.Idempotent(ctx, u.uuc, userId, idempotencyKey(ctx), "Withdraw", req, func() (*pb.WithdrawReply, error) {
+		return biz.Audited(ctx, u.uuc, auditEntry(ctx, userId, "Withdraw"), "", req, func() (*pb.WithdrawReply, error) {
+			return u.uuc.Withdraw(ctx, req, userId)
+		})
+	}, func(status string) *pb.WithdrawReply {
+		return &pb.WithdrawReply{Status: status}
+	})
 }
 
-func (u *UserService) AmountToCard(ctx context.Context, req *pb.AmountToCardRequest) (*pb.AmountToCardReply, error) {
+func (u *UserService) WithdrawAddressList(ctx context.Context, req *pb.WithdrawAddressListRequest) (*pb.WithdrawAddressListReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId uint64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return &pb.WithdrawAddressListReply{
+				Status: "无效TOKEN",
+			}, nil
+		}
+
+		userId = uint64(c["UserId"].(float64))
+	}
+
+	return u.uuc.WithdrawAddressList(ctx, req, userId)
+}
+
+func (u *UserService) AddWithdrawAddress(ctx context.Context, req *pb.AddWithdrawAddressRequest) (*pb.AddWithdrawAddressReply, error) {
 	// 在上下文 context 中取出 claims 对象
 	var (
 		err    error
@@ -574,7 +1377,7 @@ func (u *UserService) AmountToCard(ctx context.Context, req *pb.AmountToCardRequ
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return &pb.AmountToCardReply{
+			return &pb.AddWithdrawAddressReply{
 				Status: "无效TOKEN",
 			}, nil
 		}
@@ -587,13 +1390,13 @@ func (u *UserService) AmountToCard(ctx context.Context, req *pb.AmountToCardRequ
 	)
 	user, err = u.uuc.GetUserDataById(userId)
 	if nil != err {
-		return &pb.AmountToCardReply{
+		return &pb.AddWithdrawAddressReply{
 			Status: "无效TOKEN",
 		}, nil
 	}
 
 	if 1 == user.IsDelete {
-		return &pb.AmountToCardReply{
+		return &pb.AddWithdrawAddressReply{
 			Status: "用户已删除",
 		}, nil
 	}
@@ -603,22 +1406,22 @@ func (u *UserService) AmountToCard(ctx context.Context, req *pb.AmountToCardRequ
 		addressFromSign string
 	)
 	if 10 >= len(req.SendBody.Sign) {
-		return &pb.AmountToCardReply{
+		return &pb.AddWithdrawAddressReply{
 			Status: "签名错误",
 		}, nil
 	}
-
 	var (
 		contentStr string
 	)
+
 	contentStr, err = u.uuc.GetAddressNonce(ctx, user.Address)
 	if nil != err {
-		return &pb.AmountToCardReply{
+		return &pb.AddWithdrawAddressReply{
 			Status: "错误",
 		}, nil
 	}
 	if 0 >= len(contentStr) {
-		return &pb.AmountToCardReply{
+		return &pb.AddWithdrawAddressReply{
 			Status: "错误nonce",
 		}, nil
 	}
@@ -626,15 +1429,17 @@ func (u *UserService) AmountToCard(ctx context.Context, req *pb.AmountToCardRequ
 
 	res, addressFromSign = verifySig(req.SendBody.Sign, content)
 	if !res || addressFromSign != user.Address {
-		return &pb.AmountToCardReply{
+		return &pb.AddWithdrawAddressReply{
 			Status: "签名错误",
 		}, nil
 	}
 
-	return u.uuc.AmountToCard(ctx, req, userId)
+	return biz.Audited(ctx, u.uuc, auditEntry(ctx, userId, "AddWithdrawAddress"), "", req, func() (*pb.AddWithdrawAddressReply, error) {
+		return u.uuc.AddWithdrawAddress(ctx, req, userId, u.addressCooldown())
+	})
 }
 
-func (u *UserService) AmountTo(ctx context.Context, req *pb.AmountToRequest) (*pb.AmountToReply, error) {
+func (u *UserService) DeleteWithdrawAddress(ctx context.Context, req *pb.DeleteWithdrawAddressRequest) (*pb.DeleteWithdrawAddressReply, error) {
 	// 在上下文 context 中取出 claims 对象
 	var (
 		err    error
@@ -644,7 +1449,7 @@ func (u *UserService) AmountTo(ctx context.Context, req *pb.AmountToRequest) (*p
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return &pb.AmountToReply{
+			return &pb.DeleteWithdrawAddressReply{
 				Status: "无效TOKEN",
 			}, nil
 		}
@@ -657,13 +1462,13 @@ func (u *UserService) AmountTo(ctx context.Context, req *pb.AmountToRequest) (*p
 	)
 	user, err = u.uuc.GetUserDataById(userId)
 	if nil != err {
-		return &pb.AmountToReply{
+		return &pb.DeleteWithdrawAddressReply{
 			Status: "无效TOKEN",
 		}, nil
 	}
 
 	if 1 == user.IsDelete {
-		return &pb.AmountToReply{
+		return &pb.DeleteWithdrawAddressReply{
 			Status: "用户已删除",
 		}, nil
 	}
@@ -673,7 +1478,7 @@ func (u *UserService) AmountTo(ctx context.Context, req *pb.AmountToRequest) (*p
 		addressFromSign string
 	)
 	if 10 >= len(req.SendBody.Sign) {
-		return &pb.AmountToReply{
+		return &pb.DeleteWithdrawAddressReply{
 			Status: "签名错误",
 		}, nil
 	}
@@ -683,12 +1488,12 @@ func (u *UserService) AmountTo(ctx context.Context, req *pb.AmountToRequest) (*p
 
 	contentStr, err = u.uuc.GetAddressNonce(ctx, user.Address)
 	if nil != err {
-		return &pb.AmountToReply{
+		return &pb.DeleteWithdrawAddressReply{
 			Status: "错误",
 		}, nil
 	}
 	if 0 >= len(contentStr) {
-		return &pb.AmountToReply{
+		return &pb.DeleteWithdrawAddressReply{
 			Status: "错误nonce",
 		}, nil
 	}
@@ -696,15 +1501,26 @@ func (u *UserService) AmountTo(ctx context.Context, req *pb.AmountToRequest) (*p
 
 	res, addressFromSign = verifySig(req.SendBody.Sign, content)
 	if !res || addressFromSign != user.Address {
-		return &pb.AmountToReply{
+		return &pb.DeleteWithdrawAddressReply{
 			Status: "签名错误",
 		}, nil
 	}
 
-	return u.uuc.AmountTo(ctx, req, userId)
+	return biz.Audited(ctx, u.uuc, auditEntry(ctx, userId, "DeleteWithdrawAddress"), "", req, func() (*pb.DeleteWithdrawAddressReply, error) {
+		return u.uuc.DeleteWithdrawAddress(ctx, req, userId)
+	})
 }
 
-func (u *UserService) Withdraw(ctx context.Context, req *pb.WithdrawRequest) (*pb.WithdrawReply, error) {
+// addressCooldown 新提现地址可用前的等待时间，未配置时由 biz 使用默认值
+func (u *UserService) addressCooldown() time.Duration {
+	if nil != u.cw && nil != u.cw.AddressCooldown {
+		return u.cw.AddressCooldown.AsDuration()
+	}
+
+	return 0
+}
+
+func (u *UserService) Exchange(ctx context.Context, req *pb.ExchangeRequest) (*pb.ExchangeReply, error) {
 	// 在上下文 context 中取出 claims 对象
 	var (
 		err    error
@@ -714,7 +1530,7 @@ func (u *UserService) Withdraw(ctx context.Context, req *pb.WithdrawRequest) (*p
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return &pb.WithdrawReply{
+			return &pb.ExchangeReply{
 				Status: "无效TOKEN",
 			}, nil
 		}
@@ -727,13 +1543,13 @@ func (u *UserService) Withdraw(ctx context.Context, req *pb.WithdrawRequest) (*p
 	)
 	user, err = u.uuc.GetUserDataById(userId)
 	if nil != err {
-		return &pb.WithdrawReply{
+		return &pb.ExchangeReply{
 			Status: "无效TOKEN",
 		}, nil
 	}
 
 	if 1 == user.IsDelete {
-		return &pb.WithdrawReply{
+		return &pb.ExchangeReply{
 			Status: "用户已删除",
 		}, nil
 	}
@@ -743,7 +1559,7 @@ func (u *UserService) Withdraw(ctx context.Context, req *pb.WithdrawRequest) (*p
 		addressFromSign string
 	)
 	if 10 >= len(req.SendBody.Sign) {
-		return &pb.WithdrawReply{
+		return &pb.ExchangeReply{
 			Status: "签名错误",
 		}, nil
 	}
@@ -751,14 +1567,21 @@ func (u *UserService) Withdraw(ctx context.Context, req *pb.WithdrawRequest) (*p
 		contentStr string
 	)
 
+	// 重试请求的 nonce 已被第一次消耗，先按 Idempotency-Key 返回已有结果
+	if reply, ok := biz.IdempotentReplay(ctx, u.uuc, userId, idempotencyKey(ctx), "Exchange", req, func(status string) *pb.ExchangeReply {
+		return &pb.ExchangeReply{Status: status}
+	}); ok {
+		return reply, nil
+	}
+
 	contentStr, err = u.uuc.GetAddressNonce(ctx, user.Address)
 	if nil != err {
-		return &pb.WithdrawReply{
+		return &pb.ExchangeReply{
 			Status: "错误",
 		}, nil
 	}
 	if 0 >= len(contentStr) {
-		return &pb.WithdrawReply{
+		return &pb.ExchangeReply{
 			Status: "错误nonce",
 		}, nil
 	}
@@ -766,12 +1589,18 @@ func (u *UserService) Withdraw(ctx context.Context, req *pb.WithdrawRequest) (*p
 
 	res, addressFromSign = verifySig(req.SendBody.Sign, content)
 	if !res || addressFromSign != user.Address {
-		return &pb.WithdrawReply{
+		return &pb.ExchangeReply{
 			Status: "签名错误",
 		}, nil
 	}
 
-	return u.uuc.Withdraw(ctx, req, userId)
+	return biz.Idempotent(ctx, u.uuc, userId, idempotencyKey(ctx), "Exchange", req, func() (*pb.ExchangeReply, error) {
+		return biz.Audited(ctx, u.uuc, auditEntry(ctx, userId, "Exchange"), "", req, func() (*pb.ExchangeReply, error) {
+			return u.uuc.Exchange(ctx, req, userId)
+		})
+	}, func(status string) *pb.ExchangeReply {
+		return &pb.ExchangeReply{Status: status}
+	})
 }
 
 func (u *UserService) LookCard(ctx context.Context, req *pb.LookCardRequest) (*pb.LookCardReply, error) {
@@ -841,6 +1670,12 @@ func (u *UserService) LookCard(ctx context.Context, req *pb.LookCardRequest) (*p
 	//	}, nil
 	//}
 
+	if status := u.uuc.CheckTotp(ctx, userId, req.SendBody.TotpCode); "" != status {
+		return &pb.LookCardReply{
+			Status: status,
+		}, nil
+	}
+
 	return u.uuc.LookCard(ctx, req, userId)
 }
 
@@ -911,7 +1746,9 @@ func (u *UserService) LookCardNew(ctx context.Context, req *pb.LookCardRequest)
 		}, nil
 	}
 
-	return u.uuc.LookCardNew(ctx, req, userId)
+	return biz.Audited(ctx, u.uuc, auditEntry(ctx, userId, "LookCardNew"), "", req, func() (*pb.LookCardReply, error) {
+		return u.uuc.LookCardNew(ctx, req, userId)
+	})
 }
 
 func (u *UserService) LookCardNewTwo(ctx context.Context, req *pb.LookCardRequest) (*pb.LookCardReply, error) {
@@ -981,7 +1818,9 @@ func (u *UserService) LookCardNewTwo(ctx context.Context, req *pb.LookCardReques
 		}, nil
 	}
 
-	return u.uuc.LookCardNewTwo(ctx, req, userId)
+	return biz.Audited(ctx, u.uuc, auditEntry(ctx, userId, "LookCardNewTwo"), "", req, func() (*pb.LookCardReply, error) {
+		return u.uuc.LookCardNewTwo(ctx, req, userId)
+	})
 }
 
 func (u *UserService) ChangePin(ctx context.Context, req *pb.ChangePinRequest) (*pb.ChangePinReply, error) {
@@ -1051,7 +1890,86 @@ func (u *UserService) ChangePin(ctx context.Context, req *pb.ChangePinRequest) (
 		}, nil
 	}
 
-	return u.uuc.ChangePin(ctx, req, userId)
+	if status := u.uuc.CheckTotp(ctx, userId, req.SendBody.TotpCode); "" != status {
+		return &pb.ChangePinReply{
+			Status: status,
+		}, nil
+	}
+
+	return biz.Audited(ctx, u.uuc, auditEntry(ctx, userId, "ChangePin"), "", req, func() (*pb.ChangePinReply, error) {
+		return u.uuc.ChangePin(ctx, req, userId, u.pinKey)
+	})
+}
+
+func (u *UserService) PinKey(ctx context.Context, req *pb.PinKeyRequest) (*pb.PinKeyReply, error) {
+	return u.uuc.PinKey(ctx, u.pinKey)
+}
+
+func (u *UserService) AuditList(ctx context.Context, req *pb.AuditListRequest) (*pb.AuditListReply, error) {
+	if _, ok := u.adminCheck(ctx); !ok {
+		return &pb.AuditListReply{Status: "无权限"}, nil
+	}
+
+	return u.uuc.AuditList(ctx, req)
+}
+
+func (u *UserService) RiskReviewList(ctx context.Context, req *pb.RiskReviewListRequest) (*pb.RiskReviewListReply, error) {
+	if _, ok := u.adminCheck(ctx); !ok {
+		return &pb.RiskReviewListReply{Status: "无权限"}, nil
+	}
+
+	return u.uuc.RiskReviewList(ctx, req)
+}
+
+func (u *UserService) RiskReview(ctx context.Context, req *pb.RiskReviewRequest) (*pb.RiskReviewReply, error) {
+	admin, ok := u.adminCheck(ctx)
+	if !ok {
+		return &pb.RiskReviewReply{Status: "无权限"}, nil
+	}
+
+	return biz.Audited(ctx, u.uuc, adminAuditEntry(ctx, admin, "RiskReview"), "", req, func() (*pb.RiskReviewReply, error) {
+		return u.uuc.RiskReview(ctx, req)
+	})
+}
+
+func (u *UserService) AdminDataExport(ctx context.Context, req *pb.AdminDataExportRequest) (*pb.DataExportReply, error) {
+	admin, ok := u.adminCheck(ctx)
+	if !ok {
+		return &pb.DataExportReply{Status: "无权限"}, nil
+	}
+
+	user, err := u.uuc.GetUserDataById(req.UserId)
+	if nil != err || nil == user {
+		return &pb.DataExportReply{Status: "用户不存在"}, nil
+	}
+
+	return biz.Audited(ctx, u.uuc, adminAuditEntry(ctx, admin, "AdminDataExport"), user.Address, req, func() (*pb.DataExportReply, error) {
+		return u.uuc.DataExport(ctx, user.ID, u.exportKey)
+	})
+}
+
+func (u *UserService) AdminAccountErase(ctx context.Context, req *pb.AdminAccountEraseRequest) (*pb.AccountEraseReply, error) {
+	admin, ok := u.adminCheck(ctx)
+	if !ok {
+		return &pb.AccountEraseReply{Status: "无权限"}, nil
+	}
+
+	user, err := u.uuc.GetUserDataById(req.SendBody.UserId)
+	if nil != err || nil == user {
+		return &pb.AccountEraseReply{Status: "用户不存在"}, nil
+	}
+
+	return biz.Audited(ctx, u.uuc, adminAuditEntry(ctx, admin, "AdminAccountErase"), user.Address, req, func() (*pb.AccountEraseReply, error) {
+		return u.uuc.AdminAccountErase(ctx, req, admin)
+	})
+}
+
+func (u *UserService) AuditVerify(ctx context.Context, req *pb.AuditVerifyRequest) (*pb.AuditVerifyReply, error) {
+	if _, ok := u.adminCheck(ctx); !ok {
+		return &pb.AuditVerifyReply{Status: "无权限"}, nil
+	}
+
+	return u.uuc.AuditVerify(ctx, req)
 }
 
 // Upload upload .
@@ -1084,7 +2002,90 @@ func (u *UserService) Upload(ctx transporthttp.Context) (err error) {
 		return nil
 	}
 
-	return u.uuc.Upload(ctx)
+	return u.uuc.UploadAudited(ctx, auditEntry(ctx, 0, "Upload"), name)
+}
+
+// IdempotentReplayable 限流中间件使用，Idempotency-Key 已有保存结果的重试请求不消耗令牌
+func (u *UserService) IdempotentReplayable(ctx context.Context, operation string, req interface{}) bool {
+	key := idempotencyKey(ctx)
+	if "" == key {
+		return false
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return false
+	}
+
+	claims, ok := jwt.FromContext(ctx)
+	if !ok {
+		return false
+	}
+
+	c, ok := claims.(jwt2.MapClaims)
+	if !ok {
+		return false
+	}
+
+	userId, ok := c["UserId"].(float64)
+	if !ok {
+		return false
+	}
+
+	return u.uuc.IdempotentDone(ctx, uint64(userId), key, operation[strings.LastIndex(operation, "/")+1:], msg)
+}
+
+// idempotencyKey 客户端请求头 Idempotency-Key
+func idempotencyKey(ctx context.Context) string {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		return strings.TrimSpace(tr.RequestHeader().Get("Idempotency-Key"))
+	}
+
+	return ""
+}
+
+// auditEntry 审计日志的操作人和请求来源
+func auditEntry(ctx context.Context, userId uint64, operation string) *biz.AuditLog {
+	return &biz.AuditLog{
+		ActorId:   userId,
+		Operation: operation,
+		Ip:        realip.FromContext(ctx),
+		UserAgent: realip.UserAgent(ctx),
+	}
+}
+
+// adminAuditEntry 后台操作的审计，操作人为密钥对应的名称
+func adminAuditEntry(ctx context.Context, admin, operation string) *biz.AuditLog {
+	entry := auditEntry(ctx, 0, operation)
+	entry.Actor = admin
+	return entry
+}
+
+// adminCheck 后台接口校验请求头 X-Admin-Key，返回密钥对应的操作人
+func (u *UserService) adminCheck(ctx context.Context) (string, bool) {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	key := []byte(tr.RequestHeader().Get("X-Admin-Key"))
+	if 0 == len(key) {
+		return "", false
+	}
+
+	// 旧的单一密钥记为 admin
+	admin := ""
+	if "" != u.ca.AdminKey && 1 == subtle.ConstantTimeCompare(key, []byte(u.ca.AdminKey)) {
+		admin = "admin"
+	}
+
+	for name, v := range u.ca.AdminKeys {
+		if "" != name && "" != v && 1 == subtle.ConstantTimeCompare(key, []byte(v)) {
+			admin = name
+		}
+	}
+
+	return admin, "" != admin
 }
 
 func addressCheck(addressParam string) (bool, error) {